@@ -0,0 +1,221 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ErrWriterClosed is returned by the writes to a closed AsyncWriter or AccessLogFile.
+var ErrWriterClosed = errors.New("gin: writer closed")
+
+// RotatableWriter is a log sink whose file can be rotated, e.g. on SIGHUP. It is
+// implemented by AccessLogFile, and by the lumberjack.Logger of
+// gopkg.in/natefinch/lumberjack.v2, so both can be the output of the Logger middleware,
+// separately from the application logs:
+//
+//	router.Use(gin.LoggerWithConfig(gin.LoggerConfig{
+//		Output: &lumberjack.Logger{Filename: "/var/log/app/access.log", MaxSize: 100},
+//	}))
+type RotatableWriter interface {
+	io.WriteCloser
+	// Rotate closes the current file, moves it aside and opens a new one.
+	Rotate() error
+}
+
+// AccessLogFile is a RotatableWriter appending to a file, safe for concurrent use.
+type AccessLogFile struct {
+	mu       sync.Mutex
+	filename string
+	file     *os.File
+	now      func() time.Time
+}
+
+var _ RotatableWriter = (*AccessLogFile)(nil)
+
+// OpenAccessLogFile opens the file filename for appending, creating it and its directory
+// if needed.
+func OpenAccessLogFile(filename string) (*AccessLogFile, error) {
+	f := &AccessLogFile{filename: filename, now: time.Now}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *AccessLogFile) open() error {
+	if err := os.MkdirAll(filepath.Dir(f.filename), 0o755); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(f.filename, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	f.file = file
+	return nil
+}
+
+// Write appends p to the file.
+func (f *AccessLogFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file == nil {
+		return 0, ErrWriterClosed
+	}
+	return f.file.Write(p)
+}
+
+// Rotate renames the file with the current time inserted before its extension, e.g.
+// access-2026-10-16T08-30-00.000.log, and opens a new one. If the file can not be renamed,
+// it is reopened and the writes go on appending to it.
+func (f *AccessLogFile) Rotate() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file == nil {
+		return ErrWriterClosed
+	}
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	f.file = nil
+	ext := filepath.Ext(f.filename)
+	backup := strings.TrimSuffix(f.filename, ext) + "-" + f.now().Format("2006-01-02T15-04-05.000") + ext
+	if err := os.Rename(f.filename, backup); err != nil {
+		if openErr := f.open(); openErr != nil {
+			return openErr
+		}
+		return err
+	}
+	return f.open()
+}
+
+// Reopen closes and reopens the file, for the rotations done by an external tool, e.g.
+// logrotate, which moved the file away.
+func (f *AccessLogFile) Reopen() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file == nil {
+		return ErrWriterClosed
+	}
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	f.file = nil
+	return f.open()
+}
+
+// Close closes the file.
+func (f *AccessLogFile) Close() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
+
+// AsyncWriter is a buffered writer never blocking its callers: the writes are queued and
+// written to the underlying writer by a goroutine, and dropped when the queue is full,
+// e.g. because the disk is slow, so that a saturated log sink does not slow the requests
+// down. It is safe for concurrent use.
+//
+//	accessLog := gin.NewAsyncWriter(file, 1024)
+//	defer accessLog.Close()
+//	router.Use(gin.LoggerWithConfig(gin.LoggerConfig{Output: accessLog}))
+type AsyncWriter struct {
+	out     io.Writer
+	entries chan []byte
+	done    chan struct{}
+	dropped uint64
+	failed  uint64
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+// NewAsyncWriter returns an AsyncWriter queuing up to size writes to out.
+func NewAsyncWriter(out io.Writer, size int) *AsyncWriter {
+	assert1(out != nil, "async writer output can not be nil")
+	assert1(size > 0, "async writer size must be positive")
+	w := &AsyncWriter{
+		out:     out,
+		entries: make(chan []byte, size),
+		done:    make(chan struct{}),
+	}
+	go w.run()
+	return w
+}
+
+func (w *AsyncWriter) run() {
+	defer close(w.done)
+	for entry := range w.entries {
+		if _, err := w.out.Write(entry); err != nil {
+			atomic.AddUint64(&w.failed, 1)
+		}
+	}
+}
+
+// Write queues a copy of p, or drops it if the queue is full. It fails only once the
+// writer is closed.
+func (w *AsyncWriter) Write(p []byte) (int, error) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.closed {
+		return 0, ErrWriterClosed
+	}
+	entry := make([]byte, len(p))
+	copy(entry, p)
+	select {
+	case w.entries <- entry:
+	default:
+		atomic.AddUint64(&w.dropped, 1)
+	}
+	return len(p), nil
+}
+
+// Dropped returns the number of writes dropped because the queue was full.
+func (w *AsyncWriter) Dropped() uint64 {
+	return atomic.LoadUint64(&w.dropped)
+}
+
+// Failed returns the number of writes the underlying writer failed.
+func (w *AsyncWriter) Failed() uint64 {
+	return atomic.LoadUint64(&w.failed)
+}
+
+// Rotate rotates the underlying writer, if it is a RotatableWriter.
+func (w *AsyncWriter) Rotate() error {
+	if r, ok := w.out.(RotatableWriter); ok {
+		return r.Rotate()
+	}
+	return nil
+}
+
+// Close writes the queued writes, and closes the underlying writer if it is an io.Closer.
+func (w *AsyncWriter) Close() error {
+	w.mu.Lock()
+	if w.closed {
+		w.mu.Unlock()
+		return nil
+	}
+	w.closed = true
+	close(w.entries)
+	w.mu.Unlock()
+
+	<-w.done
+	if closer, ok := w.out.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessLogFile(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "logs", "access.log")
+	f, err := OpenAccessLogFile(filename)
+	assert.NoError(t, err)
+	f.now = func() time.Time { return time.Date(2026, 10, 16, 8, 30, 0, 0, time.UTC) }
+
+	_, err = f.Write([]byte("first\n"))
+	assert.NoError(t, err)
+	assert.NoError(t, f.Rotate())
+	_, err = f.Write([]byte("second\n"))
+	assert.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(dir, "logs", "access-2026-10-16T08-30-00.000.log"))
+	assert.NoError(t, err)
+	assert.Equal(t, "first\n", string(data))
+	data, err = os.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "second\n", string(data))
+
+	moved := filepath.Join(dir, "moved.log")
+	assert.NoError(t, os.Rename(filename, moved))
+	assert.NoError(t, f.Reopen())
+	_, err = f.Write([]byte("third\n"))
+	assert.NoError(t, err)
+	data, err = os.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "third\n", string(data))
+
+	assert.NoError(t, f.Close())
+	assert.NoError(t, f.Close())
+	_, err = f.Write([]byte("closed\n"))
+	assert.ErrorIs(t, err, ErrWriterClosed)
+	assert.ErrorIs(t, f.Rotate(), ErrWriterClosed)
+	assert.ErrorIs(t, f.Reopen(), ErrWriterClosed)
+}
+
+func TestAccessLogFileRotateFailed(t *testing.T) {
+	dir := t.TempDir()
+	filename := filepath.Join(dir, "access.log")
+	f, err := OpenAccessLogFile(filename)
+	assert.NoError(t, err)
+	defer f.Close()
+	f.now = func() time.Time { return time.Date(2026, 10, 16, 8, 30, 0, 0, time.UTC) }
+
+	// a directory in the way of the backup fails the rename
+	backup := filepath.Join(dir, "access-2026-10-16T08-30-00.000.log")
+	assert.NoError(t, os.MkdirAll(filepath.Join(backup, "busy"), 0o755))
+
+	_, err = f.Write([]byte("first\n"))
+	assert.NoError(t, err)
+	assert.Error(t, f.Rotate())
+	_, err = f.Write([]byte("second\n"))
+	assert.NoError(t, err, "the file is reopened")
+
+	data, err := os.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "first\nsecond\n", string(data))
+}
+
+// blockingWriter blocks its writes until it is released.
+type blockingWriter struct {
+	mu      sync.Mutex
+	buf     bytes.Buffer
+	release chan struct{}
+	rotated int
+	closed  bool
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	<-w.release
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if bytes.Equal(p, []byte("fail\n")) {
+		return 0, errors.New("disk full")
+	}
+	return w.buf.Write(p)
+}
+
+func (w *blockingWriter) Rotate() error {
+	w.rotated++
+	return nil
+}
+
+func (w *blockingWriter) Close() error {
+	w.closed = true
+	return nil
+}
+
+func TestAsyncWriter(t *testing.T) {
+	out := &blockingWriter{release: make(chan struct{})}
+	w := NewAsyncWriter(out, 2)
+
+	// the first write is taken by the goroutine, the next two are queued
+	for _, entry := range []string{"a\n", "b\n", "fail\n"} {
+		n, err := w.Write([]byte(entry))
+		assert.NoError(t, err)
+		assert.Equal(t, len(entry), n)
+		if entry == "a\n" {
+			assert.Eventually(t, func() bool { return len(w.entries) == 0 }, time.Second, time.Millisecond)
+		}
+	}
+	n, err := w.Write([]byte("dropped\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, 8, n)
+	assert.Equal(t, uint64(1), w.Dropped())
+
+	assert.NoError(t, w.Rotate())
+	assert.Equal(t, 1, out.rotated)
+
+	close(out.release)
+	assert.NoError(t, w.Close())
+	assert.NoError(t, w.Close())
+	assert.True(t, out.closed)
+	assert.Equal(t, "a\nb\n", out.buf.String())
+	assert.Equal(t, uint64(1), w.Failed())
+
+	_, err = w.Write([]byte("late\n"))
+	assert.ErrorIs(t, err, ErrWriterClosed)
+
+	assert.Panics(t, func() { NewAsyncWriter(out, 0) })
+}
+
+func TestLoggerAccessLogSink(t *testing.T) {
+	f, err := OpenAccessLogFile(filepath.Join(t.TempDir(), "access.log"))
+	assert.NoError(t, err)
+	w := NewAsyncWriter(f, 16)
+
+	router := New()
+	router.Use(LoggerWithConfig(LoggerConfig{Output: w}))
+	router.GET("/ping", func(c *Context) {})
+	PerformRequest(router, http.MethodGet, "/ping")
+	PerformRequest(router, http.MethodGet, "/ping")
+	assert.NoError(t, w.Close())
+
+	data, err := os.ReadFile(f.filename)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, bytes.Count(data, []byte(`"/ping"`)))
+}
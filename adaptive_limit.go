@@ -0,0 +1,160 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"math"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	defaultAdaptiveInitialLimit = 20
+	defaultAdaptiveMaxLimit     = 1000
+	defaultAdaptiveBackoff      = 0.9
+	defaultAdaptiveTolerance    = 2.0
+	adaptiveMinLatencySamples   = 1000
+	lowPriorityShare            = 0.75
+)
+
+// AdaptiveLimitConfig defines the config for NewAdaptiveLimiter.
+type AdaptiveLimitConfig struct {
+	// InitialLimit is the number of concurrent requests allowed at start.
+	// Optional. Default value is 20.
+	InitialLimit int
+
+	// MinLimit and MaxLimit bound the number of concurrent requests allowed.
+	// Optional. Default values are 1 and 1000.
+	MinLimit int
+	MaxLimit int
+
+	// LatencyThreshold is the latency above which a request is considered slow.
+	// Optional. By default, a request is slow when it takes longer than Tolerance times
+	// the minimum latency recently observed, which follows the no-load latency of the
+	// downstream services.
+	LatencyThreshold time.Duration
+
+	// Tolerance is the ratio to the minimum latency above which a request is considered slow,
+	// when LatencyThreshold is zero. Optional. Default value is 2.
+	Tolerance float64
+
+	// Backoff is the factor the limit is multiplied by after a slow or failed request.
+	// Optional. Default value is 0.9.
+	Backoff float64
+}
+
+// AdaptiveLimiter limits the number of concurrent requests, adjusting the limit to the
+// observed latency with an additive increase, multiplicative decrease (AIMD) algorithm:
+// the limit grows by one every limit fast requests served at full capacity, and shrinks
+// by the backoff factor after every slow request or server error. It protects the
+// downstream services, such as databases, without having to tune a static limit.
+type AdaptiveLimiter struct {
+	config AdaptiveLimitConfig
+
+	mu         sync.Mutex
+	limit      float64
+	inFlight   int
+	minLatency time.Duration
+	samples    int
+}
+
+// NewAdaptiveLimiter returns a new AdaptiveLimiter.
+func NewAdaptiveLimiter(config AdaptiveLimitConfig) *AdaptiveLimiter {
+	if config.MinLimit <= 0 {
+		config.MinLimit = 1
+	}
+	if config.MaxLimit <= 0 {
+		config.MaxLimit = defaultAdaptiveMaxLimit
+	}
+	if config.InitialLimit <= 0 {
+		config.InitialLimit = defaultAdaptiveInitialLimit
+	}
+	if config.Tolerance <= 1 {
+		config.Tolerance = defaultAdaptiveTolerance
+	}
+	if config.Backoff <= 0 || config.Backoff >= 1 {
+		config.Backoff = defaultAdaptiveBackoff
+	}
+	assert1(config.MinLimit <= config.MaxLimit, "min limit can not be greater than max limit")
+	initial := math.Min(math.Max(float64(config.InitialLimit), float64(config.MinLimit)), float64(config.MaxLimit))
+	return &AdaptiveLimiter{config: config, limit: initial}
+}
+
+// Limit returns the number of concurrent requests currently allowed.
+func (l *AdaptiveLimiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return int(l.limit)
+}
+
+// InFlight returns the number of requests being served.
+func (l *AdaptiveLimiter) InFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.inFlight
+}
+
+// acquire admits a request of priority p: the low priority requests are shed once the
+// in-flight requests reach lowPriorityShare of the limit, and the critical ones never are.
+func (l *AdaptiveLimiter) acquire(p Priority) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	limit := l.limit
+	if p <= PriorityLow {
+		limit *= lowPriorityShare
+	}
+	if p < PriorityCritical && l.inFlight >= int(limit) {
+		return false
+	}
+	l.inFlight++
+	return true
+}
+
+// release records the outcome of a request and adjusts the limit.
+func (l *AdaptiveLimiter) release(latency time.Duration, failed bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	saturated := l.inFlight >= int(l.limit)
+	l.inFlight--
+
+	threshold := l.config.LatencyThreshold
+	if threshold <= 0 {
+		// forget the minimum latency from time to time, to follow the downstream changes
+		if l.samples++; l.samples > adaptiveMinLatencySamples {
+			l.samples, l.minLatency = 0, 0
+		}
+		if l.minLatency == 0 || latency < l.minLatency {
+			l.minLatency = latency
+		}
+		threshold = time.Duration(float64(l.minLatency) * l.config.Tolerance)
+	}
+
+	switch {
+	case failed || latency > threshold:
+		l.limit = math.Max(l.limit*l.config.Backoff, float64(l.config.MinLimit))
+	case saturated:
+		l.limit = math.Min(l.limit+1/l.limit, float64(l.config.MaxLimit))
+	}
+}
+
+// Middleware returns a middleware enforcing the limit. Requests over the limit are
+// rejected right away with a 503 Service Unavailable, to shed the load. The requests of the
+// PriorityLow routes are shed at three quarters of the limit, keeping room for the others,
+// and the PriorityCritical ones are never shed, see RouterGroup.WithPriority.
+func (l *AdaptiveLimiter) Middleware() HandlerFunc {
+	return func(c *Context) {
+		if !l.acquire(c.Priority()) {
+			c.Header("Retry-After", "1")
+			c.AbortWithStatus(http.StatusServiceUnavailable)
+			return
+		}
+		start := time.Now()
+		defer func() {
+			l.release(time.Since(start), c.Writer.Status() >= http.StatusInternalServerError)
+		}()
+		c.Next()
+	}
+}
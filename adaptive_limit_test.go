@@ -0,0 +1,92 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptiveLimiterRejects(t *testing.T) {
+	limiter := NewAdaptiveLimiter(AdaptiveLimitConfig{InitialLimit: 1, MaxLimit: 1})
+	release := make(chan struct{})
+	started := make(chan struct{})
+
+	router := New()
+	router.Use(limiter.Middleware())
+	router.GET("/slow", func(c *Context) {
+		close(started)
+		<-release
+	})
+	router.GET("/", func(c *Context) {})
+
+	done := make(chan struct{})
+	go func() {
+		PerformRequest(router, http.MethodGet, "/slow")
+		close(done)
+	}()
+	<-started
+	assert.Equal(t, 1, limiter.InFlight())
+
+	w := PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+
+	close(release)
+	<-done
+	assert.Equal(t, 0, limiter.InFlight())
+	w = PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAdaptiveLimiterAIMD(t *testing.T) {
+	limiter := NewAdaptiveLimiter(AdaptiveLimitConfig{InitialLimit: 10, MinLimit: 2, LatencyThreshold: 50 * time.Millisecond})
+	assert.Equal(t, 10, limiter.Limit())
+
+	// slow requests and server errors shrink the limit, down to the minimum
+	limiter.acquire(PriorityNormal)
+	limiter.release(time.Second, false)
+	assert.Equal(t, 9, limiter.Limit())
+	limiter.acquire(PriorityNormal)
+	limiter.release(time.Millisecond, true)
+	assert.Equal(t, 8, limiter.Limit())
+	for i := 0; i < 50; i++ {
+		limiter.acquire(PriorityNormal)
+		limiter.release(time.Second, false)
+	}
+	assert.Equal(t, 2, limiter.Limit())
+
+	// fast requests at full capacity grow it
+	for i := 0; i < 20; i++ {
+		limiter.acquire(PriorityNormal)
+		limiter.acquire(PriorityNormal)
+		limiter.release(time.Millisecond, false)
+		limiter.release(time.Millisecond, false)
+	}
+	assert.Greater(t, limiter.Limit(), 2)
+
+	// fast requests below capacity leave it unchanged
+	limit := limiter.Limit()
+	limiter.acquire(PriorityNormal)
+	limiter.release(time.Millisecond, false)
+	assert.Equal(t, limit, limiter.Limit())
+}
+
+func TestAdaptiveLimiterGradient(t *testing.T) {
+	limiter := NewAdaptiveLimiter(AdaptiveLimitConfig{InitialLimit: 10})
+	limiter.acquire(PriorityNormal)
+	limiter.release(10*time.Millisecond, false)
+	assert.Equal(t, 10, limiter.Limit())
+
+	// more than twice the minimum latency
+	limiter.acquire(PriorityNormal)
+	limiter.release(30*time.Millisecond, false)
+	assert.Equal(t, 9, limiter.Limit())
+
+	assert.Panics(t, func() { NewAdaptiveLimiter(AdaptiveLimitConfig{MinLimit: 10, MaxLimit: 5}) })
+}
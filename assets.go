@@ -0,0 +1,127 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"html/template"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/gin-gonic/gin/internal/json"
+)
+
+const (
+	immutableCacheControl = "public, max-age=31536000, immutable"
+	noCacheControl        = "no-cache"
+)
+
+// AssetManifest maps logical asset names to their hashed (cache-busted) file names,
+// e.g. "app.js" -> "app.3f2a1c.js", as produced by most frontend bundlers.
+type AssetManifest map[string]string
+
+// ParseAssetManifest decodes a JSON asset manifest.
+func ParseAssetManifest(r io.Reader) (AssetManifest, error) {
+	var raw map[string]string
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+	manifest := make(AssetManifest, len(raw))
+	for name, hashed := range raw {
+		manifest[strings.TrimPrefix(name, "/")] = strings.TrimPrefix(hashed, "/")
+	}
+	return manifest, nil
+}
+
+// hashedFiles is the set of the hashed file names of an asset manifest.
+type hashedFiles map[string]struct{}
+
+// hashedFiles returns the set of the hashed file names of the manifest.
+func (manifest AssetManifest) hashedFiles() hashedFiles {
+	files := make(hashedFiles, len(manifest))
+	for _, hashed := range manifest {
+		files[hashed] = struct{}{}
+	}
+	return files
+}
+
+// contains reports whether file is one of the hashed file names.
+func (files hashedFiles) contains(file string) bool {
+	_, ok := files[strings.TrimPrefix(file, "/")]
+	return ok
+}
+
+// StaticAssets works like StaticFS but reads the asset manifest manifestName from fs.
+// Hashed assets listed in the manifest are served with immutable cache headers while
+// HTML files are served uncached. The manifest is registered on the engine, so
+// Context.AssetPath and the "assetPath" template function resolve logical names to
+// the hashed URLs. It panics if the manifest can not be loaded, and should be called
+// before LoadHTMLGlob or LoadHTMLFiles so the template function is available.
+func (group *RouterGroup) StaticAssets(relativePath string, fs http.FileSystem, manifestName string) IRoutes {
+	if strings.Contains(relativePath, ":") || strings.Contains(relativePath, "*") {
+		panic("URL parameters can not be used when serving a static folder")
+	}
+	f, err := fs.Open(manifestName)
+	if err != nil {
+		panic("cannot open asset manifest: " + err.Error())
+	}
+	manifest, err := ParseAssetManifest(f)
+	f.Close()
+	if err != nil {
+		panic("cannot parse asset manifest: " + err.Error())
+	}
+
+	engine := group.engine
+	engine.assetPrefix = group.calculateAbsolutePath(relativePath)
+	engine.assetManifest = manifest
+	if engine.FuncMap == nil {
+		engine.FuncMap = template.FuncMap{}
+	}
+	engine.FuncMap["assetPath"] = engine.AssetPath
+
+	hashed := manifest.hashedFiles()
+	static := group.createStaticHandler(relativePath, fs)
+	handler := func(c *Context) {
+		file := c.Param("filepath")
+		switch {
+		case hashed.contains(file):
+			c.Header("Cache-Control", immutableCacheControl)
+		case strings.HasSuffix(file, ".html") || strings.HasSuffix(file, "/"):
+			c.Header("Cache-Control", noCacheControl)
+		}
+		static(c)
+	}
+	urlPattern := path.Join(relativePath, "/*filepath")
+
+	group.GET(urlPattern, handler)
+	group.HEAD(urlPattern, handler)
+	return group.returnObj()
+}
+
+// AssetPath returns the URL of the hashed file registered for the logical asset name
+// by StaticAssets. Names missing from the manifest are resolved without hashing.
+func (engine *Engine) AssetPath(name string) string {
+	name = strings.TrimPrefix(name, "/")
+	if hashed, ok := engine.assetManifest[name]; ok {
+		name = hashed
+	}
+	prefix := engine.assetPrefix
+	if prefix == "" {
+		prefix = "/"
+	}
+	return joinPaths(prefix, name)
+}
+
+// AssetPath returns the URL of the hashed file for the logical asset name.
+// See Engine.AssetPath.
+//
+//	router.StaticAssets("/static", gin.Dir("./public", false), "manifest.json")
+//	router.GET("/", func(c *gin.Context) {
+//	    c.AssetPath("app.js") // "/static/app.3f2a1c.js"
+//	})
+func (c *Context) AssetPath(name string) string {
+	return c.engine.AssetPath(name)
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAssetManifest(t *testing.T) {
+	manifest, err := ParseAssetManifest(strings.NewReader(`{"/app.js": "/app.1234.js"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, AssetManifest{"app.js": "app.1234.js"}, manifest)
+	assert.True(t, manifest.hashedFiles().contains("/app.1234.js"))
+	assert.False(t, manifest.hashedFiles().contains("app.js"))
+
+	_, err = ParseAssetManifest(strings.NewReader(`[`))
+	assert.Error(t, err)
+}
+
+func TestStaticAssets(t *testing.T) {
+	router := New()
+	router.StaticAssets("/static", Dir("./testdata/assets", false), "manifest.json")
+
+	assert.Equal(t, "/static/app.3f2a1c.js", router.AssetPath("app.js"))
+	assert.Equal(t, "/static/style.9b8e.css", router.AssetPath("/style.css"))
+	assert.Equal(t, "/static/missing.js", router.AssetPath("missing.js"))
+
+	w := PerformRequest(router, http.MethodGet, "/static/app.3f2a1c.js")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, immutableCacheControl, w.Header().Get("Cache-Control"))
+
+	w = PerformRequest(router, http.MethodGet, "/static/index.html")
+	assert.Equal(t, noCacheControl, w.Header().Get("Cache-Control"))
+
+	w = PerformRequest(router, http.MethodGet, "/static/manifest.json")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("Cache-Control"))
+}
+
+func TestStaticAssetsTemplateFunc(t *testing.T) {
+	router := New()
+	group := router.Group("/assets")
+	group.StaticAssets("/", Dir("./testdata/assets", false), "manifest.json")
+	router.GET("/", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.AssetPath("app.js"))
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, "/assets/app.3f2a1c.js", w.Body.String())
+
+	fn := router.FuncMap["assetPath"].(func(string) string)
+	assert.Equal(t, "/assets/style.9b8e.css", fn("style.css"))
+}
+
+func TestStaticAssetsBadManifest(t *testing.T) {
+	router := New()
+	assert.Panics(t, func() {
+		router.StaticAssets("/static", Dir("./testdata/assets", false), "missing.json")
+	})
+	assert.Panics(t, func() {
+		router.StaticAssets("/static", Dir("./testdata/assets", false), "app.3f2a1c.js")
+	})
+	assert.Panics(t, func() {
+		router.StaticAssets("/static/:id", Dir("./testdata/assets", false), "manifest.json")
+	})
+}
@@ -0,0 +1,138 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Routes is a bundle of routes built independently of an Engine, e.g. by a library, which
+// applications mount with RouterGroup.Attach. Routes are registered on it like on any
+// router group, including its middleware and sub groups.
+//
+//	func AdminRoutes() *gin.Routes {
+//		routes := gin.NewRoutes()
+//		routes.Use(requireAdmin)
+//		routes.GET("/users", listUsers)
+//		return routes
+//	}
+//
+//	router.Attach("/admin", AdminRoutes())
+type Routes struct {
+	RouterGroup
+}
+
+// NewRoutes returns an empty bundle of routes.
+func NewRoutes() *Routes {
+	engine := newEngine()
+	engine.detached = true
+	return &Routes{RouterGroup: RouterGroup{basePath: "/", engine: engine}}
+}
+
+// RouteConflictError is returned by RouterGroup.Attach when routes of the bundle conflict
+// with routes already registered.
+type RouteConflictError struct {
+	// Conflicts describes every conflicting route.
+	Conflicts []string
+}
+
+func (e *RouteConflictError) Error() string {
+	return "gin: attached routes conflict with registered routes:\n\t" + strings.Join(e.Conflicts, "\n\t")
+}
+
+// Attach mounts the bundle routes under relativePath of the group. The middleware of the
+// group run before the bundle ones, and the metadata of the group and of the bundle routes
+// are merged. The constraints and the catch-all extensions of the bundle routes are kept.
+// If a bundle route conflicts with a registered route, no route is attached and a
+// *RouteConflictError reporting all the conflicts is returned.
+func (group *RouterGroup) Attach(relativePath string, routes *Routes) error {
+	bundle := routes.engine.treeRoutes()
+
+	mounted := make(RoutesInfo, len(bundle))
+	var baseConstraints map[string]ParamConstraint
+	for i, route := range bundle {
+		route.Method = group.host.qualify(route.Method)
+		route.Path, baseConstraints = parsePathConstraints(group.calculateAbsolutePath(relativePath + route.Path))
+		mounted[i] = route
+	}
+	if conflicts := group.engine.routeConflicts(mounted); len(conflicts) > 0 {
+		return &RouteConflictError{Conflicts: conflicts}
+	}
+
+	for i, route := range bundle {
+		method, path := mounted[i].Method, mounted[i].Path
+		n := routes.engine.routeNode(route.treeMethod(), route.Path)
+		alternatives, constrained := routes.engine.constraints[constraintKey{method: route.treeMethod(), path: route.Path}]
+		if !constrained {
+			alternatives = []*constrainedRoute{{handlers: n.handlers, meta: route.Meta}}
+		}
+		for _, alternative := range alternatives {
+			constraints := alternative.constraints
+			if len(baseConstraints) > 0 {
+				constraints = make(map[string]ParamConstraint, len(baseConstraints)+len(alternative.constraints))
+				for _, m := range []map[string]ParamConstraint{baseConstraints, alternative.constraints} {
+					for name, constraint := range m {
+						constraints[name] = constraint
+					}
+				}
+			}
+			handlers := group.combineHandlers(alternative.handlers)
+			group.engine.addConstrainedRoute(method, path, constraints, handlers, mergeMeta(group.meta, alternative.meta))
+		}
+		if n.extensions != nil {
+			group.engine.setCatchAllExtensions(method, path, n.extensions)
+		} else if group.extensions != nil {
+			group.engine.setCatchAllExtensions(method, path, group.extensions)
+		}
+	}
+	for g := group; g != nil && !g.hasRoutes && len(bundle) > 0; g = g.parent {
+		g.hasRoutes = true
+	}
+	return nil
+}
+
+// routeConflicts registers the routes of the engine and the given ones, with absolute
+// paths, in scratch trees, returning the conflicts the trees report.
+func (engine *Engine) routeConflicts(routes RoutesInfo) (conflicts []string) {
+	trees := make(map[string]*node)
+	tree := func(method string) *node {
+		if trees[method] == nil {
+			trees[method] = &node{fullPath: "/"}
+		}
+		return trees[method]
+	}
+	for _, route := range engine.treeRoutes() {
+		tree(route.treeMethod()).addRoute(route.Path, HandlersChain{route.HandlerFunc})
+	}
+	for _, route := range routes {
+		func() {
+			defer func() {
+				if err := recover(); err != nil {
+					conflicts = append(conflicts, fmt.Sprintf("%s %s: %v", route.Method, route.Path, err))
+				}
+			}()
+			if engine.DuplicateRoutes != DuplicateRoutePanic && engine.routeNode(route.Method, route.Path) != nil {
+				return
+			}
+			tree(route.Method).addRoute(route.Path, HandlersChain{route.HandlerFunc})
+		}()
+	}
+	return conflicts
+}
+
+func mergeMeta(base, meta map[string]any) map[string]any {
+	if len(meta) == 0 {
+		return base
+	}
+	merged := make(map[string]any, len(base)+len(meta))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range meta {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,121 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func adminRoutes() *Routes {
+	routes := NewRoutes()
+	routes.Use(func(c *Context) {
+		c.Header("X-Bundle", "admin")
+	})
+	routes.GET("/users/:id", func(c *Context) {
+		c.String(http.StatusOK, "%s", "user "+c.Param("id"))
+	})
+	routes.WithMeta("audit", true).DELETE("/users/:id", func(c *Context) {
+		c.Status(http.StatusNoContent)
+	})
+	return routes
+}
+
+func TestRouterGroupAttach(t *testing.T) {
+	router := New()
+	api := router.Group("/api", func(c *Context) {
+		c.Header("X-Group", "api")
+	}).WithMeta("version", 1)
+
+	assert.NoError(t, api.Attach("/admin", adminRoutes()))
+
+	w := PerformRequest(router, http.MethodGet, "/api/admin/users/42")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "user 42", w.Body.String())
+	assert.Equal(t, "api", w.Header().Get("X-Group"))
+	assert.Equal(t, "admin", w.Header().Get("X-Bundle"))
+
+	route, _, _ := router.Lookup(http.MethodDelete, "/api/admin/users/42")
+	if assert.NotNil(t, route) {
+		assert.Equal(t, map[string]any{"version": 1, "audit": true}, route.Meta)
+	}
+	route, _, _ = router.Lookup(http.MethodGet, "/api/admin/users/42")
+	if assert.NotNil(t, route) {
+		assert.Equal(t, map[string]any{"version": 1}, route.Meta)
+	}
+
+	// the bundle can be mounted several times
+	assert.NoError(t, router.Attach("/admin", adminRoutes()))
+	w = PerformRequest(router, http.MethodGet, "/admin/users/1")
+	assert.Equal(t, "user 1", w.Body.String())
+	assert.Empty(t, w.Header().Get("X-Group"))
+}
+
+func TestRouterGroupAttachConflicts(t *testing.T) {
+	router := New()
+	router.GET("/admin/users/:name", func(c *Context) {})
+	router.DELETE("/admin/users/:id", func(c *Context) {})
+
+	err := router.Attach("/admin", adminRoutes())
+	var conflictErr *RouteConflictError
+	if assert.True(t, errors.As(err, &conflictErr)) {
+		assert.Len(t, conflictErr.Conflicts, 2)
+		assert.Contains(t, conflictErr.Conflicts[0], "GET /admin/users/:id: ':id' in new path")
+		assert.Contains(t, conflictErr.Conflicts[1], "DELETE /admin/users/:id: handlers are already registered")
+	}
+	assert.Contains(t, err.Error(), "attached routes conflict with registered routes")
+	assert.Len(t, router.Routes(), 2)
+
+	// duplicates are allowed by the replace policy
+	router = New()
+	router.DuplicateRoutes = DuplicateRouteReplace
+	router.GET("/admin/users/:id", func(c *Context) {})
+	assert.NoError(t, router.Attach("/admin", adminRoutes()))
+	w := PerformRequest(router, http.MethodGet, "/admin/users/7")
+	assert.Equal(t, "user 7", w.Body.String())
+}
+
+func TestRouterGroupAttachConstraintsAndExtensions(t *testing.T) {
+	var re string
+	routes := func() *Routes {
+		re = captureOutput(t, func() {
+			SetMode(DebugMode)
+			defer SetMode(TestMode)
+			NewRoutes()
+		})
+		routes := NewRoutes()
+		routes.GET(`/users/:id(\d+)`, func(c *Context) {
+			c.String(http.StatusOK, "user %s", c.Param("id"))
+		})
+		routes.WithMeta("by", "name").GET("/users/:id", func(c *Context) {
+			c.String(http.StatusOK, "name %s", c.Param("id"))
+		})
+		routes.WithExtensions(CatchAllExtensions{Param: "ext", Allowed: []string{".js"}}).GET("/assets/*path", func(c *Context) {
+			c.String(http.StatusOK, "asset %s", c.Param("ext"))
+		})
+		return routes
+	}()
+	assert.Empty(t, re, "the bundles don't print the debug mode warning")
+
+	router := New()
+	assert.NoError(t, router.Attach("/admin", routes))
+
+	w := PerformRequest(router, http.MethodGet, "/admin/users/42")
+	assert.Equal(t, "user 42", w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/admin/users/bob")
+	assert.Equal(t, "name bob", w.Body.String())
+	route, _, _ := router.Lookup(http.MethodGet, "/admin/users/bob")
+	if assert.NotNil(t, route) {
+		assert.Equal(t, map[string]any{"by": "name"}, route.Meta)
+	}
+
+	w = PerformRequest(router, http.MethodGet, "/admin/assets/app.js")
+	assert.Equal(t, "asset .js", w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/admin/assets/app.css")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
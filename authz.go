@@ -0,0 +1,174 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"sync"
+)
+
+// PrincipalKey is the key under which authentication middleware store the Principal of
+// the request, see Context.Principal.
+const PrincipalKey = "_gin-gonic/gin/principal"
+
+// PermissionsMetaKey is the route metadata key holding the permissions a route requires,
+// see RouterGroup.RequirePermissions.
+const PermissionsMetaKey = "_gin-gonic/gin/permissions"
+
+var (
+	// ErrUnauthenticated is reported when a route requires permissions but the request has
+	// no principal. It is mapped to 401 Unauthorized by default, see Engine.MapError.
+	ErrUnauthenticated = errors.New("gin: authentication required")
+	// ErrForbidden is reported when the principal of the request lacks a permission required
+	// by the route. It is mapped to 403 Forbidden by default, see Engine.MapError.
+	ErrForbidden = errors.New("gin: permission denied")
+)
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	// ID identifies the caller, e.g. a user name.
+	ID string
+	// Roles are the roles granted to the caller.
+	Roles []string
+	// Attributes are any other attributes of the caller, for attribute-based policies.
+	Attributes map[string]any
+}
+
+// SetPrincipal stores the authenticated caller of the request, see Context.Principal.
+func (c *Context) SetPrincipal(principal *Principal) {
+	c.Set(PrincipalKey, principal)
+}
+
+// Principal returns the authenticated caller of the request, as stored by SetPrincipal.
+// If there is none but BasicAuth authenticated the request, a principal without roles is
+// returned for the user. Otherwise Principal returns nil.
+func (c *Context) Principal() *Principal {
+	if value, ok := c.Get(PrincipalKey); ok {
+		if principal, ok := value.(*Principal); ok {
+			return principal
+		}
+	}
+	if user := c.GetString(AuthUserKey); user != "" {
+		return &Principal{ID: user}
+	}
+	return nil
+}
+
+// Policy decides whether a principal holds a permission, for the Authorize middleware.
+// The context gives access to the request attributes, for attribute-based decisions.
+type Policy interface {
+	Allowed(c *Context, principal *Principal, permission string) (bool, error)
+}
+
+// PolicyFunc is an adapter to use an ordinary function as a Policy.
+type PolicyFunc func(c *Context, principal *Principal, permission string) (bool, error)
+
+// Allowed calls f(c, principal, permission).
+func (f PolicyFunc) Allowed(c *Context, principal *Principal, permission string) (bool, error) {
+	return f(c, principal, permission)
+}
+
+// RequirePermissions returns a group, with the same path and middleware, whose routes require
+// all the permissions, on top of the ones required by group. The permissions are enforced by
+// the Authorize middleware.
+//
+//	router.Use(gin.Authorize(policy))
+//	router.Group("/admin").RequirePermissions("users:write").DELETE("/users/:id", deleteUser)
+func (group *RouterGroup) RequirePermissions(permissions ...string) *RouterGroup {
+	required, _ := group.meta[PermissionsMetaKey].([]string)
+	merged := make([]string, 0, len(required)+len(permissions))
+	merged = append(merged, required...)
+	for _, permission := range permissions {
+		if !containsString(merged, permission) {
+			merged = append(merged, permission)
+		}
+	}
+	return group.WithMeta(PermissionsMetaKey, merged)
+}
+
+// Authorize returns a middleware enforcing the permissions required by the routes, see
+// RouterGroup.RequirePermissions, with policy. Requests without principal fail with
+// ErrUnauthenticated, requests whose principal lacks a permission fail with ErrForbidden.
+// Denials are rendered with Context.Fail, so they go through the error mappings and the
+// error renderer of the engine.
+func Authorize(policy Policy) HandlerFunc {
+	assert1(policy != nil, "policy can not be nil")
+	return func(c *Context) {
+		meta, _ := c.RouteMeta(PermissionsMetaKey)
+		permissions, _ := meta.([]string)
+		if len(permissions) == 0 {
+			return
+		}
+		principal := c.Principal()
+		if principal == nil {
+			c.Fail(ErrUnauthenticated)
+			return
+		}
+		for _, permission := range permissions {
+			allowed, err := policy.Allowed(c, principal, permission)
+			if err != nil {
+				c.Fail(err)
+				return
+			}
+			if !allowed {
+				c.Fail(&Error{Err: ErrForbidden, Type: ErrorTypePrivate, Meta: H{"permission": permission}})
+				return
+			}
+		}
+	}
+}
+
+// RBAC is a role-based Policy: a principal holds a permission if one of its roles was
+// granted it. The "*" permission grants every permission. It is safe for concurrent use,
+// so roles can be granted while serving requests.
+type RBAC struct {
+	mu    sync.RWMutex
+	roles map[string]map[string]struct{}
+}
+
+// NewRBAC returns a new RBAC policy without roles.
+func NewRBAC() *RBAC {
+	return &RBAC{roles: make(map[string]map[string]struct{})}
+}
+
+// Grant grants the permissions to role and returns the policy, to chain the grants.
+func (r *RBAC) Grant(role string, permissions ...string) *RBAC {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	granted, ok := r.roles[role]
+	if !ok {
+		granted = make(map[string]struct{}, len(permissions))
+		r.roles[role] = granted
+	}
+	for _, permission := range permissions {
+		granted[permission] = struct{}{}
+	}
+	return r
+}
+
+// Revoke revokes the permissions from role.
+func (r *RBAC) Revoke(role string, permissions ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, permission := range permissions {
+		delete(r.roles[role], permission)
+	}
+}
+
+// Allowed implements Policy.
+func (r *RBAC) Allowed(_ *Context, principal *Principal, permission string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, role := range principal.Roles {
+		granted := r.roles[role]
+		if _, ok := granted[permission]; ok {
+			return true, nil
+		}
+		if _, ok := granted["*"]; ok {
+			return true, nil
+		}
+	}
+	return false, nil
+}
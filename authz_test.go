@@ -0,0 +1,82 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizeRBAC(t *testing.T) {
+	policy := NewRBAC().
+		Grant("editor", "posts:read", "posts:write").
+		Grant("admin", "*")
+
+	router := New()
+	router.Use(func(c *Context) {
+		if user := c.GetHeader("X-User"); user != "" {
+			c.SetPrincipal(&Principal{ID: user, Roles: []string{c.GetHeader("X-Role")}})
+		}
+	})
+	router.Use(Authorize(policy))
+	router.GET("/public", func(c *Context) { c.String(http.StatusOK, "%s", "public") })
+	posts := router.Group("/posts").RequirePermissions("posts:read")
+	posts.GET("", func(c *Context) { c.String(http.StatusOK, "%s", "posts") })
+	posts.RequirePermissions("posts:write", "posts:read").DELETE("/:id", func(c *Context) {
+		c.String(http.StatusOK, "%s", "deleted by "+c.Principal().ID)
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/public")
+	assert.Equal(t, "public", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/posts")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, `{"error":"gin: authentication required"}`, w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/posts", header{"X-User", "ann"}, header{"X-Role", "editor"})
+	assert.Equal(t, "posts", w.Body.String())
+
+	w = PerformRequest(router, http.MethodDelete, "/posts/1", header{"X-User", "bob"}, header{"X-Role", "viewer"})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, `{"error":"gin: permission denied","permission":"posts:read"}`, w.Body.String())
+
+	w = PerformRequest(router, http.MethodDelete, "/posts/1", header{"X-User", "root"}, header{"X-Role", "admin"})
+	assert.Equal(t, "deleted by root", w.Body.String())
+
+	policy.Revoke("editor", "posts:read")
+	w = PerformRequest(router, http.MethodGet, "/posts", header{"X-User", "ann"}, header{"X-Role", "editor"})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	assert.Panics(t, func() { Authorize(nil) })
+}
+
+func TestAuthorizeABAC(t *testing.T) {
+	errPolicy := errors.New("policy unavailable")
+	router := New()
+	router.MapError(ErrForbidden, http.StatusNotFound)
+	router.Use(BasicAuth(Accounts{"ann": "pass", "bob": "pass"}))
+	router.Use(Authorize(PolicyFunc(func(c *Context, principal *Principal, permission string) (bool, error) {
+		if principal.ID == "bob" {
+			return false, errPolicy
+		}
+		return permission == "docs:read" && c.Param("owner") == principal.ID, nil
+	})))
+	router.RequirePermissions("docs:read").GET("/docs/:owner", func(c *Context) {
+		c.String(http.StatusOK, "%s", "doc")
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/docs/ann", header{"Authorization", authorizationHeader("ann", "pass")})
+	assert.Equal(t, "doc", w.Body.String())
+
+	// denials go through the error mappings of the engine
+	w = PerformRequest(router, http.MethodGet, "/docs/bob", header{"Authorization", authorizationHeader("ann", "pass")})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/docs/bob", header{"Authorization", authorizationHeader("bob", "pass")})
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
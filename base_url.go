@@ -0,0 +1,123 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net"
+	"strings"
+)
+
+// fromTrustedProxy reports whether the request was sent by a proxy trusted explicitly, see
+// Engine.SetTrustedProxies. The default proxies, every address, are not trusted with the
+// forwarded scheme and host, which any client could set otherwise.
+func (c *Context) fromTrustedProxy() bool {
+	trustedCIDRs := c.engine.trustedCIDRs
+	if c.tenant != nil && c.tenant.trustedCIDRs != nil {
+		trustedCIDRs = c.tenant.trustedCIDRs
+	} else if !c.engine.trustedExplicit {
+		return false
+	}
+	remoteIP := net.ParseIP(c.RemoteIP())
+	if remoteIP == nil {
+		return false
+	}
+	return containsIP(trustedCIDRs, remoteIP)
+}
+
+// forwardedParam returns the param of the first element of the Forwarded header (RFC 7239).
+func (c *Context) forwardedParam(name string) string {
+	forwarded := c.requestHeader("Forwarded")
+	if forwarded == "" {
+		return ""
+	}
+	first, _, _ := strings.Cut(forwarded, ",")
+	for _, pair := range strings.Split(first, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if ok && strings.EqualFold(key, name) {
+			return strings.Trim(value, `"`)
+		}
+	}
+	return ""
+}
+
+// firstHeaderValue returns the first value of a comma separated header, as set by the
+// proxy closest to the client.
+func (c *Context) firstHeaderValue(key string) string {
+	value, _, _ := strings.Cut(c.requestHeader(key), ",")
+	return strings.TrimSpace(value)
+}
+
+// forwardedProto returns the scheme forwarded by a trusted proxy, if any.
+func (c *Context) forwardedProto() string {
+	if !c.fromTrustedProxy() {
+		return ""
+	}
+	if proto := c.forwardedParam("proto"); proto != "" {
+		return strings.ToLower(proto)
+	}
+	if proto := c.firstHeaderValue("X-Forwarded-Proto"); proto != "" {
+		return strings.ToLower(proto)
+	}
+	if strings.EqualFold(c.requestHeader("X-Forwarded-Ssl"), "on") {
+		return "https"
+	}
+	return ""
+}
+
+// forwardedHost returns the host forwarded by a trusted proxy, if any.
+func (c *Context) forwardedHost() string {
+	if !c.fromTrustedProxy() {
+		return ""
+	}
+	if host := c.forwardedParam("host"); host != "" {
+		return host
+	}
+	return c.firstHeaderValue("X-Forwarded-Host")
+}
+
+// Scheme returns the scheme the client used, http or https. When the request comes from a
+// proxy trusted explicitly, see Engine.SetTrustedProxies, the Forwarded, X-Forwarded-Proto
+// and X-Forwarded-Ssl headers are honored.
+func (c *Context) Scheme() string {
+	if proto := c.forwardedProto(); proto == "http" || proto == "https" {
+		return proto
+	}
+	if c.Request.TLS != nil {
+		return "https"
+	}
+	return "http"
+}
+
+// Host returns the host the client requested. When the request comes from a proxy trusted
+// explicitly, see Engine.SetTrustedProxies, the Forwarded and X-Forwarded-Host headers are
+// honored.
+func (c *Context) Host() string {
+	if host := c.forwardedHost(); host != "" {
+		return host
+	}
+	return c.Request.Host
+}
+
+// BaseURL returns the scheme and host the client used, e.g. https://example.com, resolved
+// through the trusted proxies, to build absolute URLs.
+func (c *Context) BaseURL() string {
+	return c.Scheme() + "://" + c.Host()
+}
+
+// AbsoluteURLFor returns the absolute URL of the route name, see Engine.URLFor and
+// Context.BaseURL.
+func (c *Context) AbsoluteURLFor(name string, params map[string]string) (string, error) {
+	path, err := c.engine.URLFor(name, params)
+	if err != nil {
+		return "", err
+	}
+	return c.BaseURL() + path, nil
+}
+
+// isForwarded reports whether a proxy trusted explicitly forwarded the scheme or host of the
+// request, the redirects being relative otherwise.
+func (c *Context) isForwarded() bool {
+	return c.forwardedProto() != "" || c.forwardedHost() != ""
+}
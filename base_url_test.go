@@ -0,0 +1,109 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createBaseURLContext(t *testing.T, remoteAddr string, headers map[string]string) *Context {
+	c, _ := CreateTestContext(httptest.NewRecorder())
+	assert.NoError(t, c.engine.SetTrustedProxies([]string{"10.0.0.0/8"}))
+	c.Request, _ = http.NewRequest(http.MethodGet, "/path", nil)
+	c.Request.Host = "internal:8080"
+	c.Request.RemoteAddr = remoteAddr
+	for k, v := range headers {
+		c.Request.Header.Set(k, v)
+	}
+	return c
+}
+
+func TestContextBaseURL(t *testing.T) {
+	c := createBaseURLContext(t, "10.0.0.1:1234", nil)
+	assert.Equal(t, "http://internal:8080", c.BaseURL())
+
+	c.Request.TLS = &tls.ConnectionState{}
+	assert.Equal(t, "https://internal:8080", c.BaseURL())
+
+	c = createBaseURLContext(t, "10.0.0.1:1234", map[string]string{
+		"X-Forwarded-Proto": "HTTPS, http",
+		"X-Forwarded-Host":  "example.com, internal",
+	})
+	assert.Equal(t, "https://example.com", c.BaseURL())
+
+	c = createBaseURLContext(t, "10.0.0.1:1234", map[string]string{"X-Forwarded-Ssl": "on"})
+	assert.Equal(t, "https://internal:8080", c.BaseURL())
+
+	c = createBaseURLContext(t, "10.0.0.1:1234", map[string]string{
+		"Forwarded":         `for=1.2.3.4;proto=https;host="example.org", for=10.0.0.2`,
+		"X-Forwarded-Proto": "http",
+	})
+	assert.Equal(t, "https://example.org", c.BaseURL())
+
+	// headers of untrusted clients are ignored
+	c = createBaseURLContext(t, "1.2.3.4:1234", map[string]string{
+		"X-Forwarded-Proto": "https",
+		"X-Forwarded-Host":  "evil.com",
+	})
+	assert.Equal(t, "http://internal:8080", c.BaseURL())
+	assert.False(t, c.isForwarded())
+
+	// the proxies trusted by default are not trusted with the forwarded headers
+	c, _ = CreateTestContext(httptest.NewRecorder())
+	c.Request, _ = http.NewRequest(http.MethodGet, "/path", nil)
+	c.Request.Host = "internal:8080"
+	c.Request.RemoteAddr = "1.2.3.4:1234"
+	c.Request.Header.Set("X-Forwarded-Host", "evil.com")
+	c.Request.Header.Set("Forwarded", "proto=https")
+	assert.Equal(t, "http://internal:8080", c.BaseURL())
+	assert.False(t, c.isForwarded())
+}
+
+func TestContextAbsoluteURLFor(t *testing.T) {
+	c := createBaseURLContext(t, "10.0.0.1:1234", map[string]string{
+		"X-Forwarded-Proto": "https",
+		"X-Forwarded-Host":  "example.com",
+	})
+	c.engine.Named("user").GET("/users/:id", func(*Context) {})
+
+	url, err := c.AbsoluteURLFor("user", map[string]string{"id": "42"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/users/42", url)
+
+	_, err = c.AbsoluteURLFor("missing", nil)
+	assert.Error(t, err)
+}
+
+func TestRedirectTrailingSlashForwarded(t *testing.T) {
+	router := New()
+	assert.NoError(t, router.SetTrustedProxies([]string{"192.0.2.0/24"}))
+	router.GET("/path/", func(*Context) {})
+
+	// PerformRequest uses 192.0.2.1 as remote address
+	w := PerformRequest(router, http.MethodGet, "/path",
+		header{"X-Forwarded-Proto", "https"}, header{"X-Forwarded-Host", "example.com"})
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "https://example.com/path/", w.Header().Get("Location"))
+
+	w = PerformRequest(router, http.MethodGet, "/path")
+	assert.Equal(t, "/path/", w.Header().Get("Location"))
+
+	router.RedirectTrailingSlash = false
+	router.RedirectFixedPath = true
+	w = PerformRequest(router, http.MethodGet, "/PATH/",
+		header{"X-Forwarded-Proto", "https"}, header{"X-Forwarded-Host", "example.com"})
+	assert.Equal(t, "https://example.com/path/", w.Header().Get("Location"))
+
+	router = New()
+	router.GET("/foo", func(*Context) {})
+	w = PerformRequest(router, http.MethodGet, "/foo/", header{"X-Forwarded-Host", "evil.com"})
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/foo", w.Header().Get("Location"), "the redirects stay relative with the default proxies")
+}
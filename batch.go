@@ -0,0 +1,169 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// BatchRequest is a sub-request of a batch.
+type BatchRequest struct {
+	// Method is the method of the sub-request. Default value is GET.
+	Method string `json:"method,omitempty"`
+	// Path is the path of the sub-request, with its query, e.g. "/users/42?fields=name".
+	Path string `json:"path"`
+	// Header are the headers of the sub-request, added to the headers inherited from the
+	// batch request.
+	Header map[string]string `json:"headers,omitempty"`
+	// Body is the JSON body of the sub-request.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// BatchResponse is the response to a sub-request of a batch.
+type BatchResponse struct {
+	// Status is the status code of the response.
+	Status int `json:"status"`
+	// Header are the headers of the response.
+	Header http.Header `json:"headers,omitempty"`
+	// Body is the body of the response, embedded as is when it is JSON, as a string otherwise.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// BatchConfig defines the config for Batch.
+type BatchConfig struct {
+	// MaxRequests is the maximum number of sub-requests of a batch, above which the batch
+	// is rejected with a 413. Optional. Default value is 20.
+	MaxRequests int
+
+	// Concurrency is the number of sub-requests of a batch served concurrently.
+	// Optional. Default value is 4.
+	Concurrency int
+
+	// InheritHeaders are the headers of the batch request copied to every sub-request, e.g.
+	// Authorization, so that the sub-requests are authenticated like the batch.
+	// Optional. Default value is Authorization and Cookie.
+	InheritHeaders []string
+}
+
+var errBatchPath = errors.New("gin: batch sub-request path must begin with '/'")
+
+// Batch returns a handler serving a batch of sub-requests, posted as a JSON array of
+// BatchRequest, with the routes of the engine, as if sent by the client of the batch. The
+// sub-requests are served concurrently, up to Concurrency at once, and the handler replies
+// with the JSON array of their BatchResponse, in the order of the sub-requests.
+//
+//	router.POST("/batch", gin.Batch(gin.BatchConfig{}))
+func Batch(conf BatchConfig) HandlerFunc {
+	if conf.MaxRequests <= 0 {
+		conf.MaxRequests = 20
+	}
+	if conf.Concurrency <= 0 {
+		conf.Concurrency = 4
+	}
+	if conf.InheritHeaders == nil {
+		conf.InheritHeaders = []string{"Authorization", "Cookie"}
+	}
+
+	return func(c *Context) {
+		var batch []BatchRequest
+		if err := c.ShouldBindJSON(&batch); err != nil {
+			c.AbortWithError(http.StatusBadRequest, err).SetType(ErrorTypeBind) // nolint: errcheck
+			return
+		}
+		if len(batch) > conf.MaxRequests {
+			c.AbortWithError(http.StatusRequestEntityTooLarge, // nolint: errcheck
+				fmt.Errorf("gin: batch of %d requests, at most %d allowed", len(batch), conf.MaxRequests))
+			return
+		}
+
+		requests := make([]*http.Request, len(batch))
+		for i, sub := range batch {
+			req, err := newBatchRequest(c, sub, conf.InheritHeaders)
+			if err != nil {
+				c.AbortWithError(http.StatusBadRequest, fmt.Errorf("request %d: %w", i, err)) // nolint: errcheck
+				return
+			}
+			requests[i] = req
+		}
+
+		responses := make([]BatchResponse, len(requests))
+		sem := make(chan struct{}, conf.Concurrency)
+		var wg sync.WaitGroup
+		for i, req := range requests {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(i int, req *http.Request) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				responses[i] = c.engine.serveBatchRequest(req)
+			}(i, req)
+		}
+		wg.Wait()
+
+		c.JSON(http.StatusOK, responses)
+	}
+}
+
+// newBatchRequest builds the request of sub, received from the client of the batch c.
+func newBatchRequest(c *Context, sub BatchRequest, inherit []string) (*http.Request, error) {
+	if !strings.HasPrefix(sub.Path, "/") {
+		return nil, errBatchPath
+	}
+	if sub.Path == c.Request.URL.Path || strings.HasPrefix(sub.Path, c.Request.URL.Path+"?") {
+		return nil, fmt.Errorf("gin: batch sub-request to the batch endpoint %s", c.Request.URL.Path)
+	}
+	method := sub.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	req, err := http.NewRequestWithContext(c.Request.Context(), strings.ToUpper(method), sub.Path, bytes.NewReader(sub.Body))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range inherit {
+		if values := c.Request.Header.Values(key); len(values) > 0 {
+			req.Header[http.CanonicalHeaderKey(key)] = append([]string(nil), values...)
+		}
+	}
+	for key, value := range sub.Header {
+		req.Header.Set(key, value)
+	}
+	if len(sub.Body) > 0 && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", MIMEJSON)
+	}
+
+	req.RequestURI = req.URL.RequestURI()
+	req.Host = c.Request.Host
+	req.RemoteAddr = c.Request.RemoteAddr
+	req.TLS = c.Request.TLS
+	req.Proto, req.ProtoMajor, req.ProtoMinor = c.Request.Proto, c.Request.ProtoMajor, c.Request.ProtoMinor
+	return req, nil
+}
+
+// serveBatchRequest serves req in memory and returns its response.
+func (engine *Engine) serveBatchRequest(req *http.Request) BatchResponse {
+	w := newMemoryResponseWriter(req)
+	engine.ServeHTTP(w, req)
+	w.finish()
+
+	resp := BatchResponse{Status: w.code, Header: w.sentHeader}
+	if body := w.body.Bytes(); len(body) > 0 {
+		if json.Valid(body) {
+			resp.Body = body
+		} else {
+			resp.Body, _ = json.Marshal(string(body))
+		}
+	}
+	return resp
+}
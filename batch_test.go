@@ -0,0 +1,101 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func performBatch(r *Engine, body string, headers ...header) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/batch", strings.NewReader(body))
+	for _, h := range headers {
+		req.Header.Add(h.Key, h.Value)
+	}
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestBatch(t *testing.T) {
+	r := New()
+	r.POST("/batch", Batch(BatchConfig{}))
+	r.GET("/users/:id", func(c *Context) {
+		c.Header("X-User", c.Param("id"))
+		c.JSON(http.StatusOK, H{"id": c.Param("id"), "auth": c.GetHeader("Authorization"), "fields": c.Query("fields")})
+	})
+	r.POST("/users", func(c *Context) {
+		var user struct{ Name string }
+		if err := c.ShouldBindJSON(&user); err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		c.String(http.StatusCreated, "created %s %s", user.Name, c.GetHeader("X-Request"))
+	})
+
+	w := performBatch(r, `[
+		{"path": "/users/42?fields=name"},
+		{"method": "post", "path": "/users", "headers": {"X-Request": "1"}, "body": {"name": "gopher"}},
+		{"method": "DELETE", "path": "/users/42"},
+		{"path": "/missing"}
+	]`, header{"Authorization", "Bearer token"})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var responses []BatchResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &responses))
+	assert.Len(t, responses, 4)
+
+	assert.Equal(t, http.StatusOK, responses[0].Status)
+	assert.Equal(t, "42", responses[0].Header.Get("X-User"))
+	assert.JSONEq(t, `{"id": "42", "auth": "Bearer token", "fields": "name"}`, string(responses[0].Body))
+
+	assert.Equal(t, http.StatusCreated, responses[1].Status)
+	assert.Equal(t, `"created gopher 1"`, string(responses[1].Body))
+
+	assert.Equal(t, http.StatusNotFound, responses[2].Status)
+	assert.Equal(t, http.StatusNotFound, responses[3].Status)
+}
+
+func TestBatchConcurrency(t *testing.T) {
+	r := New()
+	r.POST("/batch", Batch(BatchConfig{Concurrency: 2}))
+	var running, max int32
+	r.GET("/slow", func(c *Context) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+		c.Status(http.StatusNoContent)
+	})
+
+	w := performBatch(r, `[{"path":"/slow"},{"path":"/slow"},{"path":"/slow"},{"path":"/slow"},{"path":"/slow"}]`)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&max))
+	assert.Equal(t, strings.Repeat(`{"status":204},`, 4)+`{"status":204}`, strings.Trim(w.Body.String(), "[]"))
+}
+
+func TestBatchInvalid(t *testing.T) {
+	r := New()
+	r.POST("/batch", Batch(BatchConfig{MaxRequests: 2}))
+
+	assert.Equal(t, http.StatusBadRequest, performBatch(r, `{"path": "/"}`).Code)
+	assert.Equal(t, http.StatusBadRequest, performBatch(r, `[{"path": "users"}]`).Code)
+	assert.Equal(t, http.StatusBadRequest, performBatch(r, `[{"method": "POST", "path": "/batch"}]`).Code)
+	assert.Equal(t, http.StatusBadRequest, performBatch(r, `[{"method": "BAD METHOD", "path": "/"}]`).Code)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, performBatch(r, `[{"path": "/"}, {"path": "/"}, {"path": "/"}]`).Code)
+	assert.Equal(t, "[]", performBatch(r, `[]`).Body.String())
+}
@@ -6,8 +6,11 @@ package gin
 
 import (
 	"html/template"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -59,6 +62,53 @@ func BenchmarkOneRouteJSON(B *testing.B) {
 	runRequest(B, router, "GET", "/json")
 }
 
+func BenchmarkOneRouteJSONFastPath(B *testing.B) {
+	router := New()
+	router.EnableResponseFastPath = true
+	data := struct {
+		Status string `json:"status"`
+	}{"ok"}
+	router.GET("/json", func(c *Context) {
+		c.JSON(http.StatusOK, data)
+	})
+	runRequest(B, router, "GET", "/json")
+}
+
+func BenchmarkServerJSON(B *testing.B) {
+	runServerJSON(B, false, 3000)
+}
+
+func BenchmarkServerJSONFastPath(B *testing.B) {
+	runServerJSON(B, true, 3000)
+}
+
+// runServerJSON benchmarks a JSON response of about size bytes sent by a server over the
+// loopback, so that the writes of the server are measured.
+func runServerJSON(B *testing.B, fastPath bool, size int) {
+	router := New()
+	router.EnableResponseFastPath = fastPath
+	data := struct {
+		Status string `json:"status"`
+	}{strings.Repeat("o", size)}
+	router.GET("/json", func(c *Context) {
+		c.JSON(http.StatusOK, data)
+	})
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+	client := srv.Client()
+
+	B.ReportAllocs()
+	B.ResetTimer()
+	for i := 0; i < B.N; i++ {
+		resp, err := client.Get(srv.URL + "/json")
+		if err != nil {
+			B.Fatal(err)
+		}
+		io.Copy(io.Discard, resp.Body) // nolint: errcheck
+		resp.Body.Close()
+	}
+}
+
 func BenchmarkOneRouteHTML(B *testing.B) {
 	router := New()
 	t := template.Must(template.New("index").Parse(`
@@ -158,3 +208,37 @@ func runRequest(B *testing.B, r *Engine, method, path string) {
 		r.ServeHTTP(w, req)
 	}
 }
+
+func BenchmarkStaticRouteTreeWalk(B *testing.B) {
+	router := New()
+	for _, route := range githubAPI {
+		router.Handle(route.method, route.path, func(c *Context) {})
+	}
+	runRequest(B, router, "GET", "/user/repos")
+}
+
+func BenchmarkStaticRouteFastPath(B *testing.B) {
+	router := New()
+	router.EnableStaticFastPath = true
+	for _, route := range githubAPI {
+		router.Handle(route.method, route.path, func(c *Context) {})
+	}
+	runRequest(B, router, "GET", "/user/repos")
+}
+
+func BenchmarkGithubParamRoute(B *testing.B) {
+	router := New()
+	for _, route := range githubAPI {
+		router.Handle(route.method, route.path, func(c *Context) {})
+	}
+	runRequest(B, router, "GET", "/repos/gin-gonic/gin/issues/42/comments")
+}
+
+func BenchmarkGithubParamRouteFrozen(B *testing.B) {
+	router := New()
+	for _, route := range githubAPI {
+		router.Handle(route.method, route.path, func(c *Context) {})
+	}
+	router.Freeze()
+	runRequest(B, router, "GET", "/repos/gin-gonic/gin/issues/42/comments")
+}
@@ -6,9 +6,9 @@ package binding
 
 import (
 	"errors"
-	"io/ioutil"
 	"net/http"
 
+	"github.com/gin-gonic/gin/internal/bufpool"
 	"google.golang.org/protobuf/proto"
 )
 
@@ -19,11 +19,12 @@ func (protobufBinding) Name() string {
 }
 
 func (b protobufBinding) Bind(req *http.Request, obj any) error {
-	buf, err := ioutil.ReadAll(req.Body)
-	if err != nil {
+	buf := bufpool.Get(int(req.ContentLength))
+	defer bufpool.Put(buf)
+	if _, err := buf.ReadFrom(req.Body); err != nil {
 		return err
 	}
-	return b.BindBody(buf, obj)
+	return b.BindBody(buf.Bytes(), obj)
 }
 
 func (protobufBinding) BindBody(body []byte, obj any) error {
@@ -0,0 +1,172 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// brokerBufferSize is the number of messages buffered per subscriber.
+const brokerBufferSize = 16
+
+// Broker fans out messages published on a topic to every request subscribed to it.
+// It backs Context.LongPoll and Context.StreamTopic and is safe for concurrent use.
+type Broker struct {
+	mu     sync.Mutex
+	topics map[string]map[chan any]struct{}
+
+	// bus, if set, delivers its payloads to the subscribers of the topics, subscribed to
+	// while they have subscribers
+	bus          EventBus
+	unsubscribes map[string]func()
+}
+
+// NewBroker returns a new, empty Broker.
+func NewBroker() *Broker {
+	return &Broker{topics: make(map[string]map[chan any]struct{})}
+}
+
+// Subscribe registers a subscriber on topic. It returns the channel receiving the messages
+// and a function that must be called to unsubscribe once the subscriber is done.
+func (b *Broker) Subscribe(topic string) (<-chan any, func()) {
+	ch := make(chan any, brokerBufferSize)
+
+	b.mu.Lock()
+	subscribers, ok := b.topics[topic]
+	if !ok {
+		subscribers = make(map[chan any]struct{})
+		b.topics[topic] = subscribers
+		if b.bus != nil {
+			b.subscribeBus(topic)
+		}
+	}
+	subscribers[ch] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(subscribers, ch)
+			if len(b.topics[topic]) == 0 {
+				delete(b.topics, topic)
+				if unsubscribe := b.unsubscribes[topic]; unsubscribe != nil {
+					delete(b.unsubscribes, topic)
+					unsubscribe()
+				}
+			}
+			b.mu.Unlock()
+		})
+	}
+}
+
+// subscribeBus subscribes the broker to topic on its bus. It must be called with b.mu held.
+func (b *Broker) subscribeBus(topic string) {
+	unsubscribe, err := b.bus.Subscribe(topic, func(topic string, payload any) {
+		b.Publish(topic, payload)
+	})
+	if err != nil {
+		debugPrint("[WARNING] Broker failed to subscribe to %s: %v\n", topic, err)
+		return
+	}
+	if b.unsubscribes == nil {
+		b.unsubscribes = make(map[string]func())
+	}
+	b.unsubscribes[topic] = unsubscribe
+}
+
+// Publish sends message to every subscriber of topic and returns the number of subscribers
+// it was delivered to. Publish never blocks: slow subscribers whose buffer is full miss the message.
+// The message is only delivered to the subscribers of this broker, publish it with
+// Engine.Publish to reach the subscribers of every instance sharing the event bus.
+func (b *Broker) Publish(topic string, message any) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delivered := 0
+	for ch := range b.topics[topic] {
+		select {
+		case ch <- message:
+			delivered++
+		default:
+		}
+	}
+	return delivered
+}
+
+// Subscribers returns the number of subscribers of topic.
+func (b *Broker) Subscribers(topic string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.topics[topic])
+}
+
+// Broker returns the broker shared by all the requests served by the engine. It receives
+// the payloads published on the event bus of the engine, see Engine.Publish.
+func (engine *Engine) Broker() *Broker {
+	engine.brokerOnce.Do(func() {
+		if engine.broker == nil {
+			engine.broker = NewBroker()
+			engine.broker.bus = engine.EventBus()
+		}
+	})
+	return engine.broker
+}
+
+// LongPoll parks the request until a message is published on topic using the engine broker,
+// and returns it. If no message arrives before timeout, or the engine starts draining, a
+// 204 No Content is written and false is returned. False is also returned if the client
+// goes away.
+//
+//	router.GET("/poll", func(c *gin.Context) {
+//	    if msg, ok := c.LongPoll("news", 30*time.Second); ok {
+//	        c.JSON(http.StatusOK, msg)
+//	    }
+//	})
+func (c *Context) LongPoll(topic string, timeout time.Duration) (any, bool) {
+	messages, unsubscribe := c.engine.Broker().Subscribe(topic)
+	defer unsubscribe()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case msg := <-messages:
+		return msg, true
+	case <-timer.C:
+		c.Status(http.StatusNoContent)
+		c.Writer.WriteHeaderNow()
+		return nil, false
+	case <-c.Request.Context().Done():
+		return nil, false
+	case <-c.Draining():
+		c.Status(http.StatusNoContent)
+		c.Writer.WriteHeaderNow()
+		return nil, false
+	}
+}
+
+// StreamTopic streams the messages published on topic using the engine broker as
+// Server-Sent Events named after the topic, until the client goes away or the engine starts
+// draining.
+func (c *Context) StreamTopic(topic string) {
+	messages, unsubscribe := c.engine.Broker().Subscribe(topic)
+	defer unsubscribe()
+
+	done, drain := c.Request.Context().Done(), c.Draining()
+	for {
+		select {
+		case msg := <-messages:
+			c.SSEvent(topic, msg)
+			c.Writer.Flush()
+		case <-done:
+			return
+		case <-drain:
+			return
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBroker(t *testing.T) {
+	broker := NewBroker()
+	assert.Equal(t, 0, broker.Publish("news", "nobody"))
+
+	first, unsubscribeFirst := broker.Subscribe("news")
+	second, unsubscribeSecond := broker.Subscribe("news")
+	assert.Equal(t, 2, broker.Subscribers("news"))
+
+	assert.Equal(t, 2, broker.Publish("news", "hello"))
+	assert.Equal(t, "hello", <-first)
+	assert.Equal(t, "hello", <-second)
+
+	unsubscribeFirst()
+	unsubscribeFirst()
+	assert.Equal(t, 1, broker.Subscribers("news"))
+	unsubscribeSecond()
+	assert.Equal(t, 0, broker.Subscribers("news"))
+	assert.Empty(t, broker.topics)
+
+	// publishing never blocks on a full subscriber
+	_, unsubscribe := broker.Subscribe("full")
+	defer unsubscribe()
+	for i := 0; i < brokerBufferSize; i++ {
+		assert.Equal(t, 1, broker.Publish("full", i))
+	}
+	assert.Equal(t, 0, broker.Publish("full", "dropped"))
+}
+
+func TestContextLongPoll(t *testing.T) {
+	router := New()
+	router.GET("/poll", func(c *Context) {
+		if msg, ok := c.LongPoll("news", time.Second); ok {
+			c.String(http.StatusOK, "%v", msg)
+		}
+	})
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() {
+		done <- PerformRequest(router, http.MethodGet, "/poll")
+	}()
+	for router.Broker().Subscribers("news") == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	router.Broker().Publish("news", "breaking")
+
+	w := <-done
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "breaking", w.Body.String())
+	assert.Equal(t, 0, router.Broker().Subscribers("news"))
+}
+
+func TestContextLongPollTimeout(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := CreateTestContext(w)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/poll", nil)
+
+	msg, ok := c.LongPoll("news", time.Millisecond)
+	assert.False(t, ok)
+	assert.Nil(t, msg)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestContextLongPollClientGone(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := CreateTestContext(w)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	c.Request, _ = http.NewRequestWithContext(ctx, http.MethodGet, "/poll", nil)
+
+	_, ok := c.LongPoll("news", time.Minute)
+	assert.False(t, ok)
+	assert.False(t, c.Writer.Written())
+	assert.Equal(t, 0, c.engine.Broker().Subscribers("news"))
+}
+
+type notifyingRecorder struct {
+	*httptest.ResponseRecorder
+	written chan struct{}
+}
+
+func (r *notifyingRecorder) Flush() {
+	r.ResponseRecorder.Flush()
+	r.written <- struct{}{}
+}
+
+func TestContextStreamTopic(t *testing.T) {
+	w := &notifyingRecorder{httptest.NewRecorder(), make(chan struct{}, 1)}
+	c, router := CreateTestContext(w)
+	ctx, cancel := context.WithCancel(context.Background())
+	c.Request, _ = http.NewRequestWithContext(ctx, http.MethodGet, "/events", nil)
+
+	done := make(chan struct{})
+	go func() {
+		c.StreamTopic("news")
+		close(done)
+	}()
+	for router.Broker().Subscribers("news") == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	router.Broker().Publish("news", "hello")
+	<-w.written
+	cancel()
+	<-done
+
+	assert.Equal(t, "event:news\ndata:hello\n\n", w.Body.String())
+	assert.Equal(t, 0, router.Broker().Subscribers("news"))
+}
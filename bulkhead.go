@@ -0,0 +1,141 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"sort"
+	"sync/atomic"
+	"time"
+)
+
+// DependenciesMetaKey is the route metadata key holding the sorted names of the
+// dependencies of the route, see RouterGroup.WithDependencies.
+const DependenciesMetaKey = "_gin-gonic/gin/dependencies"
+
+// BulkheadPoolConfig defines the concurrency budget of a dependency.
+type BulkheadPoolConfig struct {
+	// Limit is the number of requests using the dependency served concurrently.
+	Limit int
+
+	// QueueTimeout is the time a request waits for the dependency when Limit is reached,
+	// before being rejected. Optional. By default, such requests are rejected at once.
+	QueueTimeout time.Duration
+}
+
+// BulkheadConfig defines the config for NewBulkhead.
+type BulkheadConfig struct {
+	// Pools are the concurrency budgets, by dependency name.
+	Pools map[string]BulkheadPoolConfig
+
+	// RejectCode is the status code of the rejected requests.
+	// Optional. Default value is 503.
+	RejectCode int
+}
+
+// BulkheadStats are the counters of a dependency pool of a Bulkhead.
+type BulkheadStats struct {
+	// Limit is the concurrency budget of the dependency.
+	Limit int
+	// InFlight is the number of requests using the dependency.
+	InFlight int
+	// Rejected is the number of requests rejected because the dependency was saturated.
+	Rejected uint64
+}
+
+type bulkheadPool struct {
+	config   BulkheadPoolConfig
+	sem      semaphore
+	rejected uint64
+}
+
+// Bulkhead isolates the dependencies of the routes, e.g. a database, a search engine or a
+// payment provider: each dependency has its own concurrency budget, so that the requests
+// piling up on a slow dependency are rejected instead of exhausting the capacity of the
+// whole server. Routes declare their dependencies with RouterGroup.WithDependencies.
+type Bulkhead struct {
+	pools      map[string]*bulkheadPool
+	rejectCode int
+}
+
+// NewBulkhead returns a Bulkhead with the pools of config.
+//
+//	bulkhead := gin.NewBulkhead(gin.BulkheadConfig{Pools: map[string]gin.BulkheadPoolConfig{
+//		"db":       {Limit: 50},
+//		"payments": {Limit: 10, QueueTimeout: time.Second},
+//	}})
+//	router.Use(bulkhead.Middleware())
+//	router.WithDependencies("db", "payments").POST("/orders", createOrder)
+func NewBulkhead(config BulkheadConfig) *Bulkhead {
+	if config.RejectCode == 0 {
+		config.RejectCode = http.StatusServiceUnavailable
+	}
+	b := &Bulkhead{pools: make(map[string]*bulkheadPool, len(config.Pools)), rejectCode: config.RejectCode}
+	for name, pool := range config.Pools {
+		assert1(pool.Limit > 0, "bulkhead pool "+name+" limit must be positive")
+		b.pools[name] = &bulkheadPool{config: pool, sem: make(semaphore, pool.Limit)}
+	}
+	return b
+}
+
+// Stats returns the counters of the pools, by dependency name.
+func (b *Bulkhead) Stats() map[string]BulkheadStats {
+	stats := make(map[string]BulkheadStats, len(b.pools))
+	for name, pool := range b.pools {
+		stats[name] = BulkheadStats{
+			Limit:    pool.config.Limit,
+			InFlight: len(pool.sem),
+			Rejected: atomic.LoadUint64(&pool.rejected),
+		}
+	}
+	return stats
+}
+
+// Middleware returns a middleware taking a slot of the pool of every dependency of the
+// matched route for the time of the request. The requests for which a pool is saturated
+// are aborted with RejectCode. Dependencies without a pool are not limited.
+func (b *Bulkhead) Middleware() HandlerFunc {
+	return func(c *Context) {
+		names, _ := c.RouteMeta(DependenciesMetaKey)
+		dependencies, _ := names.([]string)
+
+		// the dependencies are sorted, so that the pools are always taken in the same order
+		for _, name := range dependencies {
+			pool, ok := b.pools[name]
+			if !ok {
+				continue
+			}
+			if !pool.sem.acquire(c.Request.Context(), pool.config.QueueTimeout) {
+				if c.Request.Context().Err() != nil {
+					c.Abort()
+					return
+				}
+				atomic.AddUint64(&pool.rejected, 1)
+				c.Header("Retry-After", "1")
+				c.AbortWithStatus(b.rejectCode)
+				return
+			}
+			defer pool.sem.release()
+		}
+		c.Next()
+	}
+}
+
+// WithDependencies returns a group, with the same path and middleware, whose routes use
+// the dependencies names, on top of the dependencies of group, see Bulkhead.
+func (group *RouterGroup) WithDependencies(names ...string) *RouterGroup {
+	inherited, _ := group.meta[DependenciesMetaKey].([]string)
+	dependencies := append([]string(nil), inherited...)
+	for _, name := range names {
+		i := sort.SearchStrings(dependencies, name)
+		if i < len(dependencies) && dependencies[i] == name {
+			continue
+		}
+		dependencies = append(dependencies, "")
+		copy(dependencies[i+1:], dependencies[i:])
+		dependencies[i] = name
+	}
+	return group.WithMeta(DependenciesMetaKey, dependencies)
+}
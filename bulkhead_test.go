@@ -0,0 +1,72 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBulkhead(t *testing.T) {
+	bulkhead := NewBulkhead(BulkheadConfig{Pools: map[string]BulkheadPoolConfig{
+		"db":       {Limit: 2},
+		"payments": {Limit: 1},
+	}})
+	r := New()
+	r.Use(bulkhead.Middleware())
+
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	db := r.WithDependencies("db")
+	db.WithDependencies("payments", "db").POST("/orders", func(c *Context) {
+		started <- struct{}{}
+		<-release
+		c.Status(http.StatusCreated)
+	})
+	db.GET("/users", func(c *Context) {
+		c.Status(http.StatusOK)
+	})
+	r.WithDependencies("search").GET("/search", func(c *Context) {
+		c.Status(http.StatusOK)
+	})
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() {
+		done <- PerformRequest(r, http.MethodPost, "/orders")
+	}()
+	<-started
+
+	// the payments pool is saturated, the db pool is not
+	w := PerformRequest(r, http.MethodPost, "/orders")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+	assert.Equal(t, http.StatusOK, PerformRequest(r, http.MethodGet, "/users").Code)
+	assert.Equal(t, http.StatusOK, PerformRequest(r, http.MethodGet, "/search").Code)
+
+	assert.Equal(t, map[string]BulkheadStats{
+		"db":       {Limit: 2, InFlight: 1},
+		"payments": {Limit: 1, InFlight: 1, Rejected: 1},
+	}, bulkhead.Stats())
+
+	close(release)
+	assert.Equal(t, http.StatusCreated, (<-done).Code)
+	assert.Equal(t, 0, bulkhead.Stats()["db"].InFlight)
+	assert.Equal(t, 0, bulkhead.Stats()["payments"].InFlight)
+
+	for _, route := range r.Routes() {
+		if route.Path == "/orders" {
+			assert.Equal(t, []string{"db", "payments"}, route.Meta[DependenciesMetaKey])
+		}
+	}
+}
+
+func TestBulkheadInvalid(t *testing.T) {
+	assert.Panics(t, func() {
+		NewBulkhead(BulkheadConfig{Pools: map[string]BulkheadPoolConfig{"db": {}}})
+	})
+}
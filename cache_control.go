@@ -0,0 +1,165 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CacheControl builds a Cache-Control header, see Context.CacheControl and
+// DefaultCacheControl. The zero value has no directive.
+type CacheControl struct {
+	c                    *Context
+	maxAge               time.Duration
+	sMaxAge              time.Duration
+	staleWhileRevalidate time.Duration
+	staleIfError         time.Duration
+	flags                uint16
+}
+
+const (
+	ccMaxAge uint16 = 1 << iota
+	ccSMaxAge
+	ccStaleWhileRevalidate
+	ccStaleIfError
+	ccPublic
+	ccPrivate
+	ccNoCache
+	ccNoStore
+	ccMustRevalidate
+	ccProxyRevalidate
+	ccNoTransform
+	ccImmutable
+)
+
+// NewCacheControl returns an empty Cache-Control builder, e.g. for DefaultCacheControl.
+func NewCacheControl() *CacheControl {
+	return &CacheControl{}
+}
+
+// CacheControl returns a builder writing the Cache-Control header of the response after
+// every call, replacing any previous value:
+//
+//	c.CacheControl().MaxAge(10 * time.Minute).StaleWhileRevalidate(30 * time.Second).Private()
+func (c *Context) CacheControl() *CacheControl {
+	return &CacheControl{c: c}
+}
+
+func (cc *CacheControl) set(flag uint16) *CacheControl {
+	cc.flags |= flag
+	if cc.c != nil {
+		cc.c.Header("Cache-Control", cc.String())
+	}
+	return cc
+}
+
+// MaxAge sets the max-age directive, rounded down to the second.
+func (cc *CacheControl) MaxAge(d time.Duration) *CacheControl {
+	cc.maxAge = d
+	return cc.set(ccMaxAge)
+}
+
+// SMaxAge sets the s-maxage directive, the max-age of shared caches.
+func (cc *CacheControl) SMaxAge(d time.Duration) *CacheControl {
+	cc.sMaxAge = d
+	return cc.set(ccSMaxAge)
+}
+
+// StaleWhileRevalidate sets the stale-while-revalidate directive.
+func (cc *CacheControl) StaleWhileRevalidate(d time.Duration) *CacheControl {
+	cc.staleWhileRevalidate = d
+	return cc.set(ccStaleWhileRevalidate)
+}
+
+// StaleIfError sets the stale-if-error directive.
+func (cc *CacheControl) StaleIfError(d time.Duration) *CacheControl {
+	cc.staleIfError = d
+	return cc.set(ccStaleIfError)
+}
+
+// Public sets the public directive, replacing private.
+func (cc *CacheControl) Public() *CacheControl {
+	cc.flags &^= ccPrivate
+	return cc.set(ccPublic)
+}
+
+// Private sets the private directive, replacing public.
+func (cc *CacheControl) Private() *CacheControl {
+	cc.flags &^= ccPublic
+	return cc.set(ccPrivate)
+}
+
+// NoCache sets the no-cache directive.
+func (cc *CacheControl) NoCache() *CacheControl {
+	return cc.set(ccNoCache)
+}
+
+// NoStore sets the no-store directive.
+func (cc *CacheControl) NoStore() *CacheControl {
+	return cc.set(ccNoStore)
+}
+
+// MustRevalidate sets the must-revalidate directive.
+func (cc *CacheControl) MustRevalidate() *CacheControl {
+	return cc.set(ccMustRevalidate)
+}
+
+// ProxyRevalidate sets the proxy-revalidate directive.
+func (cc *CacheControl) ProxyRevalidate() *CacheControl {
+	return cc.set(ccProxyRevalidate)
+}
+
+// NoTransform sets the no-transform directive.
+func (cc *CacheControl) NoTransform() *CacheControl {
+	return cc.set(ccNoTransform)
+}
+
+// Immutable sets the immutable directive.
+func (cc *CacheControl) Immutable() *CacheControl {
+	return cc.set(ccImmutable)
+}
+
+// String returns the value of the Cache-Control header.
+func (cc *CacheControl) String() string {
+	directives := make([]string, 0, 4)
+	flag := func(f uint16, directive string) {
+		if cc.flags&f != 0 {
+			directives = append(directives, directive)
+		}
+	}
+	seconds := func(f uint16, directive string, d time.Duration) {
+		if cc.flags&f != 0 {
+			if d < 0 {
+				d = 0
+			}
+			directives = append(directives, directive+"="+strconv.FormatInt(int64(d/time.Second), 10))
+		}
+	}
+	flag(ccPublic, "public")
+	flag(ccPrivate, "private")
+	flag(ccNoCache, "no-cache")
+	flag(ccNoStore, "no-store")
+	seconds(ccMaxAge, "max-age", cc.maxAge)
+	seconds(ccSMaxAge, "s-maxage", cc.sMaxAge)
+	seconds(ccStaleWhileRevalidate, "stale-while-revalidate", cc.staleWhileRevalidate)
+	seconds(ccStaleIfError, "stale-if-error", cc.staleIfError)
+	flag(ccMustRevalidate, "must-revalidate")
+	flag(ccProxyRevalidate, "proxy-revalidate")
+	flag(ccNoTransform, "no-transform")
+	flag(ccImmutable, "immutable")
+	return strings.Join(directives, ", ")
+}
+
+// DefaultCacheControl returns a middleware setting the Cache-Control header built by cc
+// on the responses whose handlers did not set one, e.g. as the default of a group.
+//
+//	assets := router.Group("/assets", gin.DefaultCacheControl(gin.NewCacheControl().Public().MaxAge(24*time.Hour)))
+func DefaultCacheControl(cc *CacheControl) HandlerFunc {
+	return ResponseHeaders(HeaderPolicy{
+		SetIfAbsent: map[string]string{"Cache-Control": cc.String()},
+	})
+}
@@ -0,0 +1,48 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheControlString(t *testing.T) {
+	assert.Equal(t, "", NewCacheControl().String())
+	assert.Equal(t, "public, max-age=600, s-maxage=0, must-revalidate, immutable",
+		NewCacheControl().MaxAge(10*time.Minute+500*time.Millisecond).SMaxAge(-time.Second).Private().Public().MustRevalidate().Immutable().String())
+	assert.Equal(t, "private, no-cache, no-store, stale-while-revalidate=30, stale-if-error=60, proxy-revalidate, no-transform",
+		NewCacheControl().Public().Private().NoCache().NoStore().StaleWhileRevalidate(30*time.Second).StaleIfError(time.Minute).ProxyRevalidate().NoTransform().String())
+}
+
+func TestContextCacheControl(t *testing.T) {
+	c, _ := CreateTestContext(httptest.NewRecorder())
+	cc := c.CacheControl().MaxAge(10 * time.Minute)
+	assert.Equal(t, "max-age=600", c.Writer.Header().Get("Cache-Control"))
+	cc.StaleWhileRevalidate(30 * time.Second).Private()
+	assert.Equal(t, "private, max-age=600, stale-while-revalidate=30", c.Writer.Header().Get("Cache-Control"))
+}
+
+func TestDefaultCacheControl(t *testing.T) {
+	router := New()
+	assets := router.Group("/assets", DefaultCacheControl(NewCacheControl().Public().MaxAge(time.Hour)))
+	assets.GET("/app.js", func(c *Context) {
+		c.String(http.StatusOK, "%s", "js")
+	})
+	assets.GET("/live.json", func(c *Context) {
+		c.CacheControl().NoStore()
+		c.String(http.StatusOK, "%s", "{}")
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/assets/app.js")
+	assert.Equal(t, "public, max-age=3600", w.Header().Get("Cache-Control"))
+
+	w = PerformRequest(router, http.MethodGet, "/assets/live.json")
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+}
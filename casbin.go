@@ -0,0 +1,136 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"sync"
+	"time"
+)
+
+// CasbinEnforcer is the subset of the Casbin enforcer API used by CasbinAuthorizer.
+// It is implemented by *casbin.SyncedEnforcer, which should be preferred over
+// *casbin.Enforcer when the policy is reloaded while serving requests.
+type CasbinEnforcer interface {
+	Enforce(rvals ...any) (bool, error)
+	LoadPolicy() error
+}
+
+// CasbinDecision is an authorization decision of CasbinAuthorizer, see CasbinConfig.OnDecision.
+type CasbinDecision struct {
+	Subject string
+	Object  string
+	Action  string
+	Allowed bool
+	// Err is the error returned by the enforcer, if any.
+	Err error
+}
+
+// CasbinConfig defines the config for NewCasbinAuthorizer.
+type CasbinConfig struct {
+	// Enforcer evaluates the Casbin policies. The request is enforced with the subject,
+	// the matched route path (see Context.FullPath) as object and the method as action,
+	// which suits models with a "r = sub, obj, act" request definition.
+	Enforcer CasbinEnforcer
+
+	// Subject returns the subject of a request. Requests with an empty subject fail with
+	// ErrUnauthenticated.
+	// Optional. Default value is the id of the principal of the request, see Context.Principal.
+	Subject func(c *Context) string
+
+	// ReloadInterval is the interval at which the policy is reloaded from the enforcer adapter.
+	// The reload is started in the background by the first request after the interval
+	// elapsed, the requests being enforced with the previous policy until it is loaded.
+	// Optional. The policy is only reloaded by CasbinAuthorizer.Reload when zero.
+	ReloadInterval time.Duration
+
+	// OnDecision is called with every authorization decision, e.g. to log them. Optional.
+	OnDecision func(c *Context, decision CasbinDecision)
+
+	// OnReloadError is called when the periodic reload of the policy fails. Optional.
+	OnReloadError func(err error)
+}
+
+// CasbinAuthorizer authorizes the requests with Casbin policies, see CasbinAuthorizer.Middleware.
+type CasbinAuthorizer struct {
+	config CasbinConfig
+
+	mu         sync.Mutex
+	lastReload time.Time
+	reloading  bool
+}
+
+// NewCasbinAuthorizer returns a new CasbinAuthorizer.
+func NewCasbinAuthorizer(config CasbinConfig) *CasbinAuthorizer {
+	assert1(config.Enforcer != nil, "enforcer can not be nil")
+	if config.Subject == nil {
+		config.Subject = func(c *Context) string {
+			if principal := c.Principal(); principal != nil {
+				return principal.ID
+			}
+			return ""
+		}
+	}
+	return &CasbinAuthorizer{config: config, lastReload: time.Now()}
+}
+
+// Reload reloads the policy from the enforcer adapter.
+func (a *CasbinAuthorizer) Reload() error {
+	err := a.config.Enforcer.LoadPolicy()
+	a.mu.Lock()
+	a.lastReload = time.Now()
+	a.mu.Unlock()
+	return err
+}
+
+// maybeReload starts reloading the policy in the background if the reload interval
+// elapsed and it is not already being reloaded.
+func (a *CasbinAuthorizer) maybeReload() {
+	if a.config.ReloadInterval <= 0 {
+		return
+	}
+	a.mu.Lock()
+	if a.reloading || time.Since(a.lastReload) < a.config.ReloadInterval {
+		a.mu.Unlock()
+		return
+	}
+	a.reloading = true
+	a.mu.Unlock()
+
+	go func() {
+		err := a.Reload()
+		a.mu.Lock()
+		a.reloading = false
+		a.mu.Unlock()
+		if err != nil && a.config.OnReloadError != nil {
+			a.config.OnReloadError(err)
+		}
+	}()
+}
+
+// Middleware returns a middleware enforcing the Casbin policies on the matched routes.
+// Denials are rendered with Context.Fail: requests without subject fail with
+// ErrUnauthenticated, denied requests with ErrForbidden and enforcer errors as is.
+func (a *CasbinAuthorizer) Middleware() HandlerFunc {
+	return func(c *Context) {
+		a.maybeReload()
+
+		subject := a.config.Subject(c)
+		if subject == "" {
+			c.Fail(ErrUnauthenticated)
+			return
+		}
+		decision := CasbinDecision{Subject: subject, Object: c.FullPath(), Action: c.Request.Method}
+		decision.Allowed, decision.Err = a.config.Enforcer.Enforce(decision.Subject, decision.Object, decision.Action)
+		if a.config.OnDecision != nil {
+			a.config.OnDecision(c, decision)
+		}
+		switch {
+		case decision.Err != nil:
+			c.Fail(decision.Err)
+		case !decision.Allowed:
+			c.Fail(ErrForbidden)
+		}
+	}
+}
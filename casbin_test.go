@@ -0,0 +1,126 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeEnforcer allows the requests matching one of its "sub obj act" policies, loaded
+// from source by LoadPolicy.
+type fakeEnforcer struct {
+	mu       sync.Mutex
+	source   []string
+	policies map[string]bool
+	loads    int
+	loading  chan struct{}
+}
+
+func (e *fakeEnforcer) Enforce(rvals ...any) (bool, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if rvals[0] == "broken" {
+		return false, errors.New("enforcer failure")
+	}
+	return e.policies[rvals[0].(string)+" "+rvals[1].(string)+" "+rvals[2].(string)], nil
+}
+
+func (e *fakeEnforcer) LoadPolicy() error {
+	if e.loading != nil {
+		<-e.loading
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.loads++
+	e.policies = make(map[string]bool, len(e.source))
+	for _, p := range e.source {
+		e.policies[p] = true
+	}
+	return nil
+}
+
+func TestCasbinAuthorizer(t *testing.T) {
+	enforcer := &fakeEnforcer{source: []string{"alice /users/:id GET"}}
+	assert.NoError(t, enforcer.LoadPolicy())
+
+	var decisions []CasbinDecision
+	authorizer := NewCasbinAuthorizer(CasbinConfig{
+		Enforcer: enforcer,
+		Subject:  func(c *Context) string { return c.GetHeader("X-User") },
+		OnDecision: func(c *Context, decision CasbinDecision) {
+			decisions = append(decisions, decision)
+		},
+	})
+
+	router := New()
+	router.Use(authorizer.Middleware())
+	router.GET("/users/:id", func(c *Context) { c.String(http.StatusOK, "%s", "user") })
+
+	w := PerformRequest(router, http.MethodGet, "/users/1", header{"X-User", "alice"})
+	assert.Equal(t, "user", w.Body.String())
+	assert.Equal(t, []CasbinDecision{{Subject: "alice", Object: "/users/:id", Action: "GET", Allowed: true}}, decisions)
+
+	w = PerformRequest(router, http.MethodGet, "/users/1", header{"X-User", "bob"})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	w = PerformRequest(router, http.MethodGet, "/users/1")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	w = PerformRequest(router, http.MethodGet, "/users/1", header{"X-User", "broken"})
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	enforcer.source = append(enforcer.source, "bob /users/:id GET")
+	assert.NoError(t, authorizer.Reload())
+	w = PerformRequest(router, http.MethodGet, "/users/1", header{"X-User", "bob"})
+	assert.Equal(t, "user", w.Body.String())
+
+	assert.Panics(t, func() { NewCasbinAuthorizer(CasbinConfig{}) })
+}
+
+func TestCasbinAuthorizerHotReload(t *testing.T) {
+	enforcer := &fakeEnforcer{}
+	authorizer := NewCasbinAuthorizer(CasbinConfig{Enforcer: enforcer, ReloadInterval: 10 * time.Millisecond})
+
+	router := New()
+	router.Use(BasicAuth(Accounts{"alice": "pass"}), authorizer.Middleware())
+	router.GET("/", func(c *Context) {})
+
+	enforcer.source = []string{"alice / GET"}
+	w := PerformRequest(router, http.MethodGet, "/", header{"Authorization", authorizationHeader("alice", "pass")})
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	time.Sleep(20 * time.Millisecond)
+	assert.Eventually(t, func() bool {
+		w = PerformRequest(router, http.MethodGet, "/", header{"Authorization", authorizationHeader("alice", "pass")})
+		return w.Code == http.StatusOK
+	}, time.Second, time.Millisecond)
+	enforcer.mu.Lock()
+	assert.Equal(t, 1, enforcer.loads)
+	enforcer.mu.Unlock()
+}
+
+func TestCasbinAuthorizerBackgroundReload(t *testing.T) {
+	enforcer := &fakeEnforcer{source: []string{"alice / GET"}, loading: make(chan struct{})}
+	authorizer := NewCasbinAuthorizer(CasbinConfig{Enforcer: enforcer, ReloadInterval: time.Millisecond})
+
+	router := New()
+	router.Use(BasicAuth(Accounts{"alice": "pass"}), authorizer.Middleware())
+	router.GET("/", func(c *Context) {})
+
+	time.Sleep(2 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		w := PerformRequest(router, http.MethodGet, "/", header{"Authorization", authorizationHeader("alice", "pass")})
+		assert.Equal(t, http.StatusForbidden, w.Code, "the requests don't wait for the policy being loaded")
+	}
+	close(enforcer.loading)
+	assert.Eventually(t, func() bool {
+		w := PerformRequest(router, http.MethodGet, "/", header{"Authorization", authorizationHeader("alice", "pass")})
+		return w.Code == http.StatusOK
+	}, time.Second, time.Millisecond)
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+)
+
+// memoryRemoteAddr is the remote address of the requests sent by Engine.Client.
+const memoryRemoteAddr = "127.0.0.1:0"
+
+// memoryResponseWriter buffers the response of a request sent by Engine.Client.
+type memoryResponseWriter struct {
+	header      http.Header
+	sentHeader  http.Header
+	code        int
+	wroteHeader bool
+	body        bytes.Buffer
+	done        <-chan struct{}
+	served      chan struct{}
+}
+
+// newMemoryResponseWriter returns a writer for req, whose client is gone when the
+// context of req is done. finish must be called once req is served.
+func newMemoryResponseWriter(req *http.Request) *memoryResponseWriter {
+	return &memoryResponseWriter{
+		header: make(http.Header),
+		done:   req.Context().Done(),
+		served: make(chan struct{}),
+	}
+}
+
+// finish sends the status if the handlers did not, and releases the CloseNotify channels.
+func (w *memoryResponseWriter) finish() {
+	w.WriteHeader(http.StatusOK)
+	close(w.served)
+}
+
+func (w *memoryResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *memoryResponseWriter) WriteHeader(code int) {
+	if w.wroteHeader {
+		return
+	}
+	w.wroteHeader = true
+	w.code = code
+	w.sentHeader = w.header.Clone()
+}
+
+func (w *memoryResponseWriter) Write(data []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(data)
+}
+
+func (w *memoryResponseWriter) Flush() {
+	w.WriteHeader(http.StatusOK)
+}
+
+// Hijack implements the http.Hijacker interface, there is no connection to hijack.
+func (w *memoryResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, http.ErrNotSupported
+}
+
+// CloseNotify implements the http.CloseNotifier interface, the client being gone once the
+// context of the request is done.
+func (w *memoryResponseWriter) CloseNotify() <-chan bool {
+	gone := make(chan bool, 1)
+	if w.done != nil {
+		go func() {
+			select {
+			case <-w.done:
+				gone <- true
+			case <-w.served:
+			}
+		}()
+	}
+	return gone
+}
+
+// memoryTransport is a http.RoundTripper serving the requests with the engine, in memory.
+type memoryTransport struct {
+	engine *Engine
+}
+
+func (t memoryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.URL == nil {
+		return nil, fmt.Errorf("gin: nil request URL")
+	}
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+
+	// turn the client request into a server one
+	sreq := req.Clone(req.Context())
+	sreq.RequestURI = req.URL.RequestURI()
+	sreq.RemoteAddr = memoryRemoteAddr
+	if sreq.Host == "" {
+		sreq.Host = req.URL.Host
+	}
+	if sreq.Body == nil {
+		sreq.Body = http.NoBody
+	}
+	if req.URL.Scheme == "https" {
+		sreq.TLS = &tls.ConnectionState{HandshakeComplete: true, ServerName: req.URL.Hostname()}
+	}
+	if sreq.Proto == "" {
+		sreq.Proto, sreq.ProtoMajor, sreq.ProtoMinor = "HTTP/1.1", 1, 1
+	}
+
+	w := newMemoryResponseWriter(sreq)
+	t.engine.ServeHTTP(w, sreq)
+	w.finish()
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%03d %s", w.code, http.StatusText(w.code)),
+		StatusCode:    w.code,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        w.sentHeader,
+		Body:          io.NopCloser(&w.body),
+		ContentLength: int64(w.body.Len()),
+		Request:       req,
+	}, nil
+}
+
+// Client returns an HTTP client sending the requests to the engine in memory, calling
+// ServeHTTP directly instead of going through the network, so that the integration tests
+// and the calls of a service to itself run the whole middleware chain without a server.
+// The host of the URLs is passed on to the engine, and the https URLs are served as if
+// received over TLS. Responses are buffered, so streams are returned once complete.
+//
+//	resp, err := router.Client().Get("http://example.com/users/42")
+func (engine *Engine) Client() *http.Client {
+	return &http.Client{Transport: memoryTransport{engine: engine}}
+}
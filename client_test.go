@@ -0,0 +1,110 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngineClient(t *testing.T) {
+	router := New()
+	var middlewareCalls int
+	router.Use(func(c *Context) {
+		middlewareCalls++
+		c.Header("X-Middleware", "yes")
+	})
+	router.GET("/users/:id", func(c *Context) {
+		c.String(http.StatusOK, "%s %s %s %s", c.Param("id"), c.Query("q"), c.Request.Host, c.Scheme())
+	})
+	router.POST("/echo", func(c *Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.Data(http.StatusCreated, c.ContentType(), body)
+	})
+	router.GET("/login", func(c *Context) {
+		c.SetCookie("session", "s3cr3t", 0, "/", "", false, true)
+		c.Redirect(http.StatusFound, "/me")
+	})
+	router.GET("/me", func(c *Context) {
+		session, _ := c.Cookie("session")
+		c.String(http.StatusOK, "%s", session)
+	})
+
+	client := router.Client()
+	resp, err := client.Get("https://example.com/users/42?q=go")
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "200 OK", resp.Status)
+	assert.Equal(t, "42 go example.com https", string(body))
+	assert.Equal(t, "yes", resp.Header.Get("X-Middleware"))
+	assert.Equal(t, int64(len(body)), resp.ContentLength)
+
+	resp, err = client.Post("http://example.com/echo", "text/plain", strings.NewReader("hello"))
+	assert.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+	assert.Equal(t, "hello", string(body))
+	assert.Equal(t, "text/plain", resp.Header.Get("Content-Type"))
+
+	resp, err = client.Get("http://example.com/missing")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	// redirects and cookies are handled by the client
+	client.Jar, _ = cookiejar.New(nil)
+	resp, err = client.Get("http://example.com/login")
+	assert.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	assert.Equal(t, "s3cr3t", string(body))
+	assert.Equal(t, "/me", resp.Request.URL.Path)
+
+	assert.Equal(t, 5, middlewareCalls)
+}
+
+func TestEngineClientStream(t *testing.T) {
+	router := New()
+	router.GET("/stream", func(c *Context) {
+		n := 0
+		c.Stream(func(w io.Writer) bool {
+			n++
+			_, _ = io.WriteString(w, "tick ")
+			return n < 3
+		})
+	})
+	router.GET("/hijack", func(c *Context) {
+		_, _, err := c.Writer.Hijack()
+		c.String(http.StatusOK, "%v", err)
+	})
+	router.GET("/gone", func(c *Context) {
+		<-c.Writer.CloseNotify()
+		c.Status(http.StatusNoContent)
+	})
+
+	client := router.Client()
+	resp, err := client.Get("http://example.com/stream")
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	assert.Equal(t, "tick tick tick ", string(body))
+
+	resp, err = client.Get("http://example.com/hijack")
+	assert.NoError(t, err)
+	body, _ = io.ReadAll(resp.Body)
+	assert.Equal(t, http.ErrNotSupported.Error(), string(body))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com/gone", nil)
+	w := newMemoryResponseWriter(req)
+	go cancel()
+	router.ServeHTTP(w, req)
+	w.finish()
+	assert.Equal(t, http.StatusNoContent, w.code)
+}
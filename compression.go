@@ -0,0 +1,237 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"compress/gzip"
+	"mime"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+)
+
+// CompressionMetaKey is the route metadata key disabling the Compression middleware when
+// set to false, see RouterGroup.WithoutCompression.
+const CompressionMetaKey = "_gin-gonic/gin/compression"
+
+// defaultUncompressedTypes are the content types that are already compressed.
+var defaultUncompressedTypes = []string{
+	"image/", "video/", "audio/", "font/woff", "font/woff2",
+	"application/zip", "application/gzip", "application/x-gzip", "application/zstd",
+	"application/x-brotli", "application/x-7z-compressed", "application/x-rar-compressed",
+}
+
+// CompressionConfig defines the config for Compression middleware.
+type CompressionConfig struct {
+	// Level is the gzip compression level. Optional. Default value is gzip.DefaultCompression.
+	Level int
+
+	// ExcludedContentTypes are the content types, or content type prefixes when ending with
+	// a '/', that are never compressed, on top of the already compressed types such as images.
+	// Optional.
+	ExcludedContentTypes []string
+}
+
+// WithoutCompression returns a group, with the same path and middleware, whose responses
+// are not compressed by the Compression middleware, e.g. for streaming routes.
+func (group *RouterGroup) WithoutCompression() *RouterGroup {
+	return group.WithMeta(CompressionMetaKey, false)
+}
+
+// Compression returns a middleware compressing the responses with gzip for the clients
+// supporting it. Responses that already have a Content-Encoding, such as the precompressed
+// files served by StaticPrecompressed, responses whose content type is already compressed
+// and the routes declared with RouterGroup.WithoutCompression are left untouched.
+func Compression(conf CompressionConfig) HandlerFunc {
+	if conf.Level == 0 {
+		conf.Level = gzip.DefaultCompression
+	}
+	if _, err := gzip.NewWriterLevel(nil, conf.Level); err != nil {
+		panic(err)
+	}
+	excluded := append(append([]string(nil), defaultUncompressedTypes...), conf.ExcludedContentTypes...)
+	pool := sync.Pool{New: func() any {
+		gz, _ := gzip.NewWriterLevel(nil, conf.Level)
+		return gz
+	}}
+
+	return func(c *Context) {
+		if enabled, ok := c.RouteMeta(CompressionMetaKey); ok && enabled == false {
+			return
+		}
+		if c.IsWebsocket() {
+			return
+		}
+		c.AddVary("Accept-Encoding")
+		if !acceptsEncoding(c.requestHeader("Accept-Encoding"), "gzip") {
+			return
+		}
+
+		cw := &compressWriter{ResponseWriter: c.Writer, excluded: excluded, pool: &pool}
+		c.Writer = cw
+		defer func() {
+			cw.close()
+			c.Writer = cw.ResponseWriter
+		}()
+		c.Next()
+	}
+}
+
+// acceptsEncoding reports whether the Accept-Encoding header accepts encoding.
+func acceptsEncoding(acceptEncoding, encoding string) bool {
+	for _, part := range strings.Split(acceptEncoding, ",") {
+		name, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if !strings.EqualFold(strings.TrimSpace(name), encoding) {
+			continue
+		}
+		params = strings.ReplaceAll(params, " ", "")
+		return params != "q=0" && params != "q=0.0" && params != "q=0.00" && params != "q=0.000"
+	}
+	return false
+}
+
+// compressWriter compresses the response body once it knows, at the first write or flush,
+// that the response should be compressed. The body is never compressed once the headers
+// were sent without Content-Encoding.
+type compressWriter struct {
+	ResponseWriter
+	excluded []string
+	pool     *sync.Pool
+	gz       *gzip.Writer
+	decided  bool
+}
+
+func (w *compressWriter) decide() {
+	w.decided = true
+	if w.ResponseWriter.Written() {
+		return
+	}
+	header := w.Header()
+	if header.Get("Content-Encoding") != "" {
+		return
+	}
+	status := w.Status()
+	if status < http.StatusOK || status == http.StatusNoContent || status == http.StatusNotModified {
+		return
+	}
+	contentType, _, _ := mime.ParseMediaType(header.Get("Content-Type"))
+	for _, excluded := range w.excluded {
+		if contentType == excluded || (strings.HasSuffix(excluded, "/") && strings.HasPrefix(contentType, excluded)) {
+			return
+		}
+	}
+	header.Del("Content-Length")
+	header.Set("Content-Encoding", "gzip")
+	w.gz = w.pool.Get().(*gzip.Writer)
+	w.gz.Reset(w.ResponseWriter)
+}
+
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if !w.decided {
+		w.decide()
+	}
+	if w.gz == nil {
+		return w.ResponseWriter.Write(data)
+	}
+	w.ResponseWriter.WriteHeaderNow()
+	return w.gz.Write(data)
+}
+
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// WriteHeaderNow writes the headers without Content-Encoding if the body is not being
+// compressed yet, since nothing is known of it.
+func (w *compressWriter) WriteHeaderNow() {
+	w.decided = true
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+// Flush implements the http.Flusher interface.
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		w.decide()
+	}
+	if w.gz != nil {
+		w.gz.Flush() // nolint: errcheck
+	}
+	w.ResponseWriter.Flush()
+}
+
+func (w *compressWriter) close() {
+	if w.gz == nil {
+		return
+	}
+	w.gz.Close() // nolint: errcheck
+	w.gz.Reset(nil)
+	w.pool.Put(w.gz)
+	w.gz = nil
+}
+
+// precompressedEncodings are the encodings of the precompressed files, by order of
+// preference, with their file extension.
+var precompressedEncodings = []struct{ encoding, ext string }{
+	{"br", ".br"},
+	{"gzip", ".gz"},
+}
+
+// StaticPrecompressed serves files from the given file system like StaticFS, but serves the
+// .br or .gz sibling of a file instead of the file itself, when it exists and the client
+// supports the encoding, so that assets are compressed once at build time rather than on
+// every request.
+//
+//	router.StaticPrecompressed("/assets", gin.Dir("./dist", false))
+func (group *RouterGroup) StaticPrecompressed(relativePath string, fs http.FileSystem) IRoutes {
+	if strings.Contains(relativePath, ":") || strings.Contains(relativePath, "*") {
+		panic("URL parameters can not be used when serving a static folder")
+	}
+	static := group.createStaticHandler(relativePath, fs)
+	handler := func(c *Context) {
+		if !servePrecompressed(c, fs, c.Param("filepath")) {
+			static(c)
+		}
+	}
+	urlPattern := path.Join(relativePath, "/*filepath")
+	group.GET(urlPattern, handler)
+	group.HEAD(urlPattern, handler)
+	return group.returnObj()
+}
+
+// servePrecompressed serves the precompressed sibling of name, if any is acceptable, and
+// reports whether it did.
+func servePrecompressed(c *Context, fs http.FileSystem, name string) bool {
+	if strings.HasSuffix(name, "/") {
+		return false
+	}
+	c.AddVary("Accept-Encoding")
+	acceptEncoding := c.requestHeader("Accept-Encoding")
+	for _, candidate := range precompressedEncodings {
+		if !acceptsEncoding(acceptEncoding, candidate.encoding) {
+			continue
+		}
+		f, err := fs.Open(name + candidate.ext)
+		if err != nil {
+			continue
+		}
+		stat, err := f.Stat()
+		if err != nil || stat.IsDir() {
+			f.Close()
+			continue
+		}
+		contentType := mime.TypeByExtension(path.Ext(name))
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		header := c.Writer.Header()
+		header.Set("Content-Type", contentType)
+		header.Set("Content-Encoding", candidate.encoding)
+		http.ServeContent(c.Writer, c.Request, name, stat.ModTime(), f)
+		f.Close()
+		return true
+	}
+	return false
+}
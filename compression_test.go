@@ -0,0 +1,117 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func gunzip(t *testing.T, body io.Reader) string {
+	r, err := gzip.NewReader(body)
+	assert.NoError(t, err)
+	data, err := io.ReadAll(r)
+	assert.NoError(t, err)
+	return string(data)
+}
+
+func TestCompression(t *testing.T) {
+	text := strings.Repeat("hello gin ", 100)
+	router := New()
+	router.Use(Compression(CompressionConfig{ExcludedContentTypes: []string{"text/csv"}}))
+	router.GET("/text", func(c *Context) { c.String(http.StatusOK, "%s", text) })
+	router.GET("/image", func(c *Context) { c.Data(http.StatusOK, "image/png", []byte("png")) })
+	router.GET("/csv", func(c *Context) { c.Data(http.StatusOK, "text/csv; charset=utf-8", []byte("a,b")) })
+	router.GET("/encoded", func(c *Context) {
+		c.Header("Content-Encoding", "br")
+		c.Data(http.StatusOK, "text/plain", []byte("br"))
+	})
+	router.WithoutCompression().GET("/stream", func(c *Context) { c.String(http.StatusOK, "%s", text) })
+
+	gz := header{"Accept-Encoding", "br;q=0.9, gzip"}
+	w := PerformRequest(router, http.MethodGet, "/text", gz)
+	assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+	assert.Less(t, w.Body.Len(), len(text))
+	assert.Equal(t, text, gunzip(t, w.Body))
+
+	w = PerformRequest(router, http.MethodGet, "/text")
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.Equal(t, text, w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/text", header{"Accept-Encoding", "gzip;q=0"})
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+
+	for _, path := range []string{"/image", "/csv", "/stream"} {
+		w = PerformRequest(router, http.MethodGet, path, gz)
+		assert.Empty(t, w.Header().Get("Content-Encoding"), path)
+	}
+	w = PerformRequest(router, http.MethodGet, "/encoded", gz)
+	assert.Equal(t, "br", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "br", w.Body.String())
+
+	assert.Panics(t, func() { Compression(CompressionConfig{Level: 42}) })
+}
+
+func TestCompressionFlush(t *testing.T) {
+	router := New()
+	router.Use(Compression(CompressionConfig{}))
+	router.GET("/events", func(c *Context) {
+		c.Header("Content-Type", "text/event-stream")
+		c.Writer.Flush()
+		c.Writer.WriteString("data: hello\n\n") // nolint: errcheck
+	})
+	router.GET("/status", func(c *Context) {
+		c.Status(http.StatusAccepted)
+		c.Writer.WriteHeaderNow()
+		c.Writer.WriteString("accepted") // nolint: errcheck
+	})
+
+	gz := header{"Accept-Encoding", "gzip"}
+	// the headers are the ones sent by the flush
+	w := PerformRequest(router, http.MethodGet, "/events", gz)
+	assert.Equal(t, "gzip", w.Result().Header.Get("Content-Encoding"))
+	assert.Equal(t, "data: hello\n\n", gunzip(t, w.Body))
+
+	w = PerformRequest(router, http.MethodGet, "/status", gz)
+	assert.Equal(t, http.StatusAccepted, w.Code)
+	assert.Empty(t, w.Result().Header.Get("Content-Encoding"))
+	assert.Equal(t, "accepted", w.Body.String())
+}
+
+func TestStaticPrecompressed(t *testing.T) {
+	router := New()
+	router.Use(Compression(CompressionConfig{}))
+	router.StaticPrecompressed("/assets", Dir("./testdata/precompressed", false))
+
+	w := PerformRequest(router, http.MethodGet, "/assets/app.js", header{"Accept-Encoding", "gzip, br"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "br", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "Accept-Encoding", w.Header().Get("Vary"))
+	assert.Contains(t, w.Header().Get("Content-Type"), "javascript")
+	assert.Equal(t, "brotli-app", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/assets/app.js", header{"Accept-Encoding", "gzip"})
+	assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "console.log(\"app\")\n", gunzip(t, w.Body))
+
+	w = PerformRequest(router, http.MethodGet, "/assets/app.js")
+	assert.Empty(t, w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "console.log(\"app\")\n", w.Body.String())
+
+	// no precompressed sibling, compressed on the fly
+	w = PerformRequest(router, http.MethodGet, "/assets/style.css", header{"Accept-Encoding", "br, gzip"})
+	assert.Equal(t, "gzip", w.Header().Get("Content-Encoding"))
+	assert.Equal(t, "body{}\n", gunzip(t, w.Body))
+
+	w = PerformRequest(router, http.MethodGet, "/assets/missing.js", header{"Accept-Encoding", "br"})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	assert.Panics(t, func() { router.StaticPrecompressed("/:id", Dir(".", false)) })
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ConcurrencyLimitMetaKey is the route metadata key holding the ConcurrencyLimitConfig of
+// the route, see RouterGroup.MaxConcurrency.
+const ConcurrencyLimitMetaKey = "_gin-gonic/gin/concurrency-limit"
+
+// ConcurrencyLimitConfig defines the config for RouterGroup.MaxConcurrencyWithConfig.
+type ConcurrencyLimitConfig struct {
+	// Limit is the number of requests of a route served concurrently.
+	Limit int
+
+	// QueueTimeout is the time a request waits for another request of the route to
+	// complete when Limit is reached, before being rejected.
+	// Optional. By default, such requests are rejected at once.
+	QueueTimeout time.Duration
+
+	// RejectCode is the status code of the rejected requests.
+	// Optional. Default value is 429.
+	RejectCode int
+}
+
+// semaphore bounds the number of concurrent requests.
+type semaphore chan struct{}
+
+// acquire takes a slot, waiting up to timeout for one to be released. It reports false if
+// no slot was released in time, or if ctx was done first.
+func (s semaphore) acquire(ctx context.Context, timeout time.Duration) bool {
+	select {
+	case s <- struct{}{}:
+		return true
+	default:
+	}
+	if timeout <= 0 {
+		return false
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	select {
+	case s <- struct{}{}:
+		return true
+	case <-timer.C:
+	case <-ctx.Done():
+	}
+	return false
+}
+
+func (s semaphore) release() {
+	<-s
+}
+
+// MaxConcurrency returns a group, with the same path and middleware, whose routes serve
+// at most n requests concurrently each, independently of the other routes, so that a
+// heavy endpoint does not take over the server. The requests above the limit are
+// rejected with a 429.
+//
+//	router.MaxConcurrency(2).GET("/reports/:id", generateReport)
+func (group *RouterGroup) MaxConcurrency(n int) *RouterGroup {
+	return group.MaxConcurrencyWithConfig(ConcurrencyLimitConfig{Limit: n})
+}
+
+// MaxConcurrencyWithConfig is like MaxConcurrency, with the limit, the queue timeout and
+// the status code of the rejected requests defined by conf.
+func (group *RouterGroup) MaxConcurrencyWithConfig(conf ConcurrencyLimitConfig) *RouterGroup {
+	assert1(conf.Limit > 0, "concurrency limit must be positive")
+	if conf.RejectCode == 0 {
+		conf.RejectCode = http.StatusTooManyRequests
+	}
+
+	var routes sync.Map
+	child := group.WithMeta(ConcurrencyLimitMetaKey, conf)
+	child.Use(func(c *Context) {
+		key := routeKey(c.routeMethod(), c.FullPath())
+		value, ok := routes.Load(key)
+		if !ok {
+			value, _ = routes.LoadOrStore(key, make(semaphore, conf.Limit))
+		}
+		sem := value.(semaphore)
+		if !sem.acquire(c.Request.Context(), conf.QueueTimeout) {
+			if c.Request.Context().Err() != nil {
+				c.Abort()
+				return
+			}
+			c.Header("Retry-After", "1")
+			c.AbortWithStatus(conf.RejectCode)
+			return
+		}
+		defer sem.release()
+		c.Next()
+	})
+	return child
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaxConcurrency(t *testing.T) {
+	r := New()
+	release := make(chan struct{})
+	started := make(chan struct{}, 4)
+	limited := r.MaxConcurrency(2)
+	limited.GET("/reports/:id", func(c *Context) {
+		started <- struct{}{}
+		<-release
+		c.String(http.StatusOK, c.Param("id"))
+	})
+	limited.GET("/other", func(c *Context) {
+		c.String(http.StatusOK, "other")
+	})
+	r.GET("/free", func(c *Context) {
+		c.String(http.StatusOK, "free")
+	})
+
+	done := make(chan *httptest.ResponseRecorder, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			done <- PerformRequest(r, http.MethodGet, "/reports/1")
+		}()
+	}
+	<-started
+	<-started
+
+	w := PerformRequest(r, http.MethodGet, "/reports/2")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+
+	// the limit is per route
+	assert.Equal(t, http.StatusOK, PerformRequest(r, http.MethodGet, "/other").Code)
+	assert.Equal(t, http.StatusOK, PerformRequest(r, http.MethodGet, "/free").Code)
+
+	close(release)
+	assert.Equal(t, http.StatusOK, (<-done).Code)
+	assert.Equal(t, http.StatusOK, (<-done).Code)
+	assert.Equal(t, http.StatusOK, PerformRequest(r, http.MethodGet, "/reports/2").Code)
+
+	routes := r.Routes()
+	for _, route := range routes {
+		if route.Path == "/reports/:id" {
+			assert.Equal(t, ConcurrencyLimitConfig{Limit: 2, RejectCode: http.StatusTooManyRequests}, route.Meta[ConcurrencyLimitMetaKey])
+		}
+	}
+}
+
+func TestMaxConcurrencyQueueTimeout(t *testing.T) {
+	r := New()
+	release := make(chan struct{})
+	started := make(chan struct{})
+	r.MaxConcurrencyWithConfig(ConcurrencyLimitConfig{Limit: 1, QueueTimeout: time.Second, RejectCode: http.StatusServiceUnavailable}).
+		GET("/", func(c *Context) {
+			if c.Query("block") != "" {
+				close(started)
+				<-release
+			}
+			c.String(http.StatusOK, "ok")
+		})
+
+	done := make(chan *httptest.ResponseRecorder)
+	go func() {
+		done <- PerformRequest(r, http.MethodGet, "/?block=1")
+	}()
+	<-started
+
+	// queued until the first request completes
+	queued := make(chan *httptest.ResponseRecorder)
+	go func() {
+		queued <- PerformRequest(r, http.MethodGet, "/")
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	assert.Equal(t, http.StatusOK, (<-done).Code)
+	assert.Equal(t, http.StatusOK, (<-queued).Code)
+}
+
+func TestMaxConcurrencyTimeout(t *testing.T) {
+	r := New()
+	release := make(chan struct{})
+	defer close(release)
+	started := make(chan struct{})
+	r.MaxConcurrencyWithConfig(ConcurrencyLimitConfig{Limit: 1, QueueTimeout: 10 * time.Millisecond, RejectCode: http.StatusServiceUnavailable}).
+		GET("/", func(c *Context) {
+			close(started)
+			<-release
+		})
+
+	go PerformRequest(r, http.MethodGet, "/")
+	<-started
+	assert.Equal(t, http.StatusServiceUnavailable, PerformRequest(r, http.MethodGet, "/").Code)
+
+	// the client went away
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req := httptest.NewRequest(http.MethodGet, "/", nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	assert.Empty(t, w.Header().Get("Retry-After"))
+}
+
+func TestMaxConcurrencyInvalid(t *testing.T) {
+	assert.Panics(t, func() {
+		New().MaxConcurrency(0)
+	})
+}
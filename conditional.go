@@ -0,0 +1,78 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+// RenderIfModified sets the ETag and Last-Modified validators of the response and calls
+// render, unless the validators of a GET or HEAD request show the client already has the
+// resource, in which case it responds with 304 Not Modified. etag may be given with or
+// without quotes, and is not used when empty, nor is lastModified when zero.
+// If-None-Match takes precedence over If-Modified-Since, as in RFC 7232.
+//
+//	c.RenderIfModified(article.UpdatedAt, article.Version, func() {
+//		c.JSON(http.StatusOK, article)
+//	})
+func (c *Context) RenderIfModified(lastModified time.Time, etag string, render func()) {
+	header := c.Writer.Header()
+	if etag != "" {
+		etag = quoteETag(etag)
+		header.Set("ETag", etag)
+	}
+	if !lastModified.IsZero() {
+		header.Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	method := c.Request.Method
+	if (method == http.MethodGet || method == http.MethodHead) && c.notModified(lastModified, etag) {
+		header.Del("Content-Type")
+		header.Del("Content-Length")
+		c.Status(http.StatusNotModified)
+		c.Writer.WriteHeaderNow()
+		return
+	}
+	render()
+}
+
+// notModified checks the request validators against the ones of the resource.
+func (c *Context) notModified(lastModified time.Time, etag string) bool {
+	if inm := c.requestHeader("If-None-Match"); inm != "" {
+		return etag != "" && etagWeakMatch(inm, etag)
+	}
+	ims := c.requestHeader("If-Modified-Since")
+	if ims == "" || lastModified.IsZero() {
+		return false
+	}
+	t, err := http.ParseTime(ims)
+	if err != nil {
+		return false
+	}
+	// the header has a second precision
+	return !lastModified.Truncate(time.Second).After(t)
+}
+
+func quoteETag(etag string) string {
+	if strings.HasPrefix(etag, `"`) || strings.HasPrefix(etag, `W/"`) {
+		return etag
+	}
+	return `"` + etag + `"`
+}
+
+// etagWeakMatch reports whether the If-None-Match list matches etag, using the weak
+// comparison: W/"a" matches "a".
+func etagWeakMatch(list, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(list, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextRenderIfModified(t *testing.T) {
+	modified := time.Date(2026, 3, 1, 12, 0, 0, 500, time.UTC)
+	router := New()
+	router.Any("/article", func(c *Context) {
+		c.RenderIfModified(modified, c.Query("etag"), func() {
+			c.String(http.StatusOK, "%s", "article")
+		})
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/article?etag=v1")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "article", w.Body.String())
+	assert.Equal(t, `"v1"`, w.Header().Get("ETag"))
+	assert.Equal(t, "Sun, 01 Mar 2026 12:00:00 GMT", w.Header().Get("Last-Modified"))
+
+	w = PerformRequest(router, http.MethodGet, "/article?etag=v1", header{"If-None-Match", `"v0", W/"v1"`})
+	assert.Equal(t, http.StatusNotModified, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.Empty(t, w.Header().Get("Content-Type"))
+
+	w = PerformRequest(router, http.MethodHead, "/article?etag=W/\"v1\"", header{"If-None-Match", "*"})
+	assert.Equal(t, http.StatusNotModified, w.Code)
+
+	// If-None-Match takes precedence over If-Modified-Since
+	w = PerformRequest(router, http.MethodGet, "/article?etag=v2",
+		header{"If-None-Match", `"v1"`}, header{"If-Modified-Since", "Sun, 01 Mar 2026 12:00:00 GMT"})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/article", header{"If-Modified-Since", "Sun, 01 Mar 2026 12:00:00 GMT"})
+	assert.Equal(t, http.StatusNotModified, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/article", header{"If-Modified-Since", "Sun, 01 Mar 2026 11:59:59 GMT"})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/article", header{"If-Modified-Since", "yesterday"})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	// only safe methods are short-circuited
+	w = PerformRequest(router, http.MethodPost, "/article?etag=v1", header{"If-None-Match", `"v1"`})
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+)
+
+// ConnInfo describes the connection a request was received on, see Context.ConnState.
+type ConnInfo struct {
+	// TLSVersion is the TLS version, e.g. tls.VersionTLS13, or zero for plaintext connections.
+	TLSVersion uint16
+
+	// CipherSuite is the TLS cipher suite, see tls.CipherSuiteName.
+	CipherSuite uint16
+
+	// NegotiatedProtocol is the protocol negotiated with ALPN, e.g. "h2".
+	NegotiatedProtocol string
+
+	// LocalAddr is the address the connection was accepted on.
+	LocalAddr net.Addr
+
+	// RemoteAddr is the address of the peer, which may be a proxy, see Context.ClientIP.
+	RemoteAddr net.Addr
+
+	// Requests is the number of requests received on the connection so far, this one
+	// included, so a value greater than one means the connection was kept alive and reused.
+	// HTTP/2 streams are not counted. Zero if the connection is not tracked, see
+	// TrackConnections.
+	Requests uint64
+}
+
+// trackedConn is the state of a connection tracked by TrackConnections.
+type trackedConn struct {
+	conn     net.Conn
+	requests uint64
+}
+
+type connContextKey struct{}
+
+// TrackConnections wires the ConnContext and ConnState hooks of srv, chaining the existing
+// ones, so that Context.ConnState reports the connection addresses and reuse count. The Run
+// methods of the engine do it for their server.
+func TrackConnections(srv *http.Server) {
+	var conns sync.Map
+	connContext, connState := srv.ConnContext, srv.ConnState
+
+	srv.ConnContext = func(ctx context.Context, conn net.Conn) context.Context {
+		if connContext != nil {
+			ctx = connContext(ctx, conn)
+		}
+		tc := &trackedConn{conn: conn}
+		conns.Store(conn, tc)
+		return context.WithValue(ctx, connContextKey{}, tc)
+	}
+	srv.ConnState = func(conn net.Conn, state http.ConnState) {
+		switch state {
+		case http.StateActive:
+			if tc, ok := conns.Load(conn); ok {
+				atomic.AddUint64(&tc.(*trackedConn).requests, 1)
+			}
+		case http.StateHijacked, http.StateClosed:
+			conns.Delete(conn)
+		}
+		if connState != nil {
+			connState(conn, state)
+		}
+	}
+}
+
+// newServer returns the server used by the Run methods.
+func (engine *Engine) newServer(addr string) *http.Server {
+	engine.printRouteSummaryOnce()
+	engine.ApplyRuntimeConfig()
+	srv := &http.Server{Addr: addr, Handler: engine.Handler()}
+	TrackConnections(srv)
+	srv.RegisterOnShutdown(engine.Drain)
+	return srv
+}
+
+// ConnState returns the details of the connection the request was received on, for
+// security logging or to debug keep-alive behavior.
+func (c *Context) ConnState() ConnInfo {
+	var info ConnInfo
+	if c.Request == nil {
+		return info
+	}
+	if state := c.Request.TLS; state != nil {
+		info.TLSVersion = state.Version
+		info.CipherSuite = state.CipherSuite
+		info.NegotiatedProtocol = state.NegotiatedProtocol
+	}
+	ctx := c.Request.Context()
+	if tc, ok := ctx.Value(connContextKey{}).(*trackedConn); ok {
+		info.LocalAddr = tc.conn.LocalAddr()
+		info.RemoteAddr = tc.conn.RemoteAddr()
+		info.Requests = atomic.LoadUint64(&tc.requests)
+		return info
+	}
+	if addr, ok := ctx.Value(http.LocalAddrContextKey).(net.Addr); ok {
+		info.LocalAddr = addr
+	}
+	if addr, err := net.ResolveTCPAddr("tcp", c.Request.RemoteAddr); err == nil {
+		info.RemoteAddr = addr
+	}
+	return info
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newConnStateServer(tlsServer bool) *httptest.Server {
+	router := New()
+	router.GET("/", func(c *Context) {
+		info := c.ConnState()
+		c.String(http.StatusOK, "%d %s %d %s %t", info.Requests, info.NegotiatedProtocol, info.TLSVersion,
+			info.LocalAddr.Network(), info.RemoteAddr != nil)
+	})
+	ts := httptest.NewUnstartedServer(router)
+	TrackConnections(ts.Config)
+	if tlsServer {
+		ts.StartTLS()
+	} else {
+		ts.Start()
+	}
+	return ts
+}
+
+func getBody(t *testing.T, client *http.Client, url string) string {
+	resp, err := client.Get(url)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	return string(body)
+}
+
+func TestContextConnStateReuse(t *testing.T) {
+	ts := newConnStateServer(false)
+	defer ts.Close()
+
+	client := ts.Client()
+	assert.Equal(t, "1  0 tcp true", getBody(t, client, ts.URL))
+	assert.Equal(t, "2  0 tcp true", getBody(t, client, ts.URL))
+
+	client.CloseIdleConnections()
+	assert.Equal(t, "1  0 tcp true", getBody(t, client, ts.URL))
+}
+
+func TestContextConnStateTLS(t *testing.T) {
+	ts := newConnStateServer(true)
+	defer ts.Close()
+
+	client := ts.Client()
+	client.Transport.(*http.Transport).TLSClientConfig.MaxVersion = tls.VersionTLS12
+	assert.Equal(t, fmt.Sprintf("1  %d tcp true", tls.VersionTLS12), getBody(t, client, ts.URL))
+}
+
+func TestContextConnStateUntracked(t *testing.T) {
+	c, _ := CreateTestContext(httptest.NewRecorder())
+	assert.Equal(t, ConnInfo{}, c.ConnState())
+
+	c.Request = httptest.NewRequest(http.MethodGet, "/", nil)
+	info := c.ConnState()
+	assert.Equal(t, uint64(0), info.Requests)
+	assert.Equal(t, "192.0.2.1:1234", info.RemoteAddr.String())
+	assert.Nil(t, info.LocalAddr)
+}
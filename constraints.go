@@ -0,0 +1,233 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// ParamConstraint reports whether the value of a path parameter is accepted by a route,
+// see RouterGroup.HandleWithConstraints. The value of a catch-all parameter starts with
+// a '/'.
+type ParamConstraint func(value string) bool
+
+// RegexpConstraint returns a ParamConstraint accepting the values matching the regular
+// expression expr as a whole. It panics if expr does not compile.
+func RegexpConstraint(expr string) ParamConstraint {
+	re, err := regexp.Compile("^(?:" + expr + ")$")
+	if err != nil {
+		panic(fmt.Sprintf("invalid param constraint '%s': %v", expr, err))
+	}
+	return re.MatchString
+}
+
+// HandleWithConstraints is like Handle, but the route only matches the requests whose
+// path parameters are accepted by their constraints, the others being routed as if it
+// did not exist: to another route registered on the same path, to the route they would
+// have matched otherwise, such as /:user/profile for /users/profile, or to NoRoute.
+// Constraints can also be set in the path, as regular expressions following the names
+// of the parameters, with their parentheses balanced or escaped:
+//
+//	router.GET(`/users/:id(\d+)`, getUserByID)
+//	router.GET("/users/:id", getUserByName)
+//	router.GETWithConstraints("/files/*path", map[string]gin.ParamConstraint{
+//		"path": func(path string) bool { return strings.HasSuffix(path, ".go") },
+//	}, getSource)
+//
+// The routes sharing a path must use the same names for the parameters. They are tried in
+// the order they were registered, the route without constraints last.
+func (group *RouterGroup) HandleWithConstraints(httpMethod, relativePath string, constraints map[string]ParamConstraint, handlers ...HandlerFunc) IRoutes {
+	if matched := regEnLetter.MatchString(httpMethod); !matched {
+		panic("http method " + httpMethod + " is not valid")
+	}
+	return group.handleWithConstraints(httpMethod, relativePath, constraints, handlers)
+}
+
+// GETWithConstraints is a shortcut for router.HandleWithConstraints("GET", path, constraints, handle).
+func (group *RouterGroup) GETWithConstraints(relativePath string, constraints map[string]ParamConstraint, handlers ...HandlerFunc) IRoutes {
+	return group.handleWithConstraints(http.MethodGet, relativePath, constraints, handlers)
+}
+
+type constraintKey struct {
+	method string
+	path   string
+}
+
+type constrainedRoute struct {
+	constraints map[string]ParamConstraint
+	handlers    HandlersChain
+	meta        map[string]any
+}
+
+func (route *constrainedRoute) matches(params Params) bool {
+	for name, constraint := range route.constraints {
+		if value, _ := params.Get(name); !constraint(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// parsePathConstraints strips the regular expressions following the parameter names of
+// path, returning them compiled by parameter name.
+func parsePathConstraints(path string) (string, map[string]ParamConstraint) {
+	if !strings.Contains(path, "(") {
+		return path, nil
+	}
+	var constraints map[string]ParamConstraint
+	buf := make([]byte, 0, len(path))
+	for i := 0; i < len(path); i++ {
+		buf = append(buf, path[i])
+		if path[i] != ':' && path[i] != '*' {
+			continue
+		}
+		end := i + 1
+		for end < len(path) && path[end] != '/' && path[end] != '(' {
+			end++
+		}
+		name := path[i+1 : end]
+		buf = append(buf, name...)
+		i = end - 1
+		if end == len(path) || path[end] != '(' {
+			continue
+		}
+
+		depth := 0
+	scan:
+		for i = end; i < len(path); i++ {
+			switch path[i] {
+			case '\\':
+				i++
+			case '(':
+				depth++
+			case ')':
+				if depth--; depth == 0 {
+					break scan
+				}
+			}
+		}
+		assert1(i < len(path), "unterminated constraint for '"+name+"' in path '"+path+"'")
+		if constraints == nil {
+			constraints = make(map[string]ParamConstraint)
+		}
+		constraints[name] = RegexpConstraint(path[end+1 : i])
+	}
+	return string(buf), constraints
+}
+
+// pathHasParam reports whether path has a wildcard named name.
+func pathHasParam(path, name string) bool {
+	for {
+		wildcard, i, _ := findWildcard(path)
+		if i < 0 {
+			return false
+		}
+		if wildcard[1:] == name {
+			return true
+		}
+		path = path[i+len(wildcard):]
+	}
+}
+
+// addConstrainedRoute registers a route whose parameters must be accepted by constraints,
+// with its metadata. The tree holds the handlers of the first route registered on the
+// path, the others being picked by constrainValue, and the routes sharing the path keep
+// their own handlers and metadata.
+func (engine *Engine) addConstrainedRoute(method, path string, constraints map[string]ParamConstraint, handlers HandlersChain, meta map[string]any) {
+	key := constraintKey{method: method, path: path}
+	routes, constrained := engine.constraints[key]
+	if !constrained && len(constraints) == 0 {
+		engine.addRoute(method, path, handlers)
+		if len(meta) > 0 {
+			engine.setRouteMeta(method, path, meta)
+		}
+		return
+	}
+	for name := range constraints {
+		assert1(pathHasParam(path, name), "constraint for '"+name+"' not matching a parameter of path '"+path+"'")
+	}
+
+	if !constrained {
+		if n := engine.routeNode(method, path); n != nil && len(n.handlers) > 0 {
+			// the route without constraints was registered first
+			routes = append(routes, &constrainedRoute{handlers: n.handlers, meta: engine.routeMeta[routeKey(method, path)]})
+			delete(engine.routeMeta, routeKey(method, path))
+		} else {
+			engine.addRoute(method, path, handlers)
+		}
+	} else {
+		assert1(len(handlers) > 0, "there must be at least one handler")
+		assert1(!engine.frozen, "routes can not be added once the engine is frozen")
+		if !engine.detached {
+			debugPrintRoute(method, path, handlers)
+		}
+	}
+	engine.indexRouteName(path, meta)
+
+	route := &constrainedRoute{constraints: constraints, handlers: handlers, meta: meta}
+	if last := len(routes) - 1; last >= 0 && routes[last].constraints == nil {
+		// the route without constraints stays last
+		assert1(len(constraints) > 0, "handlers are already registered for path '"+path+"'")
+		routes = append(routes, routes[last])
+		routes[last] = route
+	} else {
+		routes = append(routes, route)
+	}
+	if engine.constraints == nil {
+		engine.constraints = make(map[constraintKey][]*constrainedRoute)
+	}
+	engine.constraints[key] = routes
+}
+
+// constrainValue returns value with the handlers of the first route registered on its
+// path whose constraints accept the parameters, and that route, or resumes the lookup if
+// there is none. The route is nil for the paths without constraints.
+func (engine *Engine) constrainValue(method string, value nodeValue, params *Params, skippedNodes *[]skippedNode, unescape bool) (nodeValue, *constrainedRoute) {
+	for value.handlers != nil {
+		routes, ok := engine.constraints[constraintKey{method: method, path: value.fullPath}]
+		if !ok {
+			return value, nil
+		}
+		var ps Params
+		if value.params != nil {
+			ps = *value.params
+		}
+		for _, route := range routes {
+			if route.matches(ps) {
+				value.handlers = route.handlers
+				return value, route
+			}
+		}
+		value = resumeValue(params, skippedNodes, unescape)
+	}
+	return value, nil
+}
+
+// constrainedRoutes returns the routes of the engine, with one entry per route sharing a
+// path with constraints, in the order they are tried.
+func (engine *Engine) constrainedRoutes(routes RoutesInfo) RoutesInfo {
+	if len(engine.constraints) == 0 {
+		return routes
+	}
+	expanded := make(RoutesInfo, 0, len(routes))
+	for _, info := range routes {
+		alternatives, ok := engine.constraints[constraintKey{method: info.treeMethod(), path: info.Path}]
+		if !ok {
+			expanded = append(expanded, info)
+			continue
+		}
+		for _, route := range alternatives {
+			info.HandlerFunc = route.handlers.Last()
+			info.Handler = engine.HandlerName(info.HandlerFunc)
+			info.Meta = route.meta
+			info.middleware = len(route.handlers) - 1
+			expanded = append(expanded, info)
+		}
+	}
+	return expanded
+}
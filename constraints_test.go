@@ -0,0 +1,215 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPathConstraints(t *testing.T) {
+	router := New()
+	router.GET(`/users/:id(\d+)`, func(c *Context) {
+		c.String(http.StatusOK, "id "+c.Param("id"))
+	})
+	router.GET("/users/:id", func(c *Context) {
+		c.String(http.StatusOK, "name "+c.Param("id"))
+	})
+	router.GET(`/users/:id([a-f]+)/avatar`, func(c *Context) {
+		c.String(http.StatusOK, "avatar "+c.Param("id")+" "+c.FullPath())
+	})
+	router.GET(`/items/:id(\d+)`, func(c *Context) {
+		c.String(http.StatusOK, "item "+c.Param("id"))
+	})
+	router.GET("/:user/profile", func(c *Context) {
+		c.String(http.StatusOK, "profile "+c.Param("user"))
+	})
+	router.GET(`/posts/:year(\d{4})/:slug([a-z-]+)`, func(c *Context) {
+		c.String(http.StatusOK, c.Param("year")+" "+c.Param("slug"))
+	})
+
+	for path, body := range map[string]string{
+		"/users/42":          "id 42",
+		"/users/gin":         "name gin",
+		"/users/abc/avatar":  "avatar abc /users/:id/avatar",
+		"/users/profile":     "name profile",
+		"/users/xyz/profile": "",
+		"/2026/profile":      "profile 2026",
+		"/posts/2026/gin":    "2026 gin",
+		"/posts/26/gin":      "",
+		"/posts/2026/Gin":    "",
+	} {
+		w := PerformRequest(router, http.MethodGet, path)
+		if body == "" {
+			assert.Equal(t, http.StatusNotFound, w.Code, path)
+			continue
+		}
+		assert.Equal(t, http.StatusOK, w.Code, path)
+		assert.Equal(t, body, w.Body.String(), path)
+	}
+
+	w := PerformRequest(router, http.MethodGet, "/users/xyz/avatar")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = PerformRequest(router, http.MethodGet, "/items/1")
+	assert.Equal(t, "item 1", w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/items/profile")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "profile items", w.Body.String(), "falls through to the route it would have matched")
+
+	route, params, _ := router.Lookup(http.MethodGet, "/users/7")
+	assert.Equal(t, "/users/:id", route.Path)
+	assert.Equal(t, Params{{Key: "id", Value: "7"}}, params)
+	route, _, _ = router.Lookup(http.MethodGet, "/users/xyz/avatar")
+	assert.Nil(t, route)
+}
+
+func TestHandleWithConstraints(t *testing.T) {
+	router := New()
+	router.GET("/files/*path", func(c *Context) {
+		c.String(http.StatusOK, "file")
+	})
+	router.GETWithConstraints("/files/*path", map[string]ParamConstraint{
+		"path": func(path string) bool { return strings.HasSuffix(path, ".go") },
+	}, func(c *Context) {
+		c.String(http.StatusOK, "source")
+	})
+	router.HandleWithConstraints(http.MethodPost, "/items/:id", map[string]ParamConstraint{
+		"id": RegexpConstraint(`[0-9a-f]{8}`),
+	}, func(c *Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/files/gin.go")
+	assert.Equal(t, "source", w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/files/README.md")
+	assert.Equal(t, "file", w.Body.String(), "the route registered first without constraints runs last")
+	w = PerformRequest(router, http.MethodPost, "/items/deadbeef")
+	assert.Equal(t, http.StatusCreated, w.Code)
+	w = PerformRequest(router, http.MethodPost, "/items/gin")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	assert.PanicsWithValue(t, "http method get is not valid", func() {
+		router.HandleWithConstraints("get", "/items/:id", nil, func(*Context) {})
+	})
+}
+
+func TestConstraintsFallThrough(t *testing.T) {
+	router := New()
+	var middleware int
+	router.Use(func(c *Context) {
+		middleware++
+	})
+	router.GET(`/docs/:page(v\d+)`, func(c *Context) {
+		c.FallThrough()
+	})
+	router.GET("/docs/:page", func(c *Context) {
+		c.String(http.StatusOK, "page")
+	})
+	router.GET("/:section/index", func(c *Context) {
+		c.String(http.StatusOK, "index")
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/docs/v1")
+	assert.Equal(t, http.StatusNotFound, w.Code, "the routes sharing the path are not tried again")
+	w = PerformRequest(router, http.MethodGet, "/docs/page")
+	assert.Equal(t, "page", w.Body.String())
+	assert.Equal(t, 2, middleware)
+}
+
+func TestPathConstraintsErrors(t *testing.T) {
+	router := New()
+	assert.PanicsWithValue(t, `unterminated constraint for 'id' in path '/users/:id(\d+'`, func() {
+		router.GET(`/users/:id(\d+`, func(*Context) {})
+	})
+	assert.Panics(t, func() {
+		router.GET(`/users/:id([a-z)`, func(*Context) {})
+	})
+	assert.PanicsWithValue(t, "constraint for 'name' not matching a parameter of path '/users/:id'", func() {
+		router.GETWithConstraints("/users/:id", map[string]ParamConstraint{"name": RegexpConstraint("x")}, func(*Context) {})
+	})
+	router.GET(`/users/:id(\d+)`, func(*Context) {})
+	router.GET("/users/:id", func(*Context) {})
+	assert.PanicsWithValue(t, "handlers are already registered for path '/users/:id'", func() {
+		router.GET("/users/:id", func(*Context) {})
+	})
+
+	assert.NoError(t, ValidatePath(`/users/:id(\d+)/posts/:slug([a-z]+(-[a-z]+)*)`))
+	assert.Error(t, ValidatePath(`/users/:id(\d+`))
+}
+
+func TestParsePathConstraints(t *testing.T) {
+	path, constraints := parsePathConstraints("/users/:id/*path")
+	assert.Equal(t, "/users/:id/*path", path)
+	assert.Nil(t, constraints)
+
+	path, constraints = parsePathConstraints(`/users/:id(\d+)/(static)/:name([a-z]+\(x\))/*path(.+\.go)`)
+	assert.Equal(t, "/users/:id/(static)/:name/*path", path)
+	assert.Len(t, constraints, 3)
+	assert.True(t, constraints["id"]("123"))
+	assert.False(t, constraints["id"]("12a"))
+	assert.True(t, constraints["name"]("gin(x)"))
+	assert.True(t, constraints["path"]("/cmd/main.go"))
+	assert.False(t, constraints["path"]("/README.md"))
+}
+
+func TestConstraintsRouteMeta(t *testing.T) {
+	router := New()
+	reply := func(c *Context) {
+		role, _ := c.RouteMeta("role")
+		c.String(http.StatusOK, "%v", role)
+	}
+	router.GET("/users/:id", reply)
+	router.WithMeta("role", "admin").GET(`/users/:id(\d+)`, reply)
+	router.WithMeta("role", "public").GET(`/users/:id([a-z]+)`, reply)
+
+	for path, role := range map[string]string{
+		"/users/42":  "admin",
+		"/users/gin": "public",
+		"/users/Gin": "<nil>",
+	} {
+		w := PerformRequest(router, http.MethodGet, path)
+		assert.Equal(t, role, w.Body.String(), path)
+	}
+
+	route, _, _ := router.Lookup(http.MethodGet, "/users/42")
+	assert.Equal(t, map[string]any{"role": "admin"}, route.Meta)
+
+	routes := router.Routes()
+	assert.Len(t, routes, 3)
+	var roles []any
+	for _, route := range routes {
+		assert.Equal(t, "/users/:id", route.Path)
+		roles = append(roles, route.Meta["role"])
+	}
+	assert.Equal(t, []any{"admin", "public", nil}, roles, "in the order the routes are tried")
+
+	snapshot := router.TreeSnapshot()
+	n := snapshot[0].Root
+	for len(n.Children) > 0 {
+		n = n.Children[0]
+	}
+	assert.Equal(t, "/users/:id", n.FullPath)
+	assert.Len(t, n.Alternatives, 2)
+}
+
+func TestPathConstraintsNotCleaned(t *testing.T) {
+	router := New()
+	router.GET(`/files/*path(/docs/.+\.md)`, func(c *Context) {
+		c.String(http.StatusOK, "doc "+c.Param("path"))
+	})
+	router.Group("/v1").GET(`/refs/:ref([a-z]+\.\.[a-z]+)`, func(c *Context) {
+		c.String(http.StatusOK, "range "+c.Param("ref"))
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/files/docs/gin.md")
+	assert.Equal(t, "doc /docs/gin.md", w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/files/gin.md")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = PerformRequest(router, http.MethodGet, "/v1/refs/main..dev")
+	assert.Equal(t, "range main..dev", w.Body.String())
+}
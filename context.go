@@ -5,6 +5,8 @@
 package gin
 
 import (
+	"bufio"
+	"context"
 	"errors"
 	"io"
 	"io/ioutil"
@@ -62,6 +64,11 @@ type Context struct {
 	params       *Params
 	skippedNodes *[]skippedNode
 
+	// paramsSlice is the slice params points to, backed by paramsArray with the ParamsInline
+	// strategy, see Engine.ParamsStrategy.
+	paramsSlice Params
+	paramsArray [InlineParams]Param
+
 	// This mutex protects Keys map.
 	mu sync.RWMutex
 
@@ -84,6 +91,35 @@ type Context struct {
 	// SameSite allows a server to define a cookie attribute making it impossible for
 	// the browser to send this cookie along with cross-site requests.
 	sameSite http.SameSite
+
+	// featureFlags caches the feature flags evaluated by FeatureEnabled.
+	featureFlags map[string]bool
+
+	// tenant is the tenant the request was resolved to, see Engine.SetTenancy.
+	tenant *Tenant
+
+	// hostMethod is the method of the request qualified by the host pattern it matched,
+	// see Engine.Host.
+	hostMethod string
+
+	// constrained is the route matched among the routes sharing a path with constraints,
+	// see RouterGroup.HandleWithConstraints.
+	constrained *constrainedRoute
+
+	// segmentParams are the matrix params of the path segments, see Engine.EnableMatrixParams.
+	segmentParams []url.Values
+
+	// reentryDepth is the number of nested HandleContext calls.
+	reentryDepth int
+
+	// inheritedParams are the params of the previous route kept by HandleContextWithOptions.
+	inheritedParams Params
+
+	// pendingJobs are the jobs queued once the response is written, see Context.Enqueue.
+	pendingJobs []pendingJob
+
+	// copied is true for the copies of a context, see Context.Copy.
+	copied bool
 }
 
 /************************************/
@@ -103,6 +139,12 @@ func (c *Context) reset() {
 	c.queryCache = nil
 	c.formCache = nil
 	c.sameSite = 0
+	c.featureFlags = nil
+	c.tenant = nil
+	c.hostMethod = ""
+	c.constrained = nil
+	c.segmentParams = nil
+	c.inheritedParams = nil
 	*c.params = (*c.params)[:0]
 	*c.skippedNodes = (*c.skippedNodes)[:0]
 }
@@ -115,6 +157,9 @@ func (c *Context) Copy() *Context {
 		Request:   c.Request,
 		Params:    c.Params,
 		engine:    c.engine,
+
+		segmentParams: c.segmentParams,
+		copied:        true,
 	}
 	cp.writermem.ResponseWriter = nil
 	cp.Writer = &cp.writermem
@@ -133,7 +178,10 @@ func (c *Context) Copy() *Context {
 // HandlerName returns the main handler's name. For example if the handler is "handleGetUsers()",
 // this function will return "main.handleGetUsers".
 func (c *Context) HandlerName() string {
-	return nameOfFunction(c.handlers.Last())
+	if c.engine == nil {
+		return nameOfFunction(c.handlers.Last())
+	}
+	return c.engine.HandlerName(c.handlers.Last())
 }
 
 // HandlerNames returns a list of all registered handlers for this context in descending order,
@@ -141,7 +189,11 @@ func (c *Context) HandlerName() string {
 func (c *Context) HandlerNames() []string {
 	hn := make([]string, 0, len(c.handlers))
 	for _, val := range c.handlers {
-		hn = append(hn, nameOfFunction(val))
+		if c.engine != nil {
+			hn = append(hn, c.engine.HandlerName(val))
+		} else {
+			hn = append(hn, nameOfFunction(val))
+		}
 	}
 	return hn
 }
@@ -175,6 +227,51 @@ func (c *Context) Next() {
 	}
 }
 
+// RemainingHandlers returns the handlers that are still pending, after the current one.
+// It returns nil once the context was aborted.
+func (c *Context) RemainingHandlers() HandlersChain {
+	if c.IsAborted() || int(c.index)+1 >= len(c.handlers) {
+		return nil
+	}
+	remaining := make(HandlersChain, len(c.handlers)-int(c.index)-1)
+	copy(remaining, c.handlers[c.index+1:])
+	return remaining
+}
+
+// InsertNext inserts handlers right after the current one, so they run next, before the
+// remaining handlers. The route handlers are left untouched, only this request is affected.
+func (c *Context) InsertNext(handlers ...HandlerFunc) {
+	if len(handlers) == 0 || c.IsAborted() {
+		return
+	}
+	finalSize := len(c.handlers) + len(handlers)
+	assert1(finalSize < int(abortIndex), "too many handlers")
+	at := int(c.index) + 1
+	merged := make(HandlersChain, 0, finalSize)
+	merged = append(merged, c.handlers[:at]...)
+	merged = append(merged, handlers...)
+	c.handlers = append(merged, c.handlers[at:]...)
+}
+
+// NextWithRetry runs the pending handlers like Next, up to attempts times. After every
+// attempt but the last one, retry decides whether they run again, which should only happen
+// while nothing was written to the response yet.
+//     router.Use(func(c *gin.Context) {
+//         c.NextWithRetry(3, func(c *gin.Context) bool {
+//             return !c.Writer.Written() && len(c.Errors) > 0
+//         })
+//     })
+func (c *Context) NextWithRetry(attempts int, retry func(c *Context) bool) {
+	start := c.index
+	for attempt := 1; ; attempt++ {
+		c.index = start
+		c.Next()
+		if attempt >= attempts || !retry(c) {
+			return
+		}
+	}
+}
+
 // IsAborted returns true if the current context was aborted.
 func (c *Context) IsAborted() bool {
 	return c.index >= abortIndex
@@ -390,6 +487,42 @@ func (c *Context) Param(key string) string {
 	return c.Params.ByName(key)
 }
 
+// ParamsMap returns the URL params as a map, the first value winning for duplicate keys.
+//     router.GET("/user/:id/posts/:post", func(c *gin.Context) {
+//         // a GET request to /user/john/posts/1
+//         c.ParamsMap() // map[string]string{"id": "john", "post": "1"}
+//     })
+func (c *Context) ParamsMap() map[string]string {
+	params := make(map[string]string, len(c.Params))
+	for _, param := range c.Params {
+		if _, ok := params[param.Key]; !ok {
+			params[param.Key] = param.Value
+		}
+	}
+	return params
+}
+
+// paramsContextKey is the key of the URL params in the context of the requests,
+// see Engine.ParamsInRequestContext.
+type paramsContextKey struct{}
+
+// requestWithParams returns a shallow copy of req whose context holds a copy of params.
+func requestWithParams(req *http.Request, params Params) *http.Request {
+	copied := make(Params, len(params))
+	copy(copied, params)
+	return req.WithContext(context.WithValue(req.Context(), paramsContextKey{}, copied))
+}
+
+// ParamsFromContext returns the URL params stored in the context of the request when
+// Engine.ParamsInRequestContext is enabled, or nil.
+//     router.GET("/user/:id", gin.WrapF(func(w http.ResponseWriter, req *http.Request) {
+//         id := gin.ParamsFromContext(req.Context()).ByName("id")
+//     }))
+func ParamsFromContext(ctx context.Context) Params {
+	params, _ := ctx.Value(paramsContextKey{}).(Params)
+	return params
+}
+
 // AddParam adds param to context and
 // replaces path param key with given value for e2e testing purposes
 // Example Route: "/user/:id"
@@ -523,7 +656,7 @@ func (c *Context) initFormCache() {
 		req := c.Request
 		if err := req.ParseMultipartForm(c.engine.MaxMultipartMemory); err != nil {
 			if !errors.Is(err, http.ErrNotMultipart) {
-				debugPrint("error on parse multipart form array: %v", err)
+				debugPrintCategory(DebugBinding, "error on parse multipart form array: %v", err)
 			}
 		}
 		c.formCache = req.PostForm
@@ -651,6 +784,7 @@ func (c *Context) BindHeader(obj any) error {
 // It will abort the request with HTTP 400 if any error occurs.
 func (c *Context) BindUri(obj any) error {
 	if err := c.ShouldBindUri(obj); err != nil {
+		c.writeMessage(http.StatusBadRequest, MessageBindingFailed, c.messageData(err))
 		c.AbortWithError(http.StatusBadRequest, err).SetType(ErrorTypeBind) // nolint: errcheck
 		return err
 	}
@@ -658,10 +792,12 @@ func (c *Context) BindUri(obj any) error {
 }
 
 // MustBindWith binds the passed struct pointer using the specified binding engine.
-// It will abort the request with HTTP 400 if any error occurs.
+// It will abort the request with HTTP 400 if any error occurs, whose body is the
+// MessageBindingFailed message if the engine has a catalog, see Engine.SetMessageCatalog.
 // See the binding package.
 func (c *Context) MustBindWith(obj any, b binding.Binding) error {
 	if err := c.ShouldBindWith(obj, b); err != nil {
+		c.writeMessage(http.StatusBadRequest, MessageBindingFailed, c.messageData(err))
 		c.AbortWithError(http.StatusBadRequest, err).SetType(ErrorTypeBind) // nolint: errcheck
 		return err
 	}
@@ -722,6 +858,9 @@ func (c *Context) ShouldBindUri(obj any) error {
 // ShouldBindWith binds the passed struct pointer using the specified binding engine.
 // See the binding package.
 func (c *Context) ShouldBindWith(obj any, b binding.Binding) error {
+	if c.engine != nil && c.engine.allocStats != nil {
+		c.engine.allocStats.addBinding(b, c.Request.ContentLength)
+	}
 	return b.Bind(c.Request, obj)
 }
 
@@ -744,6 +883,9 @@ func (c *Context) ShouldBindBodyWith(obj any, bb binding.BindingBody) (err error
 		}
 		c.Set(BodyBytesKey, body)
 	}
+	if c.engine != nil && c.engine.allocStats != nil {
+		c.engine.allocStats.addBinding(bb, int64(len(body)))
+	}
 	return bb.BindBody(body, obj)
 }
 
@@ -776,11 +918,15 @@ func (c *Context) ClientIP() string {
 	if remoteIP == nil {
 		return ""
 	}
-	trusted := c.engine.isTrustedProxy(remoteIP)
+	trustedCIDRs := c.engine.trustedCIDRs
+	if c.tenant != nil && c.tenant.trustedCIDRs != nil {
+		trustedCIDRs = c.tenant.trustedCIDRs
+	}
+	trusted := containsIP(trustedCIDRs, remoteIP)
 
 	if trusted && c.engine.ForwardedByClientIP && c.engine.RemoteIPHeaders != nil {
 		for _, headerName := range c.engine.RemoteIPHeaders {
-			ip, valid := c.engine.validateHeader(c.requestHeader(headerName))
+			ip, valid := validateHeader(c.requestHeader(headerName), trustedCIDRs)
 			if valid {
 				return ip
 			}
@@ -907,16 +1053,27 @@ func (c *Context) Render(code int, r render.Render) {
 		return
 	}
 
+	written := c.Writer.Size()
 	if err := r.Render(c.Writer); err != nil {
 		panic(err)
 	}
+	if c.engine != nil && c.engine.allocStats != nil {
+		if written < 0 {
+			written = 0
+		}
+		c.engine.allocStats.addRender(r, c.Writer.Size()-written)
+	}
 }
 
 // HTML renders the HTTP template specified by its file name.
 // It also updates the HTTP code and sets the Content-Type as "text/html".
 // See http://golang.org/doc/articles/wiki/
 func (c *Context) HTML(code int, name string, obj any) {
-	instance := c.engine.HTMLRender.Instance(name, obj)
+	htmlRender := c.engine.HTMLRender
+	if c.tenant != nil && c.tenant.HTMLRender != nil {
+		htmlRender = c.tenant.HTMLRender
+	}
+	instance := htmlRender.Instance(name, obj)
 	c.Render(code, instance)
 }
 
@@ -950,6 +1107,10 @@ func (c *Context) JSONP(code int, obj any) {
 // JSON serializes the given struct as JSON into the response body.
 // It also sets the Content-Type as "application/json".
 func (c *Context) JSON(code int, obj any) {
+	if c.engine != nil && c.engine.EnableResponseFastPath {
+		c.Render(code, render.SizedJSON{Data: obj})
+		return
+	}
 	c.Render(code, render.JSON{Data: obj})
 }
 
@@ -1055,13 +1216,17 @@ func (c *Context) SSEvent(name string, message any) {
 
 // Stream sends a streaming response and returns a boolean
 // indicates "Is client disconnected in middle of stream"
+// The stream also ends once the engine starts draining, see Engine.Drain.
 func (c *Context) Stream(step func(w io.Writer) bool) bool {
 	w := c.Writer
 	clientGone := w.CloseNotify()
+	drain := c.Draining()
 	for {
 		select {
 		case <-clientGone:
 			return true
+		case <-drain:
+			return false
 		default:
 			keepOpen := step(w)
 			w.Flush()
@@ -1072,6 +1237,64 @@ func (c *Context) Stream(step func(w io.Writer) bool) bool {
 	}
 }
 
+// Hijack takes over the client connection, e.g. for websockets, and aborts the pending
+// handlers since nothing can be written through the ResponseWriter anymore.
+// The caller is responsible for closing the returned connection.
+func (c *Context) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	conn, rw, err := c.Writer.Hijack()
+	if err != nil {
+		return nil, nil, err
+	}
+	c.Abort()
+	return conn, rw, nil
+}
+
+// Tunnel answers a CONNECT request with a 200 status, hijacks the client connection and
+// copies data in both directions between the client and upstream until one side closes.
+// Both connections are closed when Tunnel returns.
+//
+//	router.Handle(http.MethodConnect, "/", func(c *gin.Context) {
+//		upstream, err := net.Dial("tcp", c.Request.Host)
+//		if err != nil {
+//			c.AbortWithStatus(http.StatusBadGateway)
+//			return
+//		}
+//		c.Tunnel(upstream)
+//	})
+func (c *Context) Tunnel(upstream net.Conn) error {
+	defer upstream.Close()
+
+	conn, rw, err := c.Hijack()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err = rw.WriteString("HTTP/1.1 200 Connection Established\r\n\r\n"); err != nil {
+		return err
+	}
+	if err = rw.Flush(); err != nil {
+		return err
+	}
+
+	errc := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(upstream, rw)
+		errc <- err
+	}()
+	go func() {
+		_, err := io.Copy(conn, upstream)
+		errc <- err
+	}()
+
+	err = <-errc
+	// unblock the other direction
+	conn.Close()
+	upstream.Close()
+	<-errc
+	return err
+}
+
 /************************************/
 /******** CONTENT NEGOTIATION *******/
 /************************************/
@@ -1089,7 +1312,9 @@ type Negotiate struct {
 }
 
 // Negotiate calls different Render according to acceptable Accept format.
+// Accept is added to the Vary header.
 func (c *Context) Negotiate(code int, config Negotiate) {
+	c.AddVary("Accept")
 	switch c.NegotiateFormat(config.Offered...) {
 	case binding.MIMEJSON:
 		data := chooseData(config.JSONData, config.Data)
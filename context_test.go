@@ -5,6 +5,7 @@
 package gin
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
@@ -371,6 +372,73 @@ func TestContextHandler(t *testing.T) {
 	assert.Equal(t, reflect.ValueOf(handlerTest).Pointer(), reflect.ValueOf(c.Handler()).Pointer())
 }
 
+func TestContextRemainingHandlersAndInsertNext(t *testing.T) {
+	var order []string
+	track := func(name string) HandlerFunc {
+		return func(c *Context) { order = append(order, name) }
+	}
+
+	var remaining int
+	router := New()
+	router.GET("/", func(c *Context) {
+		remaining = len(c.RemainingHandlers())
+		c.InsertNext(track("inserted"), func(c *Context) {
+			assert.Len(t, c.RemainingHandlers(), 1)
+			c.Next()
+			order = append(order, "after")
+		})
+	}, track("handler"))
+
+	PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, 1, remaining)
+	assert.Equal(t, []string{"inserted", "handler", "after"}, order)
+
+	// the route handlers are left untouched
+	order = nil
+	PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, []string{"inserted", "handler", "after"}, order)
+
+	c, _ := CreateTestContext(httptest.NewRecorder())
+	c.handlers = HandlersChain{handlerTest}
+	c.Abort()
+	assert.Nil(t, c.RemainingHandlers())
+}
+
+func TestContextNextWithRetry(t *testing.T) {
+	calls := 0
+	router := New()
+	router.Use(func(c *Context) {
+		c.NextWithRetry(3, func(c *Context) bool {
+			if c.Writer.Written() || len(c.Errors) == 0 {
+				return false
+			}
+			c.Errors = c.Errors[:0]
+			return true
+		})
+	})
+	router.GET("/", func(c *Context) {
+		calls++
+		if calls < 2 {
+			_ = c.Error(errors.New("unavailable"))
+			c.Abort()
+			return
+		}
+		c.String(http.StatusOK, "%s", "ok")
+	})
+	router.GET("/fail", func(c *Context) {
+		calls++
+		_ = c.Error(errors.New("failure"))
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, "ok", w.Body.String())
+
+	calls = 0
+	PerformRequest(router, http.MethodGet, "/fail")
+	assert.Equal(t, 3, calls)
+}
+
 func TestContextQuery(t *testing.T) {
 	c, _ := CreateTestContext(httptest.NewRecorder())
 	c.Request, _ = http.NewRequest("GET", "http://example.com/?foo=bar&page=10&id=", nil)
@@ -678,6 +746,67 @@ func TestContextRenderJSON(t *testing.T) {
 	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
 }
 
+func TestContextRenderJSONFastPath(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, router := CreateTestContext(w)
+	router.EnableResponseFastPath = true
+
+	c.JSON(http.StatusCreated, H{"foo": "bar", "html": "<b>"})
+
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "{\"foo\":\"bar\",\"html\":\"\\u003cb\\u003e\"}", w.Body.String())
+	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "36", w.Header().Get("Content-Length"))
+
+	w = httptest.NewRecorder()
+	c, router = CreateTestContext(w)
+	router.EnableResponseFastPath = true
+	c.JSON(http.StatusOK, H{"data": strings.Repeat("a", 3000)})
+	assert.Equal(t, "3011", w.Header().Get("Content-Length"))
+
+	w = httptest.NewRecorder()
+	c, router = CreateTestContext(w)
+	router.EnableResponseFastPath = true
+	c.JSON(http.StatusNoContent, H{"foo": "bar"})
+	assert.Empty(t, w.Header().Get("Content-Length"))
+	assert.Empty(t, w.Body.String())
+}
+
+func TestContextRenderJSONFastPathServer(t *testing.T) {
+	for _, fastPath := range []bool{false, true} {
+		router := New()
+		router.EnableResponseFastPath = fastPath
+		router.Use(Compression(CompressionConfig{}))
+		router.GET("/", func(c *Context) {
+			c.JSON(http.StatusOK, H{"data": strings.Repeat("a", 3000)})
+		})
+		srv := httptest.NewServer(router)
+
+		req, _ := http.NewRequest(http.MethodGet, srv.URL, nil)
+		req.Header.Set("Accept-Encoding", "identity")
+		resp, err := srv.Client().Do(req)
+		assert.NoError(t, err)
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		assert.Len(t, body, 3011)
+		if fastPath {
+			assert.Empty(t, resp.TransferEncoding)
+			assert.Equal(t, int64(3011), resp.ContentLength)
+		} else {
+			assert.Equal(t, []string{"chunked"}, resp.TransferEncoding)
+		}
+
+		req, _ = http.NewRequest(http.MethodGet, srv.URL, nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		resp, err = srv.Client().Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, "gzip", resp.Header.Get("Content-Encoding"))
+		assert.NotEqual(t, int64(3011), resp.ContentLength, "the compressed length is not the JSON one")
+		srv.Close()
+	}
+}
+
 // Tests that the response is serialized as JSONP
 // and Content-Type is set to application/javascript
 func TestContextRenderJSONP(t *testing.T) {
@@ -2294,3 +2423,88 @@ func TestContextAddParam(t *testing.T) {
 	assert.Equal(t, ok, true)
 	assert.Equal(t, value, v)
 }
+
+func TestContextHijack(t *testing.T) {
+	logged := make(chan LogFormatterParams, 1)
+	router := New()
+	router.Use(LoggerWithConfig(LoggerConfig{
+		Formatter: func(p LogFormatterParams) string {
+			logged <- p
+			return ""
+		},
+		Output: io.Discard,
+	}))
+	router.GET("/hijack", func(c *Context) {
+		conn, rw, err := c.Hijack()
+		assert.NoError(t, err)
+		defer conn.Close()
+		assert.True(t, c.IsAborted())
+		assert.True(t, hijacked(c.Writer))
+
+		_, err = c.Writer.Write([]byte("ignored"))
+		assert.Equal(t, http.ErrHijacked, err)
+
+		_, _ = rw.WriteString("HTTP/1.1 200 OK\r\nContent-Length: 6\r\nConnection: close\r\n\r\nraw ok")
+		_ = rw.Flush()
+	}, func(c *Context) {
+		t.Error("handler after Hijack must not run")
+	})
+
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/hijack")
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, "raw ok", string(body))
+	params := <-logged
+	assert.True(t, params.Hijacked)
+	assert.Equal(t, 0, params.StatusCode)
+	assert.Equal(t, 0, params.BodySize)
+}
+
+func TestContextHijackNotSupported(t *testing.T) {
+	c, _ := CreateTestContext(&hijackFailRecorder{httptest.NewRecorder()})
+	_, _, err := c.Hijack()
+	assert.Error(t, err)
+	assert.False(t, c.IsAborted())
+	assert.False(t, hijacked(c.Writer))
+}
+
+type hijackFailRecorder struct {
+	*httptest.ResponseRecorder
+}
+
+func (r *hijackFailRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, errors.New("hijack not supported")
+}
+
+func TestContextTunnel(t *testing.T) {
+	upstream, remote := net.Pipe()
+	go func() {
+		buf := make([]byte, 4)
+		_, _ = io.ReadFull(remote, buf)
+		_, _ = remote.Write(append([]byte("echo:"), buf...))
+		remote.Close()
+	}()
+
+	tunnelErr := make(chan error, 1)
+	router := New()
+	router.GET("/tunnel", func(c *Context) {
+		tunnelErr <- c.Tunnel(upstream)
+	})
+	srv := httptest.NewServer(router)
+	defer srv.Close()
+
+	conn, err := net.Dial("tcp", srv.Listener.Addr().String())
+	assert.NoError(t, err)
+	defer conn.Close()
+	_, err = conn.Write([]byte("GET /tunnel HTTP/1.1\r\nHost: example.com\r\n\r\nping"))
+	assert.NoError(t, err)
+
+	data, err := io.ReadAll(conn)
+	assert.NoError(t, err)
+	assert.Equal(t, "HTTP/1.1 200 Connection Established\r\n\r\necho:ping", string(data))
+	assert.NoError(t, <-tunnelErr)
+}
@@ -0,0 +1,344 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Contract is a Pact-style contract between a consumer and the engine, its provider.
+type Contract struct {
+	Consumer     ContractParty         `json:"consumer"`
+	Provider     ContractParty         `json:"provider"`
+	Interactions []ContractInteraction `json:"interactions"`
+}
+
+// ContractParty is the consumer or the provider of a contract.
+type ContractParty struct {
+	Name string `json:"name"`
+}
+
+// ContractInteraction is a request of the consumer and the response it expects.
+type ContractInteraction struct {
+	Description string `json:"description"`
+	// ProviderState is the state of the provider of the version 2 contracts.
+	ProviderState string `json:"providerState,omitempty"`
+	// ProviderStates are the states of the provider of the version 3 contracts.
+	ProviderStates []ContractState  `json:"providerStates,omitempty"`
+	Request        ContractRequest  `json:"request"`
+	Response       ContractResponse `json:"response"`
+}
+
+// ContractState is a state the provider must be in to serve an interaction.
+type ContractState struct {
+	Name   string         `json:"name"`
+	Params map[string]any `json:"params,omitempty"`
+}
+
+// ContractRequest is the request of an interaction.
+type ContractRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Query is a query string, or an object of the values of the params.
+	Query   json.RawMessage   `json:"query,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// ContractResponse is the response expected for an interaction.
+type ContractResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+// ContractStateHandler sets the provider up in a state, e.g. by seeding a database.
+type ContractStateHandler func(params map[string]any) error
+
+// ContractConfig configures Engine.VerifyContract.
+type ContractConfig struct {
+	// States are the handlers of the provider states, by name. The interactions in a
+	// state without handler fail.
+	States map[string]ContractStateHandler
+	// BaseURL is the URL the paths of the requests are resolved against, e.g. to serve
+	// them with a given host. Default value is "http://localhost".
+	BaseURL string
+}
+
+// ContractMismatch is a difference between an interaction and the behavior of the engine.
+type ContractMismatch struct {
+	Interaction string
+	Problem     string
+}
+
+func (m ContractMismatch) String() string {
+	return m.Interaction + ": " + m.Problem
+}
+
+// ContractReport is the result of the verification of a contract.
+type ContractReport struct {
+	Consumer     string
+	Provider     string
+	Interactions int
+	Mismatches   []ContractMismatch
+}
+
+// OK reports whether the engine honors all the interactions of the contract.
+func (r *ContractReport) OK() bool {
+	return len(r.Mismatches) == 0
+}
+
+// VerifyContract verifies the engine against the Pact-style contract file filename, see
+// VerifyContractData.
+func (engine *Engine) VerifyContract(filename string, conf ContractConfig) (*ContractReport, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return engine.VerifyContractData(data, conf)
+}
+
+// VerifyContractData verifies that the engine honors the interactions of the Pact-style
+// contract data, version 2 or 3: for each interaction, the provider states are set up
+// with the handlers of conf, and the request is sent to the engine in memory, see
+// Engine.Client. The status, the expected headers and the body of the response must match
+// the contract, the JSON objects of the body possibly having more fields than expected.
+// The matching rules of the contracts are not supported. It fails only if the contract
+// can not be parsed, the mismatches being listed by the report:
+//
+//	report, err := router.VerifyContract("pacts/web-api.json", gin.ContractConfig{
+//		States: map[string]gin.ContractStateHandler{
+//			"user 42 exists": func(map[string]any) error { return store.Add(User{ID: 42}) },
+//		},
+//	})
+//	require.NoError(t, err)
+//	assert.Empty(t, report.Mismatches)
+func (engine *Engine) VerifyContractData(data []byte, conf ContractConfig) (*ContractReport, error) {
+	var contract Contract
+	if err := json.Unmarshal(data, &contract); err != nil {
+		return nil, fmt.Errorf("gin: invalid contract: %w", err)
+	}
+	baseURL := conf.BaseURL
+	if baseURL == "" {
+		baseURL = "http://localhost"
+	}
+
+	report := &ContractReport{
+		Consumer:     contract.Consumer.Name,
+		Provider:     contract.Provider.Name,
+		Interactions: len(contract.Interactions),
+	}
+	client := engine.Client()
+	for _, interaction := range contract.Interactions {
+		for _, problem := range engine.verifyInteraction(client, baseURL, interaction, conf.States) {
+			report.Mismatches = append(report.Mismatches, ContractMismatch{Interaction: interaction.Description, Problem: problem})
+		}
+	}
+	return report, nil
+}
+
+// verifyInteraction returns the problems of the interaction.
+func (engine *Engine) verifyInteraction(client *http.Client, baseURL string, interaction ContractInteraction, states map[string]ContractStateHandler) []string {
+	providerStates := interaction.ProviderStates
+	if interaction.ProviderState != "" {
+		providerStates = append([]ContractState{{Name: interaction.ProviderState}}, providerStates...)
+	}
+	for _, state := range providerStates {
+		handler, ok := states[state.Name]
+		if !ok {
+			return []string{fmt.Sprintf("no handler for provider state %q", state.Name)}
+		}
+		if err := handler(state.Params); err != nil {
+			return []string{fmt.Sprintf("setting up provider state %q: %v", state.Name, err)}
+		}
+	}
+
+	want := interaction.Response
+	if route, _, _ := engine.Lookup(interaction.Request.Method, interaction.Request.Path); route == nil && want.Status != http.StatusNotFound && want.Status != http.StatusMethodNotAllowed {
+		return []string{fmt.Sprintf("no route matches %s %s", interaction.Request.Method, interaction.Request.Path)}
+	}
+
+	req, err := newContractRequest(baseURL, interaction.Request)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return []string{err.Error()}
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	var problems []string
+	if want.Status != 0 && resp.StatusCode != want.Status {
+		problems = append(problems, fmt.Sprintf("expected status %d, got %d", want.Status, resp.StatusCode))
+	}
+	names := make([]string, 0, len(want.Headers))
+	for name := range want.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if got := strings.Join(resp.Header.Values(name), ", "); got != want.Headers[name] {
+			problems = append(problems, fmt.Sprintf("expected header %s %q, got %q", name, want.Headers[name], got))
+		}
+	}
+	if len(want.Body) > 0 {
+		problems = append(problems, contractBodyProblems(want.Body, body)...)
+	}
+	return problems
+}
+
+// newContractRequest returns the request of the interaction.
+func newContractRequest(baseURL string, creq ContractRequest) (*http.Request, error) {
+	u, err := url.Parse(strings.TrimSuffix(baseURL, "/") + creq.Path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid request path: %w", err)
+	}
+	if len(creq.Query) > 0 {
+		var query string
+		var values url.Values
+		switch {
+		case json.Unmarshal(creq.Query, &query) == nil:
+			u.RawQuery = query
+		case json.Unmarshal(creq.Query, &values) == nil:
+			u.RawQuery = values.Encode()
+		default:
+			return nil, fmt.Errorf("invalid request query %s", creq.Query)
+		}
+	}
+
+	var body io.Reader
+	contentType := ""
+	if len(creq.Body) > 0 {
+		var text string
+		if json.Unmarshal(creq.Body, &text) == nil && !isJSONContentType(creq.Headers) {
+			body = strings.NewReader(text)
+		} else {
+			body = bytes.NewReader(creq.Body)
+			contentType = MIMEJSON
+		}
+	}
+	req, err := http.NewRequest(creq.Method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	for name, value := range creq.Headers {
+		req.Header.Set(name, value)
+	}
+	return req, nil
+}
+
+// isJSONContentType reports whether the content type of headers is JSON.
+func isJSONContentType(headers map[string]string) bool {
+	for name, value := range headers {
+		if strings.EqualFold(name, "Content-Type") {
+			return strings.Contains(value, "json")
+		}
+	}
+	return false
+}
+
+// contractBodyProblems returns the differences between the expected body want and the
+// actual one got.
+func contractBodyProblems(want json.RawMessage, got []byte) []string {
+	var wantValue, gotValue any
+	dec := json.NewDecoder(bytes.NewReader(want))
+	dec.UseNumber()
+	if err := dec.Decode(&wantValue); err != nil {
+		return []string{fmt.Sprintf("invalid expected body: %v", err)}
+	}
+	if text, ok := wantValue.(string); ok && !json.Valid(got) {
+		if text != string(got) {
+			return []string{fmt.Sprintf("expected body %q, got %q", text, got)}
+		}
+		return nil
+	}
+	dec = json.NewDecoder(bytes.NewReader(got))
+	dec.UseNumber()
+	if err := dec.Decode(&gotValue); err != nil {
+		return []string{fmt.Sprintf("expected a JSON body, got %q", got)}
+	}
+	return contractValueProblems("$", wantValue, gotValue, nil)
+}
+
+// contractValueProblems appends the differences between the JSON values at path to
+// problems, the objects of got possibly having more fields than the ones of want.
+func contractValueProblems(path string, want, got any, problems []string) []string {
+	switch want := want.(type) {
+	case map[string]any:
+		gotObject, ok := got.(map[string]any)
+		if !ok {
+			return append(problems, fmt.Sprintf("%s: expected an object, got %s", path, contractJSON(got)))
+		}
+		keys := make([]string, 0, len(want))
+		for key := range want {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value, ok := gotObject[key]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s.%s: missing", path, key))
+				continue
+			}
+			problems = contractValueProblems(path+"."+key, want[key], value, problems)
+		}
+		return problems
+	case []any:
+		gotArray, ok := got.([]any)
+		if !ok {
+			return append(problems, fmt.Sprintf("%s: expected an array, got %s", path, contractJSON(got)))
+		}
+		if len(gotArray) != len(want) {
+			return append(problems, fmt.Sprintf("%s: expected %d elements, got %d", path, len(want), len(gotArray)))
+		}
+		for i := range want {
+			problems = contractValueProblems(fmt.Sprintf("%s[%d]", path, i), want[i], gotArray[i], problems)
+		}
+		return problems
+	}
+	if !contractScalarEqual(want, got) {
+		problems = append(problems, fmt.Sprintf("%s: expected %s, got %s", path, contractJSON(want), contractJSON(got)))
+	}
+	return problems
+}
+
+// contractScalarEqual reports whether the JSON scalars are equal, the numbers by value.
+func contractScalarEqual(want, got any) bool {
+	wantNumber, ok1 := want.(json.Number)
+	gotNumber, ok2 := got.(json.Number)
+	if ok1 && ok2 {
+		w, err1 := wantNumber.Float64()
+		g, err2 := gotNumber.Float64()
+		if err1 == nil && err2 == nil {
+			return w == g
+		}
+	}
+	return reflect.DeepEqual(want, got)
+}
+
+func contractJSON(value any) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(data)
+}
@@ -0,0 +1,174 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testContract = `{
+  "consumer": {"name": "web"},
+  "provider": {"name": "api"},
+  "interactions": [
+    {
+      "description": "get an existing user",
+      "providerState": "user 42 exists",
+      "request": {"method": "GET", "path": "/users/42", "query": "fields=name"},
+      "response": {
+        "status": 200,
+        "headers": {"Content-Type": "application/json; charset=utf-8"},
+        "body": {"id": 42, "name": "gopher", "tags": ["a", "b"]}
+      }
+    },
+    {
+      "description": "get a missing user",
+      "providerStates": [{"name": "no users"}],
+      "request": {"method": "GET", "path": "/users/7"},
+      "response": {"status": 404}
+    },
+    {
+      "description": "create a user",
+      "request": {"method": "POST", "path": "/users", "query": {"notify": ["true"]}, "body": {"name": "gordon"}},
+      "response": {"status": 201, "body": {"name": "gordon", "notify": "true"}}
+    },
+    {
+      "description": "ping",
+      "request": {"method": "GET", "path": "/ping"},
+      "response": {"status": 200, "body": "pong"}
+    }
+  ]
+}`
+
+func newContractRouter(users map[string]string) *Engine {
+	router := New()
+	router.GET("/users/:id", func(c *Context) {
+		name, ok := users[c.Param("id")]
+		if !ok {
+			c.Status(http.StatusNotFound)
+			return
+		}
+		c.JSON(http.StatusOK, H{"id": 42, "name": name, "tags": []string{"a", "b"}, "extra": true})
+	})
+	router.POST("/users", func(c *Context) {
+		var user struct {
+			Name string `json:"name"`
+		}
+		if err := c.ShouldBindJSON(&user); err != nil {
+			c.Status(http.StatusBadRequest)
+			return
+		}
+		c.JSON(http.StatusCreated, H{"name": user.Name, "notify": c.Query("notify")})
+	})
+	router.GET("/ping", func(c *Context) {
+		c.String(http.StatusOK, "pong")
+	})
+	return router
+}
+
+func TestEngineVerifyContract(t *testing.T) {
+	users := make(map[string]string)
+	router := newContractRouter(users)
+	states := map[string]ContractStateHandler{
+		"user 42 exists": func(map[string]any) error {
+			users["42"] = "gopher"
+			return nil
+		},
+		"no users": func(map[string]any) error {
+			for id := range users {
+				delete(users, id)
+			}
+			return nil
+		},
+	}
+
+	filename := filepath.Join(t.TempDir(), "web-api.json")
+	assert.NoError(t, os.WriteFile(filename, []byte(testContract), 0o644))
+	report, err := router.VerifyContract(filename, ContractConfig{States: states})
+	assert.NoError(t, err)
+	assert.Equal(t, "web", report.Consumer)
+	assert.Equal(t, "api", report.Provider)
+	assert.Equal(t, 4, report.Interactions)
+	assert.Empty(t, report.Mismatches)
+	assert.True(t, report.OK())
+}
+
+func TestEngineVerifyContractMismatches(t *testing.T) {
+	router := newContractRouter(map[string]string{"42": "gordon"})
+	router.GET("/status", func(c *Context) {
+		c.JSON(http.StatusOK, H{"up": "yes"})
+	})
+
+	report, err := router.VerifyContractData([]byte(`{
+  "interactions": [
+    {
+      "description": "wrong body",
+      "request": {"method": "GET", "path": "/users/42"},
+      "response": {"status": 200, "headers": {"X-Version": "2"}, "body": {"name": "gopher", "age": 3, "tags": ["a"]}}
+    },
+    {
+      "description": "unknown route",
+      "request": {"method": "GET", "path": "/accounts/1"},
+      "response": {"status": 200}
+    },
+    {
+      "description": "wrong status",
+      "request": {"method": "POST", "path": "/users", "body": "not json"},
+      "response": {"status": 201}
+    },
+    {
+      "description": "missing state",
+      "providerState": "the moon is full",
+      "request": {"method": "GET", "path": "/ping"},
+      "response": {"status": 200}
+    },
+    {
+      "description": "failing state",
+      "providerState": "broken",
+      "request": {"method": "GET", "path": "/ping"},
+      "response": {"status": 200}
+    },
+    {
+      "description": "wrong type",
+      "request": {"method": "GET", "path": "/status"},
+      "response": {"body": {"up": true}}
+    }
+  ]
+}`), ContractConfig{States: map[string]ContractStateHandler{
+		"broken": func(map[string]any) error { return errors.New("database down") },
+	}})
+	assert.NoError(t, err)
+	assert.False(t, report.OK())
+
+	var problems []string
+	for _, mismatch := range report.Mismatches {
+		problems = append(problems, mismatch.String())
+	}
+	assert.Equal(t, []string{
+		`wrong body: expected header X-Version "2", got ""`,
+		`wrong body: $.age: missing`,
+		`wrong body: $.name: expected "gopher", got "gordon"`,
+		`wrong body: $.tags: expected 1 elements, got 2`,
+		`unknown route: no route matches GET /accounts/1`,
+		`wrong status: expected status 201, got 400`,
+		`missing state: no handler for provider state "the moon is full"`,
+		`failing state: setting up provider state "broken": database down`,
+		`wrong type: $.up: expected true, got "yes"`,
+	}, problems)
+}
+
+func TestEngineVerifyContractInvalid(t *testing.T) {
+	router := New()
+	_, err := router.VerifyContractData([]byte(`{"interactions": 1}`), ContractConfig{})
+	assert.Error(t, err)
+
+	_, err = router.VerifyContract(filepath.Join(t.TempDir(), "missing.json"), ContractConfig{})
+	assert.Error(t, err)
+}
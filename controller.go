@@ -0,0 +1,196 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// ControllerRoute is a route declared by a RouteProvider.
+type ControllerRoute struct {
+	Method   string
+	Path     string
+	Handlers HandlersChain
+}
+
+// RouteProvider is implemented by the controllers declaring their routes themselves, see
+// RouterGroup.Register.
+type RouteProvider interface {
+	// ControllerRoutes returns the routes, relative to the prefix of the controller.
+	ControllerRoutes() []ControllerRoute
+}
+
+// RoutePrefixer is implemented by the controllers choosing their prefix, see
+// RouterGroup.Register.
+type RoutePrefixer interface {
+	// RoutePrefix returns the path of the controller, relative to the group.
+	RoutePrefix() string
+}
+
+// controllerMethods maps the first word of the controller method names to the HTTP methods.
+var controllerMethods = map[string]string{
+	"Get":     http.MethodGet,
+	"Post":    http.MethodPost,
+	"Put":     http.MethodPut,
+	"Patch":   http.MethodPatch,
+	"Delete":  http.MethodDelete,
+	"Head":    http.MethodHead,
+	"Options": http.MethodOptions,
+}
+
+// Register registers the routes of controller in a group, which it returns, whose path
+// is the RoutePrefix of controller or else its type name, without the Controller
+// suffix, in kebab case, and whose middleware are handlers. Routes are the
+// ControllerRoutes of controller if it is a RouteProvider, or else its exported methods
+// taking a *Context whose name follows the convention HTTP method, path words, and "By"
+// followed by params separated by "And":
+//
+//	type UserController struct{}
+//
+//	func (UserController) Get(c *gin.Context)                     // GET    /user
+//	func (UserController) GetByID(c *gin.Context)                 // GET    /user/:id
+//	func (UserController) PostFollowers(c *gin.Context)           // POST   /user/followers
+//	func (UserController) DeleteTeamsByTeamIDAndID(c *gin.Context) // DELETE /user/teams/:team_id/:id
+//
+//	router.Register(UserController{}, auth)
+//
+// It panics if controller has no routes.
+func (group *RouterGroup) Register(controller any, handlers ...HandlerFunc) *RouterGroup {
+	assert1(controller != nil, "controller can not be nil")
+	prefix := controllerPrefix(controller)
+	child := group.Group(prefix, handlers...)
+
+	var routes []ControllerRoute
+	if provider, ok := controller.(RouteProvider); ok {
+		routes = provider.ControllerRoutes()
+	} else {
+		routes = controllerMethodRoutes(controller)
+	}
+	assert1(len(routes) > 0, "controller "+reflect.TypeOf(controller).String()+" has no routes")
+	for _, route := range routes {
+		child.Handle(route.Method, route.Path, route.Handlers...)
+	}
+	return child
+}
+
+// controllerPrefix returns the path of the group of controller.
+func controllerPrefix(controller any) string {
+	if prefixer, ok := controller.(RoutePrefixer); ok {
+		return prefixer.RoutePrefix()
+	}
+	t := reflect.TypeOf(controller)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	name := strings.TrimSuffix(t.Name(), "Controller")
+	if name == "" {
+		return "/"
+	}
+	return "/" + strings.ToLower(strings.Join(splitCamelCase(name), "-"))
+}
+
+// controllerMethodRoutes returns the routes of the methods of controller following the
+// naming convention.
+func controllerMethodRoutes(controller any) []ControllerRoute {
+	var routes []ControllerRoute
+	v := reflect.ValueOf(controller)
+	t := v.Type()
+	for i := 0; i < t.NumMethod(); i++ {
+		var handler HandlerFunc
+		switch f := v.Method(i).Interface().(type) {
+		case func(*Context):
+			handler = f
+		case HandlerFunc:
+			handler = f
+		default:
+			continue
+		}
+		method, path, ok := controllerMethodRoute(t.Method(i).Name)
+		if !ok {
+			continue
+		}
+		routes = append(routes, ControllerRoute{Method: method, Path: path, Handlers: HandlersChain{handler}})
+	}
+	return routes
+}
+
+// controllerMethodRoute returns the HTTP method and the path of the controller method name.
+func controllerMethodRoute(name string) (method, path string, ok bool) {
+	words := splitCamelCase(name)
+	if len(words) == 0 {
+		return "", "", false
+	}
+	if method, ok = controllerMethods[words[0]]; !ok {
+		return "", "", false
+	}
+	words = words[1:]
+
+	var segments, params []string
+	var param []string
+	inParams := false
+	for _, word := range words {
+		switch {
+		case word == "By" && !inParams:
+			inParams = true
+		case word == "And" && inParams:
+			if len(param) == 0 {
+				return "", "", false
+			}
+			params = append(params, strings.ToLower(strings.Join(param, "_")))
+			param = nil
+		case inParams:
+			param = append(param, word)
+		default:
+			segments = append(segments, strings.ToLower(word))
+		}
+	}
+	if inParams {
+		if len(param) == 0 {
+			return "", "", false
+		}
+		params = append(params, strings.ToLower(strings.Join(param, "_")))
+	}
+
+	var sb strings.Builder
+	if len(segments) > 0 {
+		sb.WriteString("/")
+		sb.WriteString(strings.Join(segments, "-"))
+	}
+	for _, p := range params {
+		sb.WriteString("/:")
+		sb.WriteString(p)
+	}
+	return method, sb.String(), true
+}
+
+// splitCamelCase splits a camel case identifier into words, keeping the acronyms
+// together, e.g. "GetHTTPServerByID" into Get, HTTP, Server, By and ID.
+func splitCamelCase(s string) []string {
+	runes := []rune(s)
+	var words []string
+	start := 0
+	for i := 1; i < len(runes); i++ {
+		prev, cur := runes[i-1], runes[i]
+		next := cur
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+		switch {
+		case unicode.IsUpper(cur) && !unicode.IsUpper(prev):
+		case unicode.IsUpper(cur) && unicode.IsUpper(prev) && unicode.IsLower(next) && i+1 < len(runes):
+		default:
+			continue
+		}
+		words = append(words, string(runes[start:i]))
+		start = i
+	}
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type UserAccountController struct {
+	greeting string
+}
+
+func (u *UserAccountController) Get(c *Context) {
+	c.String(http.StatusOK, "%s all", u.greeting)
+}
+
+func (u *UserAccountController) GetByID(c *Context) {
+	c.String(http.StatusOK, "%s %s", u.greeting, c.Param("id"))
+}
+
+func (u *UserAccountController) PostFollowers(c *Context) {
+	c.String(http.StatusCreated, "followed")
+}
+
+func (u *UserAccountController) DeleteTeamsByTeamIDAndID(c *Context) {
+	c.String(http.StatusOK, "%s/%s", c.Param("team_id"), c.Param("id"))
+}
+
+func (u *UserAccountController) Getter() string { return "" }
+
+func (u *UserAccountController) GetHelper(n int) {}
+
+type healthController struct{}
+
+func (healthController) RoutePrefix() string { return "/healthz" }
+
+func (healthController) ControllerRoutes() []ControllerRoute {
+	return []ControllerRoute{{Method: http.MethodGet, Path: "", Handlers: HandlersChain{func(c *Context) {
+		c.String(http.StatusOK, "ok")
+	}}}}
+}
+
+type emptyController struct{}
+
+func TestRegisterController(t *testing.T) {
+	r := New()
+	var middleware int
+	group := r.Group("/api").Register(&UserAccountController{greeting: "hello"}, func(c *Context) {
+		middleware++
+	})
+	assert.Equal(t, "/api/user-account", group.BasePath())
+
+	w := PerformRequest(r, http.MethodGet, "/api/user-account")
+	assert.Equal(t, "hello all", w.Body.String())
+	w = PerformRequest(r, http.MethodGet, "/api/user-account/42")
+	assert.Equal(t, "hello 42", w.Body.String())
+	w = PerformRequest(r, http.MethodPost, "/api/user-account/followers")
+	assert.Equal(t, http.StatusCreated, w.Code)
+	w = PerformRequest(r, http.MethodDelete, "/api/user-account/teams/7/42")
+	assert.Equal(t, "7/42", w.Body.String())
+	assert.Equal(t, 4, middleware)
+	assert.Len(t, r.Routes(), 4)
+
+	r.Register(healthController{})
+	w = PerformRequest(r, http.MethodGet, "/healthz")
+	assert.Equal(t, "ok", w.Body.String())
+
+	assert.Panics(t, func() {
+		r.Register(emptyController{})
+	})
+	assert.Panics(t, func() {
+		r.Register(nil)
+	})
+}
+
+func TestControllerMethodRoute(t *testing.T) {
+	for _, test := range []struct {
+		name, method, path string
+		ok                 bool
+	}{
+		{"Get", http.MethodGet, "", true},
+		{"PutByID", http.MethodPut, "/:id", true},
+		{"GetHTTPServers", http.MethodGet, "/http-servers", true},
+		{"OptionsUserPosts", http.MethodOptions, "/user-posts", true},
+		{"PatchPostsByPostIDAndCommentID", http.MethodPatch, "/posts/:post_id/:comment_id", true},
+		{"GetBy", "", "", false},
+		{"GetByIDAnd", "", "", false},
+		{"Getter", "", "", false},
+		{"Fetch", "", "", false},
+	} {
+		method, path, ok := controllerMethodRoute(test.name)
+		assert.Equal(t, test.ok, ok, test.name)
+		if ok {
+			assert.Equal(t, test.method, method, test.name)
+			assert.Equal(t, test.path, path, test.name)
+		}
+	}
+}
+
+func TestSplitCamelCase(t *testing.T) {
+	assert.Equal(t, []string{"Get", "HTTP", "Server", "By", "ID"}, splitCamelCase("GetHTTPServerByID"))
+	assert.Equal(t, []string{"user", "ID"}, splitCamelCase("userID"))
+	assert.Nil(t, splitCamelCase(""))
+}
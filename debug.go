@@ -10,6 +10,7 @@ import (
 	"runtime"
 	"strconv"
 	"strings"
+	"sync/atomic"
 )
 
 const ginSupportMinGoVer = 14
@@ -17,33 +18,107 @@ const ginSupportMinGoVer = 14
 // IsDebugging returns true if the framework is running in debug mode.
 // Use SetMode(gin.ReleaseMode) to disable debug mode.
 func IsDebugging() bool {
-	return ginMode == debugCode
+	return atomic.LoadUint32(&debugOutput)&debugModeBit != 0
 }
 
-// DebugPrintRouteFunc indicates debug log output format.
+// Debug output categories, see SetDebugCategories.
+const (
+	// DebugRouter is the category of the route summary and of the routing messages.
+	DebugRouter = "router"
+	// DebugTree is the category of the messages of the tree lookups, e.g. the redirects
+	// of the paths with a trailing slash.
+	DebugTree = "tree"
+	// DebugRender is the category of the messages of the templates and of the rendering
+	// of the responses.
+	DebugRender = "render"
+	// DebugBinding is the category of the messages of the request binding.
+	DebugBinding = "binding"
+)
+
+// EnvGinDebugCategories is the environment variable holding the comma separated debug
+// output categories enabled at startup, see SetDebugCategories.
+const EnvGinDebugCategories = "GIN_DEBUG_CATEGORIES"
+
+var debugCategoryBits = map[string]uint32{
+	DebugRouter:  1 << 0,
+	DebugTree:    1 << 1,
+	DebugRender:  1 << 2,
+	DebugBinding: 1 << 3,
+}
+
+// enabledDebugCategories is the set of the enabled categories, all by default.
+var enabledDebugCategories uint32 = 1<<len(debugCategoryBits) - 1
+
+// debugModeBit is set in debugOutput in debug mode.
+const debugModeBit = 1 << 31
+
+// debugOutput holds debugModeBit and the bits of the enabled categories in debug mode, and
+// is zero otherwise. It is loaded atomically, so that the debug output costs a single load
+// on the request paths when it is disabled, and the mode can be changed while serving.
+var debugOutput uint32
+
+// updateDebugOutput updates debugOutput after a change of the mode or of the categories.
+func updateDebugOutput() {
+	var output uint32
+	if ginMode == debugCode {
+		output = debugModeBit | enabledDebugCategories
+	}
+	atomic.StoreUint32(&debugOutput, output)
+}
+
+// SetDebugCategories enables the categories of debug output, among DebugRouter,
+// DebugTree, DebugRender and DebugBinding, and disables the others, so that the debug mode
+// prints the warnings and the messages of interest only, e.g. during tests:
+//
+//	gin.SetDebugCategories(gin.DebugRouter)
+//
+// All the categories are enabled by default, or else the ones of the EnvGinDebugCategories
+// environment variable. The messages without category, e.g. the warnings about the
+// configuration, are always printed in debug mode. It panics if a category is unknown.
+func SetDebugCategories(categories ...string) {
+	var enabled uint32
+	for _, category := range categories {
+		bit, ok := debugCategoryBits[category]
+		if !ok {
+			panic("gin debug category unknown: " + category + " (available categories: router tree render binding)")
+		}
+		enabled |= bit
+	}
+	enabledDebugCategories = enabled
+	updateDebugOutput()
+}
+
+// IsDebuggingCategory returns true if the framework is running in debug mode and the
+// debug output category is enabled, see SetDebugCategories.
+func IsDebuggingCategory(category string) bool {
+	output := atomic.LoadUint32(&debugOutput)
+	if output == 0 {
+		return false
+	}
+	bit, ok := debugCategoryBits[category]
+	return ok && output&bit != 0
+}
+
+// DebugPrintRouteFunc is called for each route as it is registered, in debug mode. By
+// default the routes are not printed one by one, but in a summary when the engine starts
+// serving, see DebugRouteSummaryFormat.
 var DebugPrintRouteFunc func(httpMethod, absolutePath, handlerName string, nuHandlers int)
 
 func debugPrintRoute(httpMethod, absolutePath string, handlers HandlersChain) {
-	if IsDebugging() {
-		nuHandlers := len(handlers)
-		handlerName := nameOfFunction(handlers.Last())
-		if DebugPrintRouteFunc == nil {
-			debugPrint("%-6s %-25s --> %s (%d handlers)\n", httpMethod, absolutePath, handlerName, nuHandlers)
-		} else {
-			DebugPrintRouteFunc(httpMethod, absolutePath, handlerName, nuHandlers)
-		}
+	if IsDebuggingCategory(DebugRouter) && DebugPrintRouteFunc != nil {
+		DebugPrintRouteFunc(httpMethod, absolutePath, nameOfFunction(handlers.Last()), len(handlers))
 	}
 }
 
 func debugPrintLoadTemplate(tmpl *template.Template) {
-	if IsDebugging() {
+	if IsDebuggingCategory(DebugRender) {
 		var buf strings.Builder
 		for _, tmpl := range tmpl.Templates() {
 			buf.WriteString("\t- ")
 			buf.WriteString(tmpl.Name())
 			buf.WriteString("\n")
 		}
-		debugPrint("Loaded HTML Templates (%d): \n%s\n", len(tmpl.Templates()), buf.String())
+		debugPrintCategory(DebugRender, "Loaded HTML Templates (%d): \n%s\n", len(tmpl.Templates()), buf.String())
 	}
 }
 
@@ -56,6 +131,22 @@ func debugPrint(format string, values ...any) {
 	}
 }
 
+// debugPrintCategory prints the message like debugPrint if its category is enabled.
+func debugPrintCategory(category, format string, values ...any) {
+	if IsDebuggingCategory(category) {
+		debugPrint(format, values...)
+	}
+}
+
+// debugPrintLazy prints the message returned by message if its category is enabled. On
+// the request paths, it saves the formatting and the boxing of the values of
+// debugPrintCategory when the debug output is disabled.
+func debugPrintLazy(category string, message func() string) {
+	if IsDebuggingCategory(category) {
+		debugPrint("%s", message())
+	}
+}
+
 func getMinVer(v string) (uint64, error) {
 	first := strings.IndexByte(v, '.')
 	last := strings.LastIndexByte(v, '.')
@@ -0,0 +1,242 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"net/http"
+	"net/url"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+)
+
+const (
+	// debugPageMaxFrames is the maximum number of stack frames of the debug page.
+	debugPageMaxFrames = 32
+	// debugPageContext is the number of source lines shown around the line of a frame.
+	debugPageContext = 3
+)
+
+// debugPageSecrets are the words of the names of the headers, query and path params whose
+// values are hidden by the debug page, e.g. Authorization, X-Api-Key or access_token.
+var debugPageSecrets = []string{"auth", "cookie", "token", "key", "secret", "session", "password", "signature", "credential"}
+
+// debugPageSecret returns true if the value named name is hidden by the debug page.
+func debugPageSecret(name string) bool {
+	name = strings.ToLower(name)
+	for _, secret := range debugPageSecrets {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+type debugSourceLine struct {
+	Number  int
+	Text    string
+	Current bool
+}
+
+type debugFrame struct {
+	Function string
+	File     string
+	Line     int
+	Source   []debugSourceLine
+}
+
+type debugHeader struct {
+	Name  string
+	Value string
+}
+
+type debugPageData struct {
+	Panic   string
+	Method  string
+	URL     string
+	Route   string
+	Handler string
+	Params  Params
+	Headers []debugHeader
+	Frames  []debugFrame
+}
+
+var debugPageTemplate = template.Must(template.New("debug").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>panic: {{.Panic}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+h1 { color: #b00; font-size: 1.4em; word-break: break-all; }
+h2 { font-size: 1.1em; border-bottom: 1px solid #ccc; }
+table { border-collapse: collapse; }
+td, th { text-align: left; padding: 2px 12px 2px 0; vertical-align: top; font-family: monospace; }
+.frame { margin-bottom: 1em; }
+.func { font-weight: bold; font-family: monospace; }
+.file { color: #666; font-family: monospace; }
+pre { background: #f6f6f6; padding: 4px; margin: 4px 0; overflow-x: auto; }
+.current { background: #fdd; display: block; }
+</style>
+</head>
+<body>
+<h1>panic: {{.Panic}}</h1>
+<h2>Request</h2>
+<table>
+<tr><th>Method</th><td>{{.Method}}</td></tr>
+<tr><th>URL</th><td>{{.URL}}</td></tr>
+<tr><th>Route</th><td>{{.Route}}</td></tr>
+<tr><th>Handler</th><td>{{.Handler}}</td></tr>
+</table>
+{{if .Params}}<h2>Params</h2>
+<table>
+{{range .Params}}<tr><th>{{.Key}}</th><td>{{.Value}}</td></tr>
+{{end}}</table>
+{{end}}<h2>Headers</h2>
+<table>
+{{range .Headers}}<tr><th>{{.Name}}</th><td>{{.Value}}</td></tr>
+{{end}}</table>
+<h2>Stack</h2>
+{{range .Frames}}<div class="frame">
+<div class="func">{{.Function}}</div>
+<div class="file">{{.File}}:{{.Line}}</div>
+{{if .Source}}<pre>{{range .Source}}<span{{if .Current}} class="current"{{end}}>{{printf "%4d" .Number}}  {{.Text}}</span>
+{{end}}</pre>{{end}}
+</div>
+{{end}}<p>This page is shown in debug mode only, see gin.SetMode.</p>
+</body>
+</html>
+`))
+
+// writeDebugPage writes the HTML page describing the panic err, with the stack and the
+// request, with a 500, and reports whether it did. It is never written in release or
+// test mode, nor once the response is written. The values of the headers and params
+// named like secrets are hidden, see debugPageSecrets.
+func (c *Context) writeDebugPage(err any) bool {
+	if !IsDebugging() || c.Writer.Written() {
+		return false
+	}
+
+	data := debugPageData{
+		Panic:   fmt.Sprint(err),
+		Method:  c.Request.Method,
+		URL:     debugPageURL(c.Request.URL, c.Params),
+		Route:   c.FullPath(),
+		Handler: c.HandlerName(),
+		Frames:  debugPanicFrames(),
+	}
+	for _, param := range c.Params {
+		if debugPageSecret(param.Key) {
+			param.Value = "*"
+		}
+		data.Params = append(data.Params, param)
+	}
+	for name, values := range c.Request.Header {
+		value := strings.Join(values, ", ")
+		if debugPageSecret(name) {
+			value = "*"
+		}
+		data.Headers = append(data.Headers, debugHeader{Name: name, Value: value})
+	}
+	sort.Slice(data.Headers, func(i, j int) bool {
+		return data.Headers[i].Name < data.Headers[j].Name
+	})
+
+	var buf bytes.Buffer
+	if err := debugPageTemplate.Execute(&buf, data); err != nil {
+		debugPrint("[WARNING] cannot render debug page: %v\n", err)
+		return false
+	}
+	c.Data(http.StatusInternalServerError, MIMEHTML+"; charset=utf-8", buf.Bytes())
+	return true
+}
+
+// debugPageURL returns the URL u, the values of its query params and of the path params
+// named like secrets being hidden.
+func debugPageURL(u *url.URL, params Params) string {
+	shown := *u
+	redacted := false
+	for _, param := range params {
+		if param.Value == "" || !debugPageSecret(param.Key) {
+			continue
+		}
+		segments := strings.Split(shown.EscapedPath(), "/")
+		for i, segment := range segments {
+			if segment == url.PathEscape(param.Value) {
+				segments[i] = "*"
+				redacted = true
+			}
+		}
+		shown.RawPath = strings.Join(segments, "/")
+		shown.Path, _ = url.PathUnescape(shown.RawPath)
+	}
+	query := u.Query()
+	for name, values := range query {
+		if debugPageSecret(name) {
+			for i := range values {
+				values[i] = "*"
+			}
+			redacted = true
+		}
+	}
+	if !redacted {
+		return u.String()
+	}
+	if shown.RawQuery != "" {
+		shown.RawQuery = query.Encode()
+	}
+	return shown.String()
+}
+
+// debugPanicFrames returns the frames of the stack of the panic being recovered, from the
+// frame which panicked.
+func debugPanicFrames() []debugFrame {
+	pcs := make([]uintptr, 128)
+	pcs = pcs[:runtime.Callers(2, pcs)]
+	frames := runtime.CallersFrames(pcs)
+
+	var all []runtime.Frame
+	panicked := -1
+	for {
+		frame, more := frames.Next()
+		all = append(all, frame)
+		if frame.Function == "runtime.gopanic" {
+			panicked = len(all)
+		}
+		if !more {
+			break
+		}
+	}
+	if panicked > 0 {
+		all = all[panicked:]
+	}
+	if len(all) > debugPageMaxFrames {
+		all = all[:debugPageMaxFrames]
+	}
+
+	sources := make(map[string][]string)
+	result := make([]debugFrame, 0, len(all))
+	for _, frame := range all {
+		lines, ok := sources[frame.File]
+		if !ok {
+			if data, err := os.ReadFile(frame.File); err == nil {
+				lines = strings.Split(string(data), "\n")
+			}
+			sources[frame.File] = lines
+		}
+		f := debugFrame{Function: frame.Function, File: frame.File, Line: frame.Line}
+		for n := frame.Line - debugPageContext; n <= frame.Line+debugPageContext; n++ {
+			if n >= 1 && n <= len(lines) {
+				f.Source = append(f.Source, debugSourceLine{Number: n, Text: lines[n-1], Current: n == frame.Line})
+			}
+		}
+		result = append(result, f)
+	}
+	return result
+}
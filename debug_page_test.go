@@ -0,0 +1,110 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newDebugPageRouter() *Engine {
+	router := New()
+	router.Use(RecoveryWithConfig(RecoveryConfig{Output: &bytes.Buffer{}, DebugPage: true}))
+	router.GET("/users/:id", func(c *Context) {
+		panic("user <script> not found")
+	})
+	router.GET("/written", func(c *Context) {
+		c.String(http.StatusOK, "partial")
+		panic("late")
+	})
+	return router
+}
+
+func TestRecoveryDebugPage(t *testing.T) {
+	SetMode(DebugMode)
+	defer SetMode(TestMode)
+
+	router := newDebugPageRouter()
+	w := PerformRequest(router, http.MethodGet, "/users/42?x=1", header{"Authorization", "Bearer secret"}, header{"X-Trace", "abc"},
+		header{"X-Api-Key", "key-secret"}, header{"X-Auth-Token", "token-secret"})
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+
+	body := w.Body.String()
+	assert.Contains(t, body, "<h1>panic: user &lt;script&gt; not found</h1>")
+	assert.Contains(t, body, "<tr><th>URL</th><td>/users/42?x=1</td></tr>")
+	assert.Contains(t, body, "<tr><th>Route</th><td>/users/:id</td></tr>")
+	assert.Contains(t, body, "<tr><th>id</th><td>42</td></tr>")
+	assert.Contains(t, body, "<tr><th>X-Trace</th><td>abc</td></tr>")
+	assert.Contains(t, body, "<tr><th>Authorization</th><td>*</td></tr>")
+	assert.Contains(t, body, "<tr><th>X-Api-Key</th><td>*</td></tr>")
+	assert.Contains(t, body, "<tr><th>X-Auth-Token</th><td>*</td></tr>")
+	assert.NotContains(t, body, "<td>Bearer")
+	assert.NotContains(t, body, "<td>key-secret")
+	assert.Contains(t, body, "debug_page_test.go")
+	assert.Contains(t, body, `class="current"`)
+	assert.Contains(t, body, "panic(&#34;user &lt;script&gt; not found&#34;)")
+
+	w = PerformRequest(router, http.MethodGet, "/written")
+	assert.Equal(t, "partial", w.Body.String())
+}
+
+func TestRecoveryDebugPageSecretParams(t *testing.T) {
+	SetMode(DebugMode)
+	defer SetMode(TestMode)
+
+	router := New()
+	router.Use(RecoveryWithConfig(RecoveryConfig{Output: &bytes.Buffer{}, DebugPage: true}))
+	router.GET("/reset/:token", func(c *Context) {
+		panic("expired")
+	})
+	w := PerformRequest(router, http.MethodGet, "/reset/t0ps3cret?access_token=s3cret&page=2")
+	body := w.Body.String()
+	assert.Contains(t, body, "<tr><th>token</th><td>*</td></tr>")
+	assert.Contains(t, body, "<tr><th>URL</th><td>/reset/*?access_token=%2A&amp;page=2</td></tr>")
+}
+
+func TestRecoveryDebugPageOptIn(t *testing.T) {
+	SetMode(DebugMode)
+	defer SetMode(TestMode)
+
+	router := New()
+	router.Use(RecoveryWithWriter(&bytes.Buffer{}))
+	router.GET("/", func(c *Context) {
+		panic("oops")
+	})
+	w := PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Empty(t, w.Body.String())
+}
+
+func TestRecoveryDebugPageReleaseMode(t *testing.T) {
+	for _, mode := range []string{ReleaseMode, TestMode} {
+		SetMode(mode)
+		router := newDebugPageRouter()
+		w := PerformRequest(router, http.MethodGet, "/users/42")
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+		assert.Empty(t, w.Body.String())
+	}
+	SetMode(TestMode)
+}
+
+func TestDebugPanicFrames(t *testing.T) {
+	var frames []debugFrame
+	func() {
+		defer func() {
+			recover()
+			frames = debugPanicFrames()
+		}()
+		panic("boom")
+	}()
+	assert.NotEmpty(t, frames)
+	assert.Contains(t, frames[0].Function, "TestDebugPanicFrames")
+	assert.Contains(t, frames[0].File, "debug_page_test.go")
+	assert.LessOrEqual(t, len(frames[0].Source), 2*debugPageContext+1)
+}
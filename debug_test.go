@@ -11,9 +11,12 @@ import (
 	"html/template"
 	"io"
 	"log"
+	"net/http"
 	"os"
 	"runtime"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -59,10 +62,15 @@ func TestDebugPrintError(t *testing.T) {
 func TestDebugPrintRoutes(t *testing.T) {
 	re := captureOutput(t, func() {
 		SetMode(DebugMode)
-		debugPrintRoute("GET", "/path/to/route/:param", HandlersChain{func(c *Context) {}, handlerNameTest})
+		router := New()
+		router.GET("/path/to/route/:param", func(c *Context) {}, handlerNameTest)
+		PerformRequest(router, http.MethodGet, "/path/to/route/1")
+		router.newServer("")
+		router.newServer("")
 		SetMode(TestMode)
 	})
-	assert.Regexp(t, `^\[GIN-debug\] GET    /path/to/route/:param     --> (.*/vendor/)?github.com/gin-gonic/gin.handlerNameTest \(2 handlers\)\n$`, re)
+	assert.Regexp(t, `\[GIN-debug\]   GET  /path/to/route/:param  --> (.*/vendor/)?github.com/gin-gonic/gin.handlerNameTest  \(1 middleware\)\n`, re)
+	assert.Equal(t, 1, strings.Count(re, "/path/to/route/:param  -->"), "the routes are printed once, when the engine starts serving")
 }
 
 func TestDebugPrintRouteFunc(t *testing.T) {
@@ -119,6 +127,99 @@ func TestDebugPrintWARNINGNew(t *testing.T) {
 	assert.Equal(t, "[GIN-debug] [WARNING] Running in \"debug\" mode. Switch to \"release\" mode in production.\n - using env:\texport GIN_MODE=release\n - using code:\tgin.SetMode(gin.ReleaseMode)\n\n", re)
 }
 
+func TestSetDebugCategories(t *testing.T) {
+	defer SetDebugCategories(DebugRouter, DebugTree, DebugRender, DebugBinding)
+
+	SetDebugCategories(DebugRouter)
+	re := captureOutput(t, func() {
+		SetMode(DebugMode)
+		debugPrintCategory(DebugRouter, "route")
+		debugPrintCategory(DebugRender, "render")
+		debugPrintWARNINGNew()
+		SetMode(TestMode)
+	})
+	assert.Contains(t, re, "[GIN-debug] route\n")
+	assert.NotContains(t, re, "render")
+	assert.Contains(t, re, "[WARNING] Running in \"debug\" mode")
+
+	SetDebugCategories()
+	re = captureOutput(t, func() {
+		SetMode(DebugMode)
+		debugPrintCategory(DebugRouter, "route")
+		debugPrintCategory(DebugBinding, "binding")
+		SetMode(TestMode)
+	})
+	assert.Empty(t, re)
+
+	assert.False(t, IsDebuggingCategory(DebugTree))
+	SetDebugCategories(DebugTree, DebugBinding)
+	assert.False(t, IsDebuggingCategory(DebugTree), "not in debug mode")
+	SetMode(DebugMode)
+	assert.True(t, IsDebuggingCategory(DebugTree))
+	assert.True(t, IsDebuggingCategory(DebugBinding))
+	assert.False(t, IsDebuggingCategory(DebugRender))
+	assert.False(t, IsDebuggingCategory("unknown"))
+	SetMode(TestMode)
+
+	assert.PanicsWithValue(t, "gin debug category unknown: routes (available categories: router tree render binding)", func() {
+		SetDebugCategories("routes")
+	})
+}
+
+func TestDebugCategoriesResponseWriter(t *testing.T) {
+	defer SetDebugCategories(DebugRouter, DebugTree, DebugRender, DebugBinding)
+
+	write := func() {
+		SetMode(DebugMode)
+		router := New()
+		router.GET("/", func(c *Context) {
+			c.String(http.StatusOK, "ok")
+			c.Status(http.StatusCreated)
+		})
+		PerformRequest(router, http.MethodGet, "/")
+		SetMode(TestMode)
+	}
+	assert.Contains(t, captureOutput(t, write), "Headers were already written")
+
+	SetDebugCategories()
+	assert.Contains(t, captureOutput(t, write), "Headers were already written", "the warnings have no category")
+}
+
+func TestDebugPrintLazy(t *testing.T) {
+	defer SetDebugCategories(DebugRouter, DebugTree, DebugRender, DebugBinding)
+
+	calls := 0
+	message := func() string {
+		calls++
+		return "redirecting 100%"
+	}
+	re := captureOutput(t, func() {
+		debugPrintLazy(DebugTree, message)
+		SetMode(DebugMode)
+		debugPrintLazy(DebugTree, message)
+		SetDebugCategories(DebugRouter)
+		debugPrintLazy(DebugTree, message)
+		SetMode(TestMode)
+	})
+	assert.Equal(t, "[GIN-debug] redirecting 100%\n", re)
+	assert.Equal(t, 1, calls)
+}
+
+func TestDebugOutputFlag(t *testing.T) {
+	defer SetDebugCategories(DebugRouter, DebugTree, DebugRender, DebugBinding)
+
+	assert.Zero(t, atomic.LoadUint32(&debugOutput))
+	SetMode(DebugMode)
+	assert.Equal(t, uint32(debugModeBit|0xf), atomic.LoadUint32(&debugOutput))
+	SetDebugCategories(DebugRender)
+	assert.Equal(t, uint32(debugModeBit|debugCategoryBits[DebugRender]), atomic.LoadUint32(&debugOutput))
+	assert.True(t, IsDebugging())
+	SetMode(ReleaseMode)
+	assert.Zero(t, atomic.LoadUint32(&debugOutput))
+	assert.False(t, IsDebugging())
+	SetMode(TestMode)
+}
+
 func captureOutput(t *testing.T, f func()) string {
 	reader, writer, err := os.Pipe()
 	if err != nil {
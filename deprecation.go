@@ -0,0 +1,110 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// DeprecationMetaKey is the route metadata key holding the Deprecation of the route, see
+// RouterGroup.Deprecated.
+const DeprecationMetaKey = "_gin-gonic/gin/deprecation"
+
+// Deprecation describes a deprecated route.
+type Deprecation struct {
+	// Since is when the route was deprecated. Zero if unknown.
+	Since time.Time
+	// Sunset is when the route will stop responding. Zero if unknown.
+	Sunset time.Time
+	// Link is the URL of the documentation of the deprecation, e.g. the migration guide.
+	Link string
+}
+
+// DeprecatedRouteUsage is the traffic of a deprecated route, see Engine.DeprecatedRoutes.
+type DeprecatedRouteUsage struct {
+	Method      string
+	Path        string
+	Deprecation Deprecation
+	// Requests is the number of requests served since the engine started.
+	Requests uint64
+	// LastRequest is the time of the last request, zero if there was none.
+	LastRequest time.Time
+}
+
+type deprecatedUsage struct {
+	requests    uint64
+	lastRequest int64 // unix nano
+}
+
+// setHeaders sets the Deprecation (RFC 9745), Sunset (RFC 8594) and Link headers.
+func (d Deprecation) setHeaders(header http.Header) {
+	if d.Since.IsZero() {
+		header.Set("Deprecation", "true")
+	} else {
+		header.Set("Deprecation", "@"+strconv.FormatInt(d.Since.Unix(), 10))
+	}
+	if !d.Sunset.IsZero() {
+		header.Set("Sunset", d.Sunset.UTC().Format(http.TimeFormat))
+	}
+	if d.Link != "" {
+		header.Add("Link", "<"+d.Link+`>; rel="deprecation"; type="text/html"`)
+	}
+}
+
+// Deprecated returns a group, with the same path and middleware, whose routes are
+// deprecated: their responses carry the Deprecation, Sunset and Link headers, pointing the
+// clients to link, their first request is logged in debug mode and their traffic is
+// reported by Engine.DeprecatedRoutes. since, sunset and link are optional.
+//
+//	v1 := router.Group("/v1").Deprecated(since, sunset, "https://example.com/docs/v2-migration")
+//	v1.GET("/users", listUsers)
+func (group *RouterGroup) Deprecated(since, sunset time.Time, link string) *RouterGroup {
+	d := Deprecation{Since: since, Sunset: sunset, Link: link}
+	child := group.WithMeta(DeprecationMetaKey, d)
+	engine := group.engine
+	child.Use(func(c *Context) {
+		d.setHeaders(c.Writer.Header())
+
+		key := routeKey(c.routeMethod(), c.FullPath())
+		value, loaded := engine.deprecatedUsage.Load(key)
+		if !loaded {
+			value, loaded = engine.deprecatedUsage.LoadOrStore(key, &deprecatedUsage{})
+			if !loaded {
+				debugPrint("[WARNING] Deprecated route %s was requested\n", key)
+			}
+		}
+		usage := value.(*deprecatedUsage)
+		atomic.AddUint64(&usage.requests, 1)
+		atomic.StoreInt64(&usage.lastRequest, time.Now().UnixNano())
+	})
+	return child
+}
+
+// DeprecatedRoutes returns the deprecated routes, see RouterGroup.Deprecated, with their
+// traffic, sorted by decreasing number of requests, to tell when they can be removed.
+func (engine *Engine) DeprecatedRoutes() []DeprecatedRouteUsage {
+	var report []DeprecatedRouteUsage
+	for _, route := range engine.Routes() {
+		d, ok := route.Meta[DeprecationMetaKey].(Deprecation)
+		if !ok {
+			continue
+		}
+		entry := DeprecatedRouteUsage{Method: route.Method, Path: route.Path, Deprecation: d}
+		if value, ok := engine.deprecatedUsage.Load(routeKey(route.treeMethod(), route.Path)); ok {
+			usage := value.(*deprecatedUsage)
+			entry.Requests = atomic.LoadUint64(&usage.requests)
+			entry.LastRequest = time.Unix(0, atomic.LoadInt64(&usage.lastRequest))
+		}
+		report = append(report, entry)
+	}
+	sort.SliceStable(report, func(i, j int) bool {
+		return report[i].Requests > report[j].Requests
+	})
+	return report
+}
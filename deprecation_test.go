@@ -0,0 +1,53 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouterGroupDeprecated(t *testing.T) {
+	since := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	router := New()
+	v1 := router.Group("/v1").Deprecated(since, sunset, "https://example.com/migration")
+	v1.GET("/users", func(c *Context) {})
+	v1.GET("/books", func(c *Context) {})
+	router.Deprecated(time.Time{}, time.Time{}, "").GET("/legacy", func(c *Context) {})
+	router.GET("/v2/users", func(c *Context) {})
+
+	w := PerformRequest(router, http.MethodGet, "/v1/users")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "@1767225600", w.Header().Get("Deprecation"))
+	assert.Equal(t, "Fri, 01 Jan 2027 00:00:00 GMT", w.Header().Get("Sunset"))
+	assert.Equal(t, `<https://example.com/migration>; rel="deprecation"; type="text/html"`, w.Header().Get("Link"))
+	PerformRequest(router, http.MethodGet, "/v1/users")
+
+	w = PerformRequest(router, http.MethodGet, "/legacy")
+	assert.Equal(t, "true", w.Header().Get("Deprecation"))
+	assert.Empty(t, w.Header().Get("Sunset"))
+	assert.Empty(t, w.Header().Get("Link"))
+
+	w = PerformRequest(router, http.MethodGet, "/v2/users")
+	assert.Empty(t, w.Header().Get("Deprecation"))
+
+	report := router.DeprecatedRoutes()
+	if assert.Len(t, report, 3) {
+		assert.Equal(t, "/v1/users", report[0].Path)
+		assert.Equal(t, uint64(2), report[0].Requests)
+		assert.Equal(t, sunset, report[0].Deprecation.Sunset)
+		assert.WithinDuration(t, time.Now(), report[0].LastRequest, time.Minute)
+		assert.Equal(t, "/legacy", report[1].Path)
+		assert.Equal(t, uint64(1), report[1].Requests)
+		assert.Equal(t, "/v1/books", report[2].Path)
+		assert.Zero(t, report[2].Requests)
+		assert.True(t, report[2].LastRequest.IsZero())
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"html/template"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin/render"
+)
+
+// DirIndexConfig defines the config for the directory index served by StaticIndex.
+type DirIndexConfig struct {
+	// ShowHidden lists (and serves) files and directories starting with a dot.
+	// Optional. Default value is false.
+	ShowHidden bool
+
+	// Template is the name of the HTML template rendered through Engine.HTMLRender
+	// with a DirIndex value. Optional. A built-in template is used when empty.
+	Template string
+}
+
+// DirIndex is the data handed to the directory index template.
+type DirIndex struct {
+	// Path is the directory path relative to the static root.
+	Path string
+	// Breadcrumbs links every parent directory, the first one is the static root.
+	Breadcrumbs []DirBreadcrumb
+	// Entries are the files and directories, sorted according to Sort and Order.
+	Entries []DirEntry
+	// Sort is the column the entries are sorted by: name, size or modified.
+	Sort string
+	// Order is the sort order: asc or desc.
+	Order string
+}
+
+// DirBreadcrumb is a link to a parent directory of a DirIndex.
+type DirBreadcrumb struct {
+	Name string
+	URL  string
+}
+
+// DirEntry is a single file or directory of a DirIndex.
+type DirEntry struct {
+	Name    string
+	URL     string
+	Size    int64
+	ModTime time.Time
+	IsDir   bool
+}
+
+// SortURL returns the URL sorting the index by column, toggling the order if the index is
+// already sorted by this column.
+func (index DirIndex) SortURL(column string) string {
+	order := "asc"
+	if index.Sort == column && index.Order == "asc" {
+		order = "desc"
+	}
+	return "?sort=" + column + "&order=" + order
+}
+
+var defaultDirIndexTemplate = template.Must(template.New("dirindex").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>Index of {{.Path}}</title></head>
+<body>
+<h1>{{range $i, $b := .Breadcrumbs}}{{if $i}} / {{end}}<a href="{{$b.URL}}">{{$b.Name}}</a>{{end}}</h1>
+<table>
+<thead><tr>
+<th><a href="{{.SortURL "name"}}">Name</a></th>
+<th><a href="{{.SortURL "size"}}">Size</a></th>
+<th><a href="{{.SortURL "modified"}}">Modified</a></th>
+</tr></thead>
+<tbody>
+{{range .Entries}}<tr><td><a href="{{.URL}}">{{.Name}}{{if .IsDir}}/{{end}}</a></td><td>{{if not .IsDir}}{{.Size}}{{end}}</td><td>{{.ModTime.Format "2006-01-02 15:04:05"}}</td></tr>
+{{end}}</tbody>
+</table>
+</body>
+</html>
+`))
+
+// StaticIndex works just like StaticFS but renders a directory index for directories without an
+// index.html file, with sortable columns, hidden file filtering and breadcrumbs.
+// The index is rendered through the HTML engine when config.Template is set.
+// The handlers are executed before serving any file or directory, e.g. for authorization:
+//
+//	router.StaticIndex("/files", http.Dir("/var/www"), gin.DirIndexConfig{}, gin.BasicAuth(accounts))
+func (group *RouterGroup) StaticIndex(relativePath string, fs http.FileSystem, config DirIndexConfig, handlers ...HandlerFunc) IRoutes {
+	if strings.Contains(relativePath, ":") || strings.Contains(relativePath, "*") {
+		panic("URL parameters can not be used when serving a static folder")
+	}
+	chain := make(HandlersChain, 0, len(handlers)+1)
+	chain = append(chain, handlers...)
+	chain = append(chain, group.createDirIndexHandler(relativePath, fs, config))
+	urlPattern := path.Join(relativePath, "/*filepath")
+
+	group.GET(urlPattern, chain...)
+	group.HEAD(urlPattern, chain...)
+	return group.returnObj()
+}
+
+func (group *RouterGroup) createDirIndexHandler(relativePath string, fs http.FileSystem, config DirIndexConfig) HandlerFunc {
+	absolutePath := group.calculateAbsolutePath(relativePath)
+	fileServer := http.StripPrefix(absolutePath, http.FileServer(fs))
+
+	return func(c *Context) {
+		name := path.Clean("/" + c.Param("filepath"))
+		if !config.ShowHidden && isHiddenPath(name) {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+
+		f, err := fs.Open(name)
+		if err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		defer f.Close()
+
+		info, err := f.Stat()
+		if err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		if !info.IsDir() {
+			fileServer.ServeHTTP(c.Writer, c.Request)
+			return
+		}
+		if !strings.HasSuffix(c.Request.URL.Path, "/") {
+			c.Redirect(http.StatusMovedPermanently, path.Base(c.Request.URL.Path)+"/")
+			return
+		}
+		if index, err := fs.Open(path.Join(name, "index.html")); err == nil {
+			index.Close()
+			fileServer.ServeHTTP(c.Writer, c.Request)
+			return
+		}
+
+		files, err := f.Readdir(-1)
+		if err != nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+
+		index := DirIndex{
+			Path:        name,
+			Breadcrumbs: dirBreadcrumbs(absolutePath, name),
+			Sort:        "name",
+			Order:       "asc",
+		}
+		switch sortBy := c.Query("sort"); sortBy {
+		case "size", "modified":
+			index.Sort = sortBy
+		}
+		if c.Query("order") == "desc" {
+			index.Order = "desc"
+		}
+		for _, file := range files {
+			if !config.ShowHidden && strings.HasPrefix(file.Name(), ".") {
+				continue
+			}
+			entryURL := url.URL{Path: file.Name()}
+			if file.IsDir() {
+				entryURL.Path += "/"
+			}
+			index.Entries = append(index.Entries, DirEntry{
+				Name:    file.Name(),
+				URL:     entryURL.String(),
+				Size:    file.Size(),
+				ModTime: file.ModTime(),
+				IsDir:   file.IsDir(),
+			})
+		}
+		sortDirEntries(index.Entries, index.Sort, index.Order == "desc")
+
+		if config.Template != "" {
+			c.HTML(http.StatusOK, config.Template, index)
+			return
+		}
+		c.Render(http.StatusOK, render.HTML{Template: defaultDirIndexTemplate, Data: index})
+	}
+}
+
+func isHiddenPath(name string) bool {
+	for _, segment := range strings.Split(name, "/") {
+		if strings.HasPrefix(segment, ".") {
+			return true
+		}
+	}
+	return false
+}
+
+func dirBreadcrumbs(absolutePath, name string) []DirBreadcrumb {
+	root := strings.TrimSuffix(absolutePath, "/") + "/"
+	crumbs := []DirBreadcrumb{{Name: path.Base(absolutePath), URL: root}}
+	current := root
+	for _, segment := range strings.Split(strings.Trim(name, "/"), "/") {
+		if segment == "" {
+			continue
+		}
+		current += segment + "/"
+		crumbs = append(crumbs, DirBreadcrumb{Name: segment, URL: current})
+	}
+	return crumbs
+}
+
+// sortDirEntries sorts the entries by column, directories always come first.
+func sortDirEntries(entries []DirEntry, column string, desc bool) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.IsDir != b.IsDir {
+			return a.IsDir
+		}
+		if desc {
+			a, b = b, a
+		}
+		switch column {
+		case "size":
+			if a.Size != b.Size {
+				return a.Size < b.Size
+			}
+		case "modified":
+			if !a.ModTime.Equal(b.ModTime) {
+				return a.ModTime.Before(b.ModTime)
+			}
+		}
+		return a.Name < b.Name
+	})
+}
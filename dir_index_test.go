@@ -0,0 +1,108 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaticIndex(t *testing.T) {
+	router := New()
+	router.StaticIndex("/files", http.Dir("./testdata/dirindex"), DirIndexConfig{})
+
+	w := PerformRequest(router, http.MethodGet, "/files/")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
+	body := w.Body.String()
+	assert.Contains(t, body, `<a href="/files/">files</a>`)
+	assert.Contains(t, body, `<a href="sub/">sub/</a>`)
+	assert.Contains(t, body, `<a href="a.txt">a.txt</a>`)
+	assert.NotContains(t, body, ".env")
+	assert.NotContains(t, body, ".secret")
+	assert.Less(t, strings.Index(body, "site/"), strings.Index(body, "a.txt"))
+
+	w = PerformRequest(router, http.MethodGet, "/files/sub/")
+	assert.Contains(t, w.Body.String(), `<a href="/files/sub/">sub</a>`)
+
+	w = PerformRequest(router, http.MethodGet, "/files/sub")
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/files/sub/", w.Header().Get("Location"))
+
+	w = PerformRequest(router, http.MethodGet, "/files/a.txt")
+	assert.Equal(t, "hello\n", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/files/site/")
+	assert.Equal(t, "<p>site</p>\n", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/files/.env")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = PerformRequest(router, http.MethodGet, "/files/.secret/key")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = PerformRequest(router, http.MethodGet, "/files/missing")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestStaticIndexShowHiddenAndAuth(t *testing.T) {
+	router := New()
+	router.StaticIndex("/files", http.Dir("./testdata/dirindex"), DirIndexConfig{ShowHidden: true},
+		BasicAuth(Accounts{"admin": "secret"}))
+
+	w := PerformRequest(router, http.MethodGet, "/files/")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/files/", header{"Authorization", authorizationHeader("admin", "secret")})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), ".env")
+
+	w = PerformRequest(router, http.MethodGet, "/files/.env", header{"Authorization", authorizationHeader("admin", "secret")})
+	assert.Equal(t, "hidden\n", w.Body.String())
+}
+
+func TestStaticIndexTemplate(t *testing.T) {
+	router := New()
+	router.LoadHTMLGlob("./testdata/template/*")
+	router.StaticIndex("/files", http.Dir("./testdata/dirindex"), DirIndexConfig{Template: "hello.tmpl"})
+
+	w := PerformRequest(router, http.MethodGet, "/files/")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "<h1>Hello {[{.name}]}</h1>", w.Body.String())
+
+	assert.Panics(t, func() {
+		router.StaticIndex("/files/:id", http.Dir("./testdata/dirindex"), DirIndexConfig{})
+	})
+}
+
+func TestSortDirEntries(t *testing.T) {
+	now := time.Now()
+	entries := []DirEntry{
+		{Name: "b", Size: 1, ModTime: now},
+		{Name: "a", Size: 3, ModTime: now.Add(time.Hour)},
+		{Name: "dir", IsDir: true},
+	}
+
+	sortDirEntries(entries, "name", false)
+	assert.Equal(t, []string{"dir", "a", "b"}, dirEntryNames(entries))
+	sortDirEntries(entries, "size", true)
+	assert.Equal(t, []string{"dir", "a", "b"}, dirEntryNames(entries))
+	sortDirEntries(entries, "modified", false)
+	assert.Equal(t, []string{"dir", "b", "a"}, dirEntryNames(entries))
+
+	index := DirIndex{Sort: "name", Order: "asc"}
+	assert.Equal(t, "?sort=name&order=desc", index.SortURL("name"))
+	assert.Equal(t, "?sort=size&order=asc", index.SortURL("size"))
+}
+
+func dirEntryNames(entries []DirEntry) []string {
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name)
+	}
+	return names
+}
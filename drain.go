@@ -0,0 +1,68 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrStreamDraining is returned by StreamWithConfig and by the stream writer once the engine
+// started draining, see Engine.Drain.
+var ErrStreamDraining = errors.New("gin: server is draining")
+
+// drainState signals the long-lived requests that the server is shutting down.
+type drainState struct {
+	once  sync.Once
+	done  chan struct{}
+	mu    sync.Mutex
+	hooks []func()
+}
+
+// OnDrain registers f to be called when the engine starts draining, e.g. to close the
+// websockets of the application, see Engine.Drain.
+func (engine *Engine) OnDrain(f func()) {
+	engine.drain.mu.Lock()
+	defer engine.drain.mu.Unlock()
+	engine.drain.hooks = append(engine.drain.hooks, f)
+}
+
+// Drain signals the long-lived requests, such as streams and long polls, that the server is
+// shutting down so that they end promptly instead of holding http.Server.Shutdown until its
+// timeout, and calls the OnDrain hooks. Regular requests are left to complete. The servers
+// started by the Run methods drain on Shutdown, otherwise call Drain before Shutdown or
+// register it with http.Server.RegisterOnShutdown. Draining can not be undone.
+func (engine *Engine) Drain() {
+	engine.drain.once.Do(func() {
+		close(engine.drain.done)
+		engine.drain.mu.Lock()
+		hooks := engine.drain.hooks
+		engine.drain.mu.Unlock()
+		for _, hook := range hooks {
+			hook()
+		}
+	})
+}
+
+// Draining returns a channel closed once the engine started draining, see Engine.Drain.
+func (engine *Engine) Draining() <-chan struct{} {
+	return engine.drain.done
+}
+
+// Draining returns a channel closed once the engine started draining, for the handlers
+// of long-lived routes to select on, see Engine.Drain.
+func (c *Context) Draining() <-chan struct{} {
+	return c.engine.Draining()
+}
+
+// isDraining reports whether the drain channel is closed.
+func isDraining(drain <-chan struct{}) bool {
+	select {
+	case <-drain:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngineDrain(t *testing.T) {
+	router := New()
+	var calls int32
+	router.OnDrain(func() { atomic.AddInt32(&calls, 1) })
+
+	assert.False(t, isDraining(router.Draining()))
+	router.Drain()
+	router.Drain()
+	assert.True(t, isDraining(router.Draining()))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestContextStreamWithConfigDrain(t *testing.T) {
+	c, w := createStreamContext(context.Background())
+
+	err := c.StreamWithConfig(StreamConfig{}, func(w io.Writer) error {
+		_, err := io.WriteString(w, "data")
+		c.engine.Drain()
+		return err
+	})
+	assert.Equal(t, ErrStreamDraining, err)
+	assert.Equal(t, "data", w.Body.String())
+
+	// writes fail once draining
+	c, _ = createStreamContext(context.Background())
+	c.engine.Drain()
+	err = c.StreamWithConfig(StreamConfig{}, func(w io.Writer) error {
+		return nil
+	})
+	assert.Equal(t, ErrStreamDraining, err)
+}
+
+func TestContextStreamDrain(t *testing.T) {
+	w := CreateTestResponseRecorder()
+	c, _ := CreateTestContext(w)
+
+	steps := 0
+	clientGone := c.Stream(func(w io.Writer) bool {
+		steps++
+		c.engine.Drain()
+		return true
+	})
+	assert.False(t, clientGone)
+	assert.Equal(t, 1, steps)
+}
+
+func TestLongPollDrain(t *testing.T) {
+	router := New()
+	router.GET("/poll", func(c *Context) {
+		c.LongPoll("news", time.Minute)
+	})
+	router.GET("/events", func(c *Context) {
+		c.StreamTopic("news")
+	})
+	go func() {
+		for router.Broker().Subscribers("news") < 2 {
+			time.Sleep(time.Millisecond)
+		}
+		router.Drain()
+	}()
+
+	done := make(chan *httptest.ResponseRecorder, 2)
+	go func() { done <- PerformRequest(router, http.MethodGet, "/poll") }()
+	go func() { done <- PerformRequest(router, http.MethodGet, "/events") }()
+	for i := 0; i < 2; i++ {
+		select {
+		case w := <-done:
+			assert.Contains(t, []int{http.StatusOK, http.StatusNoContent}, w.Code)
+		case <-time.After(5 * time.Second):
+			t.Fatal("long-lived requests were not drained")
+		}
+	}
+}
+
+func TestServerShutdownDrains(t *testing.T) {
+	router := New()
+	srv := router.newServer("")
+	assert.NoError(t, srv.Shutdown(context.Background()))
+	select {
+	case <-router.Draining():
+	case <-time.After(5 * time.Second):
+		t.Fatal("shutdown did not drain the engine")
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+)
+
+// errorMapping maps the errors matching either target (errors.Is) or targetType (errors.As)
+// to a status code.
+type errorMapping struct {
+	target     error
+	targetType reflect.Type
+	code       int
+}
+
+// defaultErrorMappings are checked after the mappings of the engine.
+var defaultErrorMappings = []errorMapping{
+	{target: ErrUnauthenticated, code: http.StatusUnauthorized},
+	{target: ErrForbidden, code: http.StatusForbidden},
+}
+
+// MapError maps the errors matching target with errors.Is to the status code used by
+// Context.Fail and ErrorRenderer. Mappings are checked in registration order.
+//
+//	router.MapError(sql.ErrNoRows, http.StatusNotFound)
+func (engine *Engine) MapError(target error, code int) {
+	assert1(target != nil, "target error can not be nil")
+	assert1(code >= 100 && code <= 999, "invalid status code")
+	engine.errorMappings = append(engine.errorMappings, errorMapping{target: target, code: code})
+}
+
+// MapErrorAs maps the errors matching target with errors.As to the status code used by
+// Context.Fail and ErrorRenderer. As for errors.As, target must be a non-nil pointer to
+// a type implementing error or to an interface type.
+//
+//	router.MapErrorAs(new(*json.SyntaxError), http.StatusBadRequest)
+func (engine *Engine) MapErrorAs(target any, code int) {
+	assert1(target != nil, "target can not be nil")
+	typ := reflect.TypeOf(target)
+	assert1(typ.Kind() == reflect.Ptr, "target must be a non-nil pointer")
+	assert1(code >= 100 && code <= 999, "invalid status code")
+	engine.errorMappings = append(engine.errorMappings, errorMapping{targetType: typ.Elem(), code: code})
+}
+
+// SetErrorRenderer sets the function writing the response for the errors handled by
+// Context.Fail and ErrorRenderer. By default the error is rendered as JSON, see Error.JSON,
+// unless it is unmapped and private, in which case only the status text is exposed.
+func (engine *Engine) SetErrorRenderer(render func(c *Context, code int, err *Error)) {
+	engine.errorRenderer = render
+}
+
+// errorStatus returns the status code err is mapped to and whether it is mapped at all.
+func (engine *Engine) errorStatus(err error) (int, bool) {
+	if code, ok := matchErrorMappings(engine.errorMappings, err); ok {
+		return code, true
+	}
+	if code, ok := matchErrorMappings(defaultErrorMappings, err); ok {
+		return code, true
+	}
+	return http.StatusInternalServerError, false
+}
+
+func matchErrorMappings(mappings []errorMapping, err error) (int, bool) {
+	for _, m := range mappings {
+		if m.target != nil {
+			if errors.Is(err, m.target) {
+				return m.code, true
+			}
+			continue
+		}
+		if errors.As(err, reflect.New(m.targetType).Interface()) {
+			return m.code, true
+		}
+	}
+	return 0, false
+}
+
+func (engine *Engine) renderError(c *Context, err *Error) {
+	code, mapped := engine.errorStatus(err.Err)
+	if engine.errorRenderer != nil {
+		engine.errorRenderer(c, code, err)
+		return
+	}
+	if !mapped && !err.IsType(ErrorTypePublic) {
+		c.JSON(code, H{"error": http.StatusText(code)})
+		return
+	}
+	c.JSON(code, err.JSON())
+}
+
+// Fail attaches err to the context like Error, aborts the chain and writes the response
+// with the status code err is mapped to, see Engine.MapError. Unmapped errors are
+// reported as 500 Internal Server Error.
+func (c *Context) Fail(err error) *Error {
+	parsedError := c.Error(err)
+	c.Abort()
+	c.engine.renderError(c, parsedError)
+	return parsedError
+}
+
+// ErrorRenderer returns a middleware that renders the last error attached to the context
+// once the chain is done, if nothing was written to the response, so that errors reported
+// with Context.Error are never left unanswered. The status code is picked as in Context.Fail.
+func ErrorRenderer() HandlerFunc {
+	return func(c *Context) {
+		c.Next()
+		if len(c.Errors) == 0 || c.Writer.Written() {
+			return
+		}
+		c.engine.renderError(c, c.Errors.Last())
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type quotaError struct{ limit int }
+
+func (e *quotaError) Error() string { return fmt.Sprintf("quota of %d exceeded", e.limit) }
+
+func TestContextFail(t *testing.T) {
+	router := New()
+	router.MapError(fs.ErrNotExist, http.StatusNotFound)
+	router.MapErrorAs(new(*quotaError), http.StatusTooManyRequests)
+
+	router.GET("/missing", func(c *Context) {
+		c.Fail(fmt.Errorf("user 42: %w", fs.ErrNotExist))
+	})
+	router.GET("/quota", func(c *Context) {
+		c.Fail(fmt.Errorf("upload: %w", &quotaError{limit: 10}))
+	})
+	router.GET("/internal", func(c *Context) {
+		c.Fail(errors.New("db password leaked"))
+	})
+	router.GET("/public", func(c *Context) {
+		c.Fail(&Error{Err: errors.New("readable"), Type: ErrorTypePublic})
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/missing")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, `{"error":"user 42: file does not exist"}`, w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/quota")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, `{"error":"upload: quota of 10 exceeded"}`, w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/internal")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, `{"error":"Internal Server Error"}`, w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/public")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, `{"error":"readable"}`, w.Body.String())
+
+	assert.Panics(t, func() { router.MapError(nil, http.StatusNotFound) })
+	assert.Panics(t, func() { router.MapErrorAs(quotaError{}, http.StatusNotFound) })
+	assert.Panics(t, func() { router.MapError(fs.ErrExist, 42) })
+}
+
+func TestErrorRenderer(t *testing.T) {
+	router := New()
+	router.Use(ErrorRenderer())
+	router.MapError(fs.ErrPermission, http.StatusForbidden)
+	router.SetErrorRenderer(func(c *Context, code int, err *Error) {
+		c.String(code, "%s", err.Error())
+	})
+
+	router.GET("/reported", func(c *Context) {
+		_ = c.Error(errors.New("ignored"))
+		_ = c.Error(fs.ErrPermission)
+	})
+	router.GET("/written", func(c *Context) {
+		_ = c.Error(fs.ErrPermission)
+		c.String(http.StatusOK, "%s", "partial")
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/reported")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.Equal(t, "permission denied", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/written")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "partial", w.Body.String())
+}
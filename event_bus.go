@@ -0,0 +1,109 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import "sync"
+
+// EventHandler handles the payloads published on a topic of an EventBus.
+type EventHandler func(topic string, payload any)
+
+// EventBus delivers the payloads published on a topic to the handlers subscribed to it.
+// The default bus of an engine is in-process, see NewMemoryEventBus; an implementation
+// backed by NATS or Redis pub/sub fans the payloads out to all the instances, the
+// Context.LongPoll and Context.StreamTopic requests of every instance receiving the
+// payloads published with Engine.Publish on any of them.
+type EventBus interface {
+	// Publish delivers payload to the handlers subscribed to topic.
+	Publish(topic string, payload any) error
+
+	// Subscribe calls handler with the payloads published on topic, until the returned
+	// function is called. The returned function must not wait for the running handlers.
+	Subscribe(topic string, handler EventHandler) (unsubscribe func(), err error)
+}
+
+type memorySubscription struct {
+	handler EventHandler
+}
+
+// memoryEventBus is the in-process EventBus.
+type memoryEventBus struct {
+	mu     sync.RWMutex
+	topics map[string]map[*memorySubscription]struct{}
+}
+
+// NewMemoryEventBus returns an in-process EventBus. The handlers are called synchronously,
+// in the goroutine of Publish, so they must not block.
+func NewMemoryEventBus() EventBus {
+	return &memoryEventBus{topics: make(map[string]map[*memorySubscription]struct{})}
+}
+
+func (b *memoryEventBus) Publish(topic string, payload any) error {
+	b.mu.RLock()
+	handlers := make([]EventHandler, 0, len(b.topics[topic]))
+	for sub := range b.topics[topic] {
+		handlers = append(handlers, sub.handler)
+	}
+	b.mu.RUnlock()
+
+	// the handlers may subscribe or unsubscribe, call them unlocked
+	for _, handler := range handlers {
+		handler(topic, payload)
+	}
+	return nil
+}
+
+func (b *memoryEventBus) Subscribe(topic string, handler EventHandler) (func(), error) {
+	sub := &memorySubscription{handler: handler}
+
+	b.mu.Lock()
+	subs, ok := b.topics[topic]
+	if !ok {
+		subs = make(map[*memorySubscription]struct{})
+		b.topics[topic] = subs
+	}
+	subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(subs, sub)
+			if len(b.topics[topic]) == 0 {
+				delete(b.topics, topic)
+			}
+			b.mu.Unlock()
+		})
+	}, nil
+}
+
+// SetEventBus replaces the event bus of the engine, an in-process one by default. It must
+// be called before the engine publishes or subscribes, i.e. before serving.
+func (engine *Engine) SetEventBus(bus EventBus) {
+	assert1(bus != nil, "event bus can not be nil")
+	engine.eventBus = bus
+}
+
+// EventBus returns the event bus of the engine, see SetEventBus.
+func (engine *Engine) EventBus() EventBus {
+	engine.eventBusOnce.Do(func() {
+		if engine.eventBus == nil {
+			engine.eventBus = NewMemoryEventBus()
+		}
+	})
+	return engine.eventBus
+}
+
+// Publish publishes payload on topic using the event bus of the engine, e.g. to notify the
+// requests parked by Context.LongPoll and Context.StreamTopic, or the audit sinks.
+func (engine *Engine) Publish(topic string, payload any) error {
+	return engine.EventBus().Publish(topic, payload)
+}
+
+// Subscribe calls handler with the payloads published on topic using the event bus of the
+// engine, until the returned function is called.
+func (engine *Engine) Subscribe(topic string, handler EventHandler) (unsubscribe func(), err error) {
+	return engine.EventBus().Subscribe(topic, handler)
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryEventBus(t *testing.T) {
+	bus := NewMemoryEventBus()
+	var got []any
+	unsubscribe, err := bus.Subscribe("orders", func(topic string, payload any) {
+		assert.Equal(t, "orders", topic)
+		got = append(got, payload)
+	})
+	assert.NoError(t, err)
+	unsubscribeOther, _ := bus.Subscribe("users", func(topic string, payload any) {
+		t.Errorf("unexpected %v on %s", payload, topic)
+	})
+	unsubscribeOther()
+
+	assert.NoError(t, bus.Publish("orders", 1))
+	assert.NoError(t, bus.Publish("users", 2))
+	unsubscribe()
+	unsubscribe()
+	assert.NoError(t, bus.Publish("orders", 3))
+	assert.Equal(t, []any{1}, got)
+}
+
+func TestMemoryEventBusReentrant(t *testing.T) {
+	bus := NewMemoryEventBus()
+	var calls int
+	var unsubscribe func()
+	unsubscribe, _ = bus.Subscribe("once", func(topic string, payload any) {
+		calls++
+		unsubscribe()
+		bus.Publish("once", payload) // nolint: errcheck
+	})
+	assert.NoError(t, bus.Publish("once", nil))
+	assert.Equal(t, 1, calls)
+}
+
+func TestEngineEventBus(t *testing.T) {
+	r := New()
+	var mu sync.Mutex
+	var got []any
+	unsubscribe, err := r.Subscribe("audit", func(topic string, payload any) {
+		mu.Lock()
+		got = append(got, payload)
+		mu.Unlock()
+	})
+	assert.NoError(t, err)
+	defer unsubscribe()
+
+	r.POST("/orders", func(c *Context) {
+		assert.NoError(t, c.engine.Publish("audit", "order created"))
+		c.Status(http.StatusCreated)
+	})
+	PerformRequest(r, http.MethodPost, "/orders")
+	assert.Equal(t, []any{"order created"}, got)
+
+	assert.Panics(t, func() {
+		r.SetEventBus(nil)
+	})
+}
+
+// recordingEventBus is an EventBus recording the subscriptions, like a bus shared by
+// several instances would.
+type recordingEventBus struct {
+	EventBus
+	mu     sync.Mutex
+	topics map[string]int
+}
+
+func (b *recordingEventBus) Subscribe(topic string, handler EventHandler) (func(), error) {
+	b.mu.Lock()
+	b.topics[topic]++
+	b.mu.Unlock()
+	unsubscribe, err := b.EventBus.Subscribe(topic, handler)
+	return func() {
+		b.mu.Lock()
+		b.topics[topic]--
+		b.mu.Unlock()
+		unsubscribe()
+	}, err
+}
+
+func (b *recordingEventBus) subscriptions(topic string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.topics[topic]
+}
+
+func TestBrokerEventBus(t *testing.T) {
+	bus := &recordingEventBus{EventBus: NewMemoryEventBus(), topics: make(map[string]int)}
+	r := New()
+	r.SetEventBus(bus)
+	r.GET("/poll", func(c *Context) {
+		if msg, ok := c.LongPoll("news", time.Second); ok {
+			c.JSON(http.StatusOK, msg)
+		}
+	})
+
+	done := make(chan int)
+	for i := 0; i < 2; i++ {
+		go func() {
+			w := PerformRequest(r, http.MethodGet, "/poll")
+			assert.Equal(t, `"hello"`, w.Body.String())
+			done <- w.Code
+		}()
+	}
+	assert.Eventually(t, func() bool {
+		return r.Broker().Subscribers("news") == 2
+	}, time.Second, time.Millisecond)
+	// the broker subscribes once per topic
+	assert.Equal(t, 1, bus.subscriptions("news"))
+
+	assert.NoError(t, r.Publish("news", "hello"))
+	assert.Equal(t, http.StatusOK, <-done)
+	assert.Equal(t, http.StatusOK, <-done)
+	assert.Eventually(t, func() bool {
+		return bus.subscriptions("news") == 0
+	}, time.Second, time.Millisecond)
+}
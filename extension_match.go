@@ -0,0 +1,75 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import "strings"
+
+// CatchAllExtensions configures how the catch-all params of routes match file extensions,
+// see RouterGroup.WithExtensions.
+type CatchAllExtensions struct {
+	// Param is the name of an additional param set to the extension of the catch-all
+	// value, with its leading dot, e.g. ".js". It is empty if the value has no extension.
+	// Optional. No param is added when empty.
+	Param string
+
+	// Allowed restricts the catch-all routes to values with one of these extensions,
+	// e.g. ".js", compared case-insensitively. Other values do not match the route.
+	// Optional. Any extension is allowed when empty.
+	Allowed []string
+}
+
+// allows returns true if the catch-all value v has an allowed extension.
+func (e *CatchAllExtensions) allows(v string) bool {
+	if len(e.Allowed) == 0 {
+		return true
+	}
+	ext := fileExt(v)
+	for _, allowed := range e.Allowed {
+		if strings.EqualFold(ext, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// fileExt returns the extension of the last element of the slash-separated path p,
+// with its leading dot, or an empty string.
+func fileExt(p string) string {
+	for i := len(p) - 1; i >= 0 && p[i] != '/'; i-- {
+		if p[i] == '.' {
+			return p[i:]
+		}
+	}
+	return ""
+}
+
+// WithExtensions returns a group, with the same path and middleware, whose catch-all
+// routes match file extensions as configured, so handlers don't have to check them:
+//
+//	assets := router.WithExtensions(gin.CatchAllExtensions{Param: "ext", Allowed: []string{".js", ".css"}})
+//	assets.GET("/assets/*filepath", serveAsset) // /assets/app.js sets ext to ".js"
+func (group *RouterGroup) WithExtensions(config CatchAllExtensions) *RouterGroup {
+	child := group.derive("", nil)
+	child.extensions = &config
+	return child
+}
+
+// setCatchAllExtensions attaches the extension matcher to the catch-all route registered
+// with method and path, if it has one.
+func (engine *Engine) setCatchAllExtensions(method, path string, extensions *CatchAllExtensions) {
+	if !strings.Contains(path, "/*") {
+		return
+	}
+	n := engine.routeNode(method, path)
+	if n == nil || n.nType != catchAll {
+		return
+	}
+	n.extensions = extensions
+	if extensions.Param != "" {
+		if paramsCount := countParams(path) + 1; paramsCount > engine.maxParams {
+			engine.maxParams = paramsCount
+		}
+	}
+}
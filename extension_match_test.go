@@ -0,0 +1,71 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileExt(t *testing.T) {
+	assert.Equal(t, ".js", fileExt("/js/app.js"))
+	assert.Equal(t, ".gz", fileExt("/archive.tar.gz"))
+	assert.Equal(t, "", fileExt("/v1.2/readme"))
+	assert.Equal(t, "", fileExt("/"))
+	assert.Equal(t, "", fileExt(""))
+}
+
+func TestRouterGroupWithExtensions(t *testing.T) {
+	router := New()
+	assets := router.WithExtensions(CatchAllExtensions{
+		Param:   "ext",
+		Allowed: []string{".js", ".CSS"},
+	})
+	assets.GET("/assets/*filepath", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.Param("filepath")+" "+c.Param("ext"))
+	})
+	// routes without catch-all are not affected
+	assets.GET("/index", func(c *Context) {
+		c.String(http.StatusOK, "%s", "index")
+	})
+	router.GET("/files/*filepath", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.Param("filepath")+" "+c.Param("ext"))
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/assets/js/app.js")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "/js/app.js .js", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/assets/style.css")
+	assert.Equal(t, "/style.css .css", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/assets/passwd")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/assets/image.png")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/index")
+	assert.Equal(t, "index", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/files/image.png")
+	assert.Equal(t, "/image.png ", w.Body.String())
+}
+
+func TestRouterGroupWithExtensionsParamOnly(t *testing.T) {
+	router := New()
+	router.WithExtensions(CatchAllExtensions{Param: "ext"}).GET("/*filepath", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.Param("ext"))
+	})
+
+	assert.Equal(t, 2, int(router.maxParams))
+	w := PerformRequest(router, http.MethodGet, "/image.png")
+	assert.Equal(t, ".png", w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/LICENSE")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+}
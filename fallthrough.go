@@ -0,0 +1,74 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"reflect"
+)
+
+// FallThrough resumes the routing of the request as if the matched route had not matched,
+// e.g. when a catch-all handler decides the resource doesn't exist. The request is handed
+// to the route that would have matched otherwise, such as /:user/profile for a request
+// /docs/profile matching /docs/*page first, or to the NoRoute handlers if there is none.
+// The remaining handlers of the current route are skipped, and the leading middleware the
+// other chain shares with the current one, such as the global middleware, don't run again.
+func (c *Context) FallThrough() {
+	engine := c.engine
+	unescape := engine.UseRawPath && len(c.Request.URL.RawPath) > 0 && engine.UnescapePathValues
+	root := engine.trees.get(c.routeMethod())
+	if root != nil && engine.EnableStaticFastPath && c.fullPath != "" && isStaticPath(c.fullPath) {
+		// the static fast path did not record the skipped nodes, walk the tree instead
+		*c.params = (*c.params)[:0]
+		*c.skippedNodes = (*c.skippedNodes)[:0]
+		root.getValue(c.fullPath, c.params, c.skippedNodes, false)
+	}
+
+	var value nodeValue
+	c.constrained = nil
+	if root != nil {
+		value = resumeValue(c.params, c.skippedNodes, unescape)
+		if len(engine.constraints) > 0 {
+			value, c.constrained = engine.constrainValue(c.routeMethod(), value, c.params, c.skippedNodes, unescape)
+		}
+	}
+	current := c.handlers
+	if value.handlers == nil {
+		*c.params = (*c.params)[:0]
+	}
+	c.Params = *c.params
+	if value.handlers == nil {
+		c.fullPath = ""
+		c.handlers = engine.allNoRoute
+		c.index = int8(commonPrefixLen(current, c.handlers)) - 1
+		serveError(c, http.StatusNotFound, default404Body)
+		return
+	}
+
+	c.handlers = value.handlers
+	c.fullPath = value.fullPath
+	if engine.ParamsInRequestContext && len(c.Params) > 0 {
+		c.Request = requestWithParams(c.Request, c.Params)
+	}
+	// the final handler of the route always runs
+	shared := commonPrefixLen(current, c.handlers)
+	if shared == len(c.handlers) {
+		shared--
+	}
+	c.index = int8(shared) - 1
+	c.Next()
+}
+
+// commonPrefixLen returns the number of leading handlers of a and b that are the same
+// functions.
+func commonPrefixLen(a, b HandlersChain) int {
+	i := 0
+	for ; i < len(a) && i < len(b); i++ {
+		if reflect.ValueOf(a[i]).Pointer() != reflect.ValueOf(b[i]).Pointer() {
+			break
+		}
+	}
+	return i
+}
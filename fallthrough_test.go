@@ -0,0 +1,78 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextFallThrough(t *testing.T) {
+	var middlewareRuns, nextRuns int
+	router := New()
+	router.Use(func(c *Context) {
+		middlewareRuns++
+	})
+	router.GET("/docs/*page", func(c *Context) {
+		if c.Param("page") != "/index" {
+			c.FallThrough()
+			return
+		}
+		c.String(http.StatusOK, "%s", "docs")
+	}, func(c *Context) {
+		nextRuns++
+	})
+	router.GET("/:user/profile", func(c *Context) {
+		c.String(http.StatusOK, "%s", "profile of "+c.Param("user")+" "+c.FullPath())
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/docs/index")
+	assert.Equal(t, "docs", w.Body.String())
+	assert.Equal(t, 1, nextRuns)
+
+	middlewareRuns, nextRuns = 0, 0
+	w = PerformRequest(router, http.MethodGet, "/docs/profile")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "profile of docs /:user/profile", w.Body.String())
+	assert.Equal(t, 1, middlewareRuns)
+	assert.Equal(t, 0, nextRuns)
+
+	middlewareRuns = 0
+	w = PerformRequest(router, http.MethodGet, "/docs/missing")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "404 page not found", w.Body.String())
+	assert.Equal(t, 1, middlewareRuns)
+	assert.Equal(t, 0, nextRuns)
+}
+
+func TestContextFallThroughNoRoute(t *testing.T) {
+	router := New()
+	router.NoRoute(func(c *Context) {
+		c.String(http.StatusNotFound, "%s", "custom "+c.Param("page"))
+	})
+	router.GET("/files/*page", func(c *Context) {
+		c.FallThrough()
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/files/a")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "custom ", w.Body.String())
+}
+
+func TestContextFallThroughStaticFastPath(t *testing.T) {
+	router := New()
+	router.EnableStaticFastPath = true
+	router.GET("/users/new", func(c *Context) {
+		c.FallThrough()
+	})
+	router.GET("/users/:id", func(c *Context) {
+		c.String(http.StatusOK, "%s", "user "+c.Param("id"))
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/users/new")
+	assert.Equal(t, "user new", w.Body.String())
+}
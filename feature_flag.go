@@ -0,0 +1,79 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import "net/http"
+
+// FlagAttributes are the request attributes a feature flag is evaluated with.
+type FlagAttributes struct {
+	// User is the authenticated user, as stored under AuthUserKey, if any.
+	User string
+	// ClientIP is the client IP, see Context.ClientIP.
+	ClientIP string
+	// Header is the request header.
+	Header http.Header
+	// Keys are the values set on the context.
+	Keys map[string]any
+}
+
+// FeatureFlagProvider evaluates feature flags, see Engine.SetFeatureFlagProvider.
+type FeatureFlagProvider interface {
+	Enabled(flag string, attrs FlagAttributes) bool
+}
+
+// FeatureFlagFunc is an adapter to use an ordinary function as a FeatureFlagProvider.
+type FeatureFlagFunc func(flag string, attrs FlagAttributes) bool
+
+// Enabled calls f(flag, attrs).
+func (f FeatureFlagFunc) Enabled(flag string, attrs FlagAttributes) bool {
+	return f(flag, attrs)
+}
+
+// SetFeatureFlagProvider sets the provider evaluating the flags of Context.FeatureEnabled.
+func (engine *Engine) SetFeatureFlagProvider(provider FeatureFlagProvider) {
+	engine.featureFlags = provider
+}
+
+// FeatureEnabled reports whether flag is enabled for the current request, according to the
+// provider set with Engine.SetFeatureFlagProvider. It returns false if there is no provider.
+// A flag is evaluated once per request, so a handler and the middleware before it always
+// agree on its value.
+//
+//	if c.FeatureEnabled("new-checkout") {
+//	    // ...
+//	}
+func (c *Context) FeatureEnabled(flag string) bool {
+	provider := c.engine.featureFlags
+	if provider == nil {
+		return false
+	}
+
+	c.mu.Lock()
+	if enabled, ok := c.featureFlags[flag]; ok {
+		c.mu.Unlock()
+		return enabled
+	}
+	keys := make(map[string]any, len(c.Keys))
+	for k, v := range c.Keys {
+		keys[k] = v
+	}
+	c.mu.Unlock()
+
+	user, _ := keys[AuthUserKey].(string)
+	enabled := provider.Enabled(flag, FlagAttributes{
+		User:     user,
+		ClientIP: c.ClientIP(),
+		Header:   c.Request.Header,
+		Keys:     keys,
+	})
+
+	c.mu.Lock()
+	if c.featureFlags == nil {
+		c.featureFlags = make(map[string]bool)
+	}
+	c.featureFlags[flag] = enabled
+	c.mu.Unlock()
+	return enabled
+}
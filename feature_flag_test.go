@@ -0,0 +1,45 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextFeatureEnabled(t *testing.T) {
+	evaluations := 0
+	router := New()
+	router.GET("/", func(c *Context) {
+		assert.False(t, c.FeatureEnabled("new-checkout"))
+	})
+	PerformRequest(router, http.MethodGet, "/")
+
+	router = New()
+	router.SetFeatureFlagProvider(FeatureFlagFunc(func(flag string, attrs FlagAttributes) bool {
+		evaluations++
+		return flag == "new-checkout" && (attrs.User == "beta" || attrs.Header.Get("X-Beta") == "1")
+	}))
+	router.Use(BasicAuth(Accounts{"beta": "pass", "user": "pass"}))
+	router.GET("/", func(c *Context) {
+		enabled := c.FeatureEnabled("new-checkout")
+		assert.Equal(t, enabled, c.FeatureEnabled("new-checkout"))
+		assert.False(t, c.FeatureEnabled("other"))
+		c.String(http.StatusOK, "%t", enabled)
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/", header{"Authorization", authorizationHeader("beta", "pass")})
+	assert.Equal(t, "true", w.Body.String())
+	assert.Equal(t, 2, evaluations)
+
+	w = PerformRequest(router, http.MethodGet, "/", header{"Authorization", authorizationHeader("user", "pass")})
+	assert.Equal(t, "false", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/",
+		header{"Authorization", authorizationHeader("user", "pass")}, header{"X-Beta", "1"})
+	assert.Equal(t, "true", w.Body.String())
+}
@@ -0,0 +1,50 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// Forward serves the request with the route matching method and path, e.g. "/v2/users/42"
+// or "/search?q=gin", without changing the request seen by the other handlers. The new
+// route gets its own params and shares the keys of the context. Once it is served, the
+// route and params of the context are restored. If path has no query, the query of the
+// request is kept.
+func (c *Context) Forward(method, path string) error {
+	return c.ForwardWithOptions(method, path, ReentryOptions{KeepKeys: true})
+}
+
+// ForwardWithOptions is like Forward, with the state of the context selected by opts.
+func (c *Context) ForwardWithOptions(method, path string, opts ReentryOptions) error {
+	if method == "" {
+		return fmt.Errorf("gin: forward to %q without method", path)
+	}
+	target, err := url.ParseRequestURI(path)
+	if err != nil {
+		return fmt.Errorf("gin: forward to %q: %w", path, err)
+	}
+	if target.Scheme != "" || target.Host != "" {
+		return fmt.Errorf("gin: forward to %q is not a path", path)
+	}
+
+	req := c.Request
+	forwarded := req.Clone(req.Context())
+	forwarded.Method = method
+	if target.RawQuery == "" && !target.ForceQuery {
+		target.RawQuery = req.URL.RawQuery
+	}
+	target.Scheme, target.Host, target.User = req.URL.Scheme, req.URL.Host, req.URL.User
+	forwarded.URL = target
+	forwarded.RequestURI = target.RequestURI()
+
+	c.Request = forwarded
+	defer func() {
+		c.Request = req
+		c.queryCache = nil
+	}()
+	return c.engine.HandleContextWithOptions(c, opts)
+}
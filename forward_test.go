@@ -0,0 +1,84 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextForward(t *testing.T) {
+	r := New()
+	var after string
+	r.GET("/v1/users/:id", func(c *Context) {
+		c.Set("user", "gopher")
+		assert.NoError(t, c.Forward(http.MethodPost, "/v2/users/"+c.Param("id")))
+		after = c.Request.Method + " " + c.Request.URL.String() + " " + c.FullPath() + " " + c.Param("id") + " " + c.Query("page")
+	})
+	r.POST("/v2/users/:uid", func(c *Context) {
+		_, hasID := c.Params.Get("id")
+		c.String(http.StatusOK, "%s %s %s %t %s %s", c.Request.Method, c.Param("uid"), c.Query("page"), hasID, c.GetString("user"), c.FullPath())
+		c.Set("seen", true)
+	})
+
+	w := PerformRequest(r, http.MethodGet, "/v1/users/42?page=2")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "POST 42 2 false gopher /v2/users/:uid", w.Body.String())
+	assert.Equal(t, "GET /v1/users/42?page=2 /v1/users/:id 42 2", after)
+}
+
+func TestContextForwardQuery(t *testing.T) {
+	r := New()
+	r.GET("/old", func(c *Context) {
+		assert.NoError(t, c.Forward(http.MethodGet, "/search?q=gin"))
+		assert.Equal(t, "", c.Query("q"))
+		assert.Equal(t, "1", c.Query("page"))
+	})
+	r.GET("/search", func(c *Context) {
+		c.String(http.StatusOK, "%s:%s", c.Query("q"), c.Query("page"))
+	})
+
+	w := PerformRequest(r, http.MethodGet, "/old?page=1")
+	assert.Equal(t, "gin:", w.Body.String())
+}
+
+func TestContextForwardWithOptions(t *testing.T) {
+	r := New()
+	var keys map[string]any
+	r.GET("/a/:id", func(c *Context) {
+		c.Set("user", "gopher")
+		assert.NoError(t, c.ForwardWithOptions(http.MethodGet, "/b", ReentryOptions{CopyKeys: true, KeepParams: true}))
+		keys = c.Keys
+	})
+	r.GET("/b", func(c *Context) {
+		c.Set("seen", true)
+		c.String(http.StatusOK, "%s %s", c.GetString("user"), c.Param("id"))
+	})
+
+	w := PerformRequest(r, http.MethodGet, "/a/42")
+	assert.Equal(t, "gopher 42", w.Body.String())
+	assert.Equal(t, map[string]any{"user": "gopher"}, keys)
+}
+
+func TestContextForwardErrors(t *testing.T) {
+	c, _ := CreateTestContext(nil)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/", nil)
+
+	assert.Error(t, c.Forward("", "/"))
+	assert.Error(t, c.Forward(http.MethodGet, "users"))
+	assert.Error(t, c.Forward(http.MethodGet, "http://example.com/"))
+}
+
+func TestContextForwardNotFound(t *testing.T) {
+	r := New()
+	r.GET("/", func(c *Context) {
+		assert.NoError(t, c.Forward(http.MethodGet, "/missing"))
+	})
+
+	w := PerformRequest(r, http.MethodGet, "/")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
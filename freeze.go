@@ -0,0 +1,50 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+// Freeze finalizes the routes of the engine for read-only serving: every tree is compacted
+// into a single array of nodes laid out breadth first, so lookups walk contiguous memory,
+// and registering routes or appending middleware afterwards panics.
+// Freeze must be called once all the routes are registered and before serving requests.
+func (engine *Engine) Freeze() {
+	if engine.frozen {
+		return
+	}
+	for i := range engine.trees {
+		engine.trees[i].root = &compactTree(engine.trees[i].root)[0]
+	}
+	engine.frozen = true
+}
+
+// Frozen returns true if the routes of the engine were finalized with Freeze.
+func (engine *Engine) Frozen() bool {
+	return engine.frozen
+}
+
+// compactTree copies the tree rooted at root into a single array of nodes in breadth first
+// order, which keeps the children of every node next to each other. The root is the first
+// node of the array.
+func compactTree(root *node) []node {
+	order := []*node{root}
+	for i := 0; i < len(order); i++ {
+		order = append(order, order[i].children...)
+	}
+
+	nodes := make([]node, len(order))
+	children := make([]*node, len(order))
+	for i, n := range order {
+		nodes[i] = *n
+		children[i] = &nodes[i]
+	}
+
+	next := 1
+	for i := range nodes {
+		if count := len(nodes[i].children); count > 0 {
+			nodes[i].children = children[next : next+count : next+count]
+			next += count
+		}
+	}
+	return nodes
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngineFreeze(t *testing.T) {
+	router := New()
+	githubConfigRouter(router)
+	routes := router.Routes()
+
+	assert.False(t, router.Frozen())
+	router.Freeze()
+	assert.True(t, router.Frozen())
+	root := router.trees.get(http.MethodGet)
+	router.Freeze()
+	assert.Same(t, root, router.trees.get(http.MethodGet))
+	assert.Len(t, router.Routes(), len(routes))
+
+	for _, route := range githubAPI {
+		path, values := exampleFromPath(route.path)
+		w := PerformRequest(router, route.method, path)
+
+		assert.Contains(t, w.Body.String(), "\"status\":\"good\"")
+		for _, value := range values {
+			str := fmt.Sprintf("\"%s\":\"%s\"", value.Key, value.Value)
+			assert.Contains(t, w.Body.String(), str)
+		}
+	}
+
+	assert.PanicsWithValue(t, "routes can not be added once the engine is frozen", func() {
+		router.GET("/late", func(c *Context) {})
+	})
+	assert.PanicsWithValue(t, "middleware can not be appended once the engine is frozen", func() {
+		router.AppendMiddleware(http.MethodGet, "/user", func(c *Context) {})
+	})
+}
+
+func TestCompactTree(t *testing.T) {
+	tree := &node{}
+	for _, route := range []string{"/", "/users", "/users/:id", "/files/*path", "/about", "/api/v1"} {
+		tree.addRoute(route, fakeHandler(route))
+	}
+	nodes := compactTree(tree)
+	compact := &nodes[0]
+
+	// the nodes are stored in a single array in breadth first order
+	order := []*node{compact}
+	for i := 0; i < len(order); i++ {
+		order = append(order, order[i].children...)
+	}
+	assert.Len(t, nodes, len(order))
+	for i, n := range order {
+		assert.Same(t, &nodes[i], n)
+	}
+
+	checkPriorities(t, compact)
+	checkRequests(t, compact, testRequests{
+		{"/", false, "/", nil},
+		{"/users", false, "/users", nil},
+		{"/users/42", false, "/users/:id", Params{Param{"id", "42"}}},
+		{"/files/a/b", false, "/files/*path", Params{Param{"path", "/a/b"}}},
+		{"/about", false, "/about", nil},
+		{"/api/v1", false, "/api/v1", nil},
+	})
+}
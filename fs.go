@@ -5,8 +5,12 @@
 package gin
 
 import (
+	"errors"
+	"io"
+	"io/fs"
 	"net/http"
 	"os"
+	"sort"
 )
 
 type onlyFilesFS struct {
@@ -43,3 +47,118 @@ func (f neuteredReaddirFile) Readdir(count int) ([]os.FileInfo, error) {
 	// this disables directory listing
 	return nil, nil
 }
+
+type overlayFS []fs.FS
+
+// OverlayFS composes several fs.FS into a single one. Layers are looked up in order, so the
+// first layer holding a file wins and directories list the merged entries of every layer.
+// It is typically used to serve embedded defaults that operators can override on disk
+// without rebuilding:
+//
+//	router.StaticOverlay("/assets", os.DirFS("./overrides"), embeddedAssets)
+func OverlayFS(layers ...fs.FS) fs.FS {
+	return overlayFS(layers)
+}
+
+// Open conforms to fs.FS.
+func (o overlayFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	var dirs []fs.File
+	var openErr error
+	for _, layer := range o {
+		f, err := layer.Open(name)
+		if err != nil {
+			if openErr == nil && !errors.Is(err, fs.ErrNotExist) {
+				openErr = err
+			}
+			continue
+		}
+		info, err := f.Stat()
+		if err != nil {
+			f.Close()
+			continue
+		}
+		if info.IsDir() {
+			dirs = append(dirs, f)
+			continue
+		}
+		// a directory of an upper layer hides files of the lower ones
+		if len(dirs) == 0 {
+			return f, nil
+		}
+		f.Close()
+	}
+
+	switch len(dirs) {
+	case 0:
+		if openErr != nil {
+			return nil, openErr
+		}
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	case 1:
+		return dirs[0], nil
+	}
+	return &overlayDir{File: dirs[0], layers: dirs}, nil
+}
+
+// overlayDir is a directory present in several layers of an overlayFS.
+type overlayDir struct {
+	fs.File
+	layers  []fs.File
+	entries []fs.DirEntry
+	offset  int
+}
+
+// ReadDir conforms to fs.ReadDirFile and merges the entries of every layer.
+func (d *overlayDir) ReadDir(count int) ([]fs.DirEntry, error) {
+	if d.entries == nil {
+		seen := make(map[string]struct{})
+		d.entries = []fs.DirEntry{}
+		for _, layer := range d.layers {
+			dir, ok := layer.(fs.ReadDirFile)
+			if !ok {
+				continue
+			}
+			entries, err := dir.ReadDir(-1)
+			if err != nil {
+				return nil, err
+			}
+			for _, entry := range entries {
+				if _, ok := seen[entry.Name()]; ok {
+					continue
+				}
+				seen[entry.Name()] = struct{}{}
+				d.entries = append(d.entries, entry)
+			}
+		}
+		sort.Slice(d.entries, func(i, j int) bool { return d.entries[i].Name() < d.entries[j].Name() })
+	}
+
+	rest := d.entries[d.offset:]
+	if count <= 0 {
+		d.offset = len(d.entries)
+		return rest, nil
+	}
+	if len(rest) == 0 {
+		return nil, io.EOF
+	}
+	if count > len(rest) {
+		count = len(rest)
+	}
+	d.offset += count
+	return rest[:count], nil
+}
+
+// Close closes the directory of every layer.
+func (d *overlayDir) Close() error {
+	var err error
+	for _, layer := range d.layers {
+		if cerr := layer.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
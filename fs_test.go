@@ -0,0 +1,83 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"io/fs"
+	"net/http"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverlayFS(t *testing.T) {
+	override := fstest.MapFS{
+		"app.css":       {Data: []byte("override")},
+		"img/new.png":   {Data: []byte("new")},
+		"shadow/x.txt":  {Data: []byte("dir")},
+		"nested/a.txt":  {Data: []byte("a")},
+		"nested/b.txt":  {Data: []byte("b override")},
+		"only-override": {Data: []byte("o")},
+	}
+	defaults := fstest.MapFS{
+		"app.css":      {Data: []byte("default")},
+		"app.js":       {Data: []byte("js")},
+		"img/logo.png": {Data: []byte("logo")},
+		"shadow":       {Data: []byte("file")},
+		"nested/b.txt": {Data: []byte("b default")},
+		"nested/c.txt": {Data: []byte("c")},
+	}
+	overlay := OverlayFS(override, defaults)
+
+	data, err := fs.ReadFile(overlay, "app.css")
+	assert.NoError(t, err)
+	assert.Equal(t, "override", string(data))
+
+	data, err = fs.ReadFile(overlay, "app.js")
+	assert.NoError(t, err)
+	assert.Equal(t, "js", string(data))
+
+	data, err = fs.ReadFile(overlay, "nested/b.txt")
+	assert.NoError(t, err)
+	assert.Equal(t, "b override", string(data))
+
+	info, err := fs.Stat(overlay, "shadow")
+	assert.NoError(t, err)
+	assert.True(t, info.IsDir())
+
+	entries, err := fs.ReadDir(overlay, "nested")
+	assert.NoError(t, err)
+	names := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	assert.Equal(t, []string{"a.txt", "b.txt", "c.txt"}, names)
+
+	_, err = overlay.Open("missing")
+	assert.ErrorIs(t, err, fs.ErrNotExist)
+	_, err = overlay.Open("../escape")
+	assert.ErrorIs(t, err, fs.ErrInvalid)
+
+	assert.NoError(t, fstest.TestFS(overlay, "app.css", "app.js", "img/new.png", "img/logo.png", "nested/c.txt"))
+}
+
+func TestRouterStaticOverlay(t *testing.T) {
+	router := New()
+	router.StaticOverlay("/assets",
+		fstest.MapFS{"app.css": {Data: []byte("override")}},
+		fstest.MapFS{"app.css": {Data: []byte("default")}, "app.js": {Data: []byte("js")}},
+	)
+
+	w := PerformRequest(router, http.MethodGet, "/assets/app.css")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "override", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/assets/app.js")
+	assert.Equal(t, "js", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/assets/missing.js")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,480 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// ErrNoHealthyUpstream is reported when all the targets of an upstream pool are unhealthy.
+var ErrNoHealthyUpstream = errors.New("gin: no healthy upstream target")
+
+const defaultGatewayRetryBodySize = 1 << 20 // 1 MB
+
+// LoadBalancing is the strategy an upstream pool uses to pick a target.
+type LoadBalancing string
+
+const (
+	// LoadBalanceRoundRobin picks the targets in turn, it is the default.
+	LoadBalanceRoundRobin LoadBalancing = "round-robin"
+	// LoadBalanceRandom picks a random target.
+	LoadBalanceRandom LoadBalancing = "random"
+	// LoadBalanceLeastConnections picks the target with the fewest requests in flight.
+	LoadBalanceLeastConnections LoadBalancing = "least-connections"
+)
+
+const defaultHealthInterval = 10 * time.Second
+
+// UpstreamConfig declares a pool of upstream targets.
+type UpstreamConfig struct {
+	// Targets are the base URLs of the upstream servers, e.g. http://10.0.0.1:8080.
+	Targets []string `yaml:"targets"`
+
+	// Balancing is the load balancing strategy. Optional. Default value is round-robin.
+	Balancing LoadBalancing `yaml:"balancing,omitempty"`
+
+	// HealthCheck is the path requested on every target to check its health, targets
+	// answering with an error or a status code of 500 or more are not picked until they
+	// recover. Optional. No health check when empty.
+	HealthCheck string `yaml:"health_check,omitempty"`
+
+	// HealthInterval is the interval between health checks. Optional. Default value is 10s.
+	HealthInterval time.Duration `yaml:"health_interval,omitempty"`
+}
+
+// GatewayRoute declares a route proxied to an upstream pool.
+type GatewayRoute struct {
+	// Method is the HTTP method of the route, e.g. GET.
+	Method string `yaml:"method"`
+
+	// Path is the path of the route, relative to the group the gateway is mounted in.
+	Path string `yaml:"path"`
+
+	// Upstream is the name of the upstream pool serving the route.
+	Upstream string `yaml:"upstream"`
+
+	// StripPrefix is removed from the request path before it is appended to the path of
+	// the target, if the path starts with its segments: "/api" is removed from "/api" and
+	// "/api/users", not from "/apikeys". Optional.
+	StripPrefix string `yaml:"strip_prefix,omitempty"`
+
+	// Retries is the number of times a request failing with a transport error or a 502,
+	// 503 or 504 response is retried on another target. Only the idempotent requests are
+	// retried: those with an idempotent method, e.g. GET or PUT, or an Idempotency-Key
+	// header. Optional.
+	Retries int `yaml:"retries,omitempty"`
+
+	// MaxRetryBodySize is the maximum size of the request bodies buffered to be sent again
+	// on retries, the requests with a larger body are not retried. Optional. Default value
+	// is 1 MB.
+	MaxRetryBodySize int64 `yaml:"max_retry_body_size,omitempty"`
+
+	// RequestHeaders is applied to the requests sent upstream. Optional.
+	RequestHeaders HeaderPolicy `yaml:"request_headers,omitempty"`
+
+	// ResponseHeaders is applied to the responses sent back to the client. Optional.
+	ResponseHeaders HeaderPolicy `yaml:"response_headers,omitempty"`
+}
+
+// GatewayConfig declares the upstream pools and routes of a Gateway.
+type GatewayConfig struct {
+	Upstreams map[string]UpstreamConfig `yaml:"upstreams"`
+	Routes    []GatewayRoute            `yaml:"routes"`
+
+	// Transport sends the requests to the upstream targets, it is shared by all the routes
+	// so connections are pooled. Optional. Default value is a clone of
+	// http.DefaultTransport keeping up to 32 idle connections per target.
+	Transport http.RoundTripper `yaml:"-"`
+}
+
+// ParseGatewayConfig parses a YAML gateway config.
+func ParseGatewayConfig(data []byte) (*GatewayConfig, error) {
+	var config GatewayConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// Gateway proxies routes to pools of upstream servers, see RouterGroup.Gateway.
+type Gateway struct {
+	upstreams map[string]*upstreamPool
+	transport http.RoundTripper
+	cancel    context.CancelFunc
+	wg        sync.WaitGroup
+}
+
+type upstreamTarget struct {
+	url      *url.URL
+	healthy  int32
+	inFlight int64
+}
+
+type upstreamPool struct {
+	name      string
+	targets   []*upstreamTarget
+	balancing LoadBalancing
+	next      uint32
+}
+
+// Gateway validates config, registers its routes in the group as reverse proxies to their
+// upstream pools and starts the health checks. Close stops the health checks.
+// If config is invalid or its routes conflict with registered routes, nothing is
+// registered and a *RouteTableError is returned.
+func (group *RouterGroup) Gateway(config *GatewayConfig) (*Gateway, error) {
+	var problems []string
+	g := &Gateway{upstreams: make(map[string]*upstreamPool, len(config.Upstreams)), transport: config.Transport}
+	for name, upstream := range config.Upstreams {
+		pool, err := newUpstreamPool(name, upstream)
+		if err != nil {
+			problems = append(problems, err.Error())
+			continue
+		}
+		g.upstreams[name] = pool
+	}
+
+	routes := make(RoutesInfo, 0, len(config.Routes))
+	for i, route := range config.Routes {
+		if !regEnLetter.MatchString(route.Method) || !strings.HasPrefix(route.Path, "/") {
+			problems = append(problems, fmt.Sprintf("route %d (%s %s): invalid method or path", i, route.Method, route.Path))
+		}
+		if _, ok := config.Upstreams[route.Upstream]; !ok {
+			problems = append(problems, fmt.Sprintf("route %d (%s %s): unknown upstream %q", i, route.Method, route.Path, route.Upstream))
+		}
+		routes = append(routes, RouteInfo{Method: route.Method, Path: group.calculateAbsolutePath(route.Path)})
+	}
+	if len(problems) == 0 {
+		problems = group.engine.routeConflicts(routes)
+	}
+	if len(problems) > 0 {
+		return nil, &RouteTableError{Problems: problems}
+	}
+
+	if g.transport == nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.MaxIdleConnsPerHost = 32
+		g.transport = transport
+	}
+	for _, route := range config.Routes {
+		group.handle(route.Method, route.Path, HandlersChain{g.proxy(route, g.upstreams[route.Upstream])})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	g.cancel = cancel
+	for name, pool := range g.upstreams {
+		upstream := config.Upstreams[name]
+		if upstream.HealthCheck == "" {
+			continue
+		}
+		interval := upstream.HealthInterval
+		if interval <= 0 {
+			interval = defaultHealthInterval
+		}
+		g.wg.Add(1)
+		go func(pool *upstreamPool, path string) {
+			defer g.wg.Done()
+			g.healthCheck(ctx, pool, path, interval)
+		}(pool, upstream.HealthCheck)
+	}
+	return g, nil
+}
+
+func newUpstreamPool(name string, config UpstreamConfig) (*upstreamPool, error) {
+	switch config.Balancing {
+	case "":
+		config.Balancing = LoadBalanceRoundRobin
+	case LoadBalanceRoundRobin, LoadBalanceRandom, LoadBalanceLeastConnections:
+	default:
+		return nil, fmt.Errorf("upstream %s: unknown load balancing %q", name, config.Balancing)
+	}
+	if len(config.Targets) == 0 {
+		return nil, fmt.Errorf("upstream %s: no target", name)
+	}
+	pool := &upstreamPool{name: name, balancing: config.Balancing}
+	for _, target := range config.Targets {
+		u, err := url.Parse(target)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			return nil, fmt.Errorf("upstream %s: invalid target %q", name, target)
+		}
+		pool.targets = append(pool.targets, &upstreamTarget{url: u, healthy: 1})
+	}
+	return pool, nil
+}
+
+// pick returns a healthy target other than the excluded ones, or nil.
+func (p *upstreamPool) pick(excluded []*upstreamTarget) *upstreamTarget {
+	candidates := make([]*upstreamTarget, 0, len(p.targets))
+	for _, target := range p.targets {
+		if atomic.LoadInt32(&target.healthy) == 1 && !containsTarget(excluded, target) {
+			candidates = append(candidates, target)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	switch p.balancing {
+	case LoadBalanceRandom:
+		return candidates[rand.Intn(len(candidates))]
+	case LoadBalanceLeastConnections:
+		best := candidates[0]
+		for _, target := range candidates[1:] {
+			if atomic.LoadInt64(&target.inFlight) < atomic.LoadInt64(&best.inFlight) {
+				best = target
+			}
+		}
+		return best
+	default:
+		return candidates[int(atomic.AddUint32(&p.next, 1)-1)%len(candidates)]
+	}
+}
+
+func containsTarget(targets []*upstreamTarget, target *upstreamTarget) bool {
+	for _, t := range targets {
+		if t == target {
+			return true
+		}
+	}
+	return false
+}
+
+// Healthy returns the targets of the upstream pool name that are currently healthy.
+func (g *Gateway) Healthy(name string) []string {
+	pool := g.upstreams[name]
+	if pool == nil {
+		return nil
+	}
+	var healthy []string
+	for _, target := range pool.targets {
+		if atomic.LoadInt32(&target.healthy) == 1 {
+			healthy = append(healthy, target.url.String())
+		}
+	}
+	return healthy
+}
+
+// Close stops the health checks.
+func (g *Gateway) Close() {
+	g.cancel()
+	g.wg.Wait()
+}
+
+func (g *Gateway) healthCheck(ctx context.Context, pool *upstreamPool, path string, interval time.Duration) {
+	client := &http.Client{Transport: g.transport, Timeout: interval}
+	check := func() {
+		for _, target := range pool.targets {
+			healthy := int32(0)
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, joinURLPath(target.url, path), nil)
+			if err == nil {
+				var resp *http.Response
+				if resp, err = client.Do(req); err == nil {
+					io.Copy(io.Discard, resp.Body) // nolint: errcheck
+					resp.Body.Close()
+					if resp.StatusCode < http.StatusInternalServerError {
+						healthy = 1
+					}
+				}
+			}
+			atomic.StoreInt32(&target.healthy, healthy)
+		}
+	}
+
+	check()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			check()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func joinURLPath(u *url.URL, path string) string {
+	return strings.TrimSuffix(u.String(), "/") + "/" + strings.TrimPrefix(path, "/")
+}
+
+// stripPathPrefix removes prefix from path if path starts with its segments.
+func stripPathPrefix(path, prefix string) string {
+	prefix = strings.TrimSuffix(prefix, "/")
+	if prefix == "" || !strings.HasPrefix(path, prefix) {
+		return path
+	}
+	rest := path[len(prefix):]
+	switch {
+	case rest == "":
+		return "/"
+	case rest[0] == '/':
+		return rest
+	}
+	return path
+}
+
+// proxy returns the handler proxying route to pool.
+func (g *Gateway) proxy(route GatewayRoute, pool *upstreamPool) HandlerFunc {
+	proxy := &httputil.ReverseProxy{
+		Director: func(req *http.Request) {
+			req.URL.Path = stripPathPrefix(req.URL.Path, route.StripPrefix)
+			req.URL.RawPath = ""
+			route.RequestHeaders.apply(req.Header)
+		},
+		Transport: &gatewayTransport{pool: pool, transport: g.transport, retries: route.Retries},
+		ModifyResponse: func(resp *http.Response) error {
+			route.ResponseHeaders.apply(resp.Header)
+			return nil
+		},
+		ErrorHandler: func(w http.ResponseWriter, req *http.Request, err error) {
+			if errors.Is(err, ErrNoHealthyUpstream) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.WriteHeader(http.StatusBadGateway)
+		},
+	}
+	maxBody := route.MaxRetryBodySize
+	if maxBody <= 0 {
+		maxBody = defaultGatewayRetryBodySize
+	}
+	return func(c *Context) {
+		req := c.Request
+		if route.Retries > 0 && idempotentRequest(req) && req.Body != nil && req.Body != http.NoBody &&
+			req.GetBody == nil && req.ContentLength <= maxBody {
+			// buffer the body so it can be sent again, unless it is too large
+			body, err := io.ReadAll(io.LimitReader(req.Body, maxBody+1))
+			if err != nil {
+				c.AbortWithError(http.StatusBadRequest, err) // nolint: errcheck
+				return
+			}
+			if int64(len(body)) > maxBody {
+				req.Body = struct {
+					io.Reader
+					io.Closer
+				}{io.MultiReader(bytes.NewReader(body), req.Body), req.Body}
+			} else {
+				req.Body = io.NopCloser(bytes.NewReader(body))
+				req.GetBody = func() (io.ReadCloser, error) {
+					return io.NopCloser(bytes.NewReader(body)), nil
+				}
+			}
+		}
+		// hide http.CloseNotifier, the underlying writer may not implement it
+		w := struct {
+			http.ResponseWriter
+			http.Flusher
+		}{c.Writer, c.Writer}
+		proxy.ServeHTTP(w, c.Request)
+	}
+}
+
+// gatewayTransport sends the requests to a target of the pool, retrying on other targets.
+type gatewayTransport struct {
+	pool      *upstreamPool
+	transport http.RoundTripper
+	retries   int
+}
+
+func (t *gatewayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var tried []*upstreamTarget
+	path := req.URL.Path
+	retries := t.retries
+	if !idempotentRequest(req) || (req.Body != nil && req.Body != http.NoBody && req.GetBody == nil) {
+		retries = 0
+	}
+	for attempt := 0; ; attempt++ {
+		target := t.pool.pick(tried)
+		if target == nil && len(tried) > 0 {
+			// every healthy target was tried, retry on any of them
+			target = t.pool.pick(nil)
+		}
+		if target == nil {
+			return nil, ErrNoHealthyUpstream
+		}
+		tried = append(tried, target)
+
+		out := req
+		if attempt > 0 {
+			out = req.Clone(req.Context())
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				out.Body = body
+			}
+		}
+		out.URL.Scheme = target.url.Scheme
+		out.URL.Host = target.url.Host
+		out.URL.Path = strings.TrimSuffix(target.url.Path, "/") + path
+		out.Host = ""
+
+		atomic.AddInt64(&target.inFlight, 1)
+		resp, err := t.transport.RoundTrip(out)
+		if resp != nil {
+			// the request is in flight until its response body is closed
+			resp.Body = &inFlightBody{ReadCloser: resp.Body, target: target}
+		} else {
+			atomic.AddInt64(&target.inFlight, -1)
+		}
+
+		if attempt >= retries || !retryable(resp, err) {
+			return resp, err
+		}
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body) // nolint: errcheck
+			resp.Body.Close()
+		}
+	}
+}
+
+// inFlightBody is the body of an upstream response, counted in the requests in flight to
+// its target until it is closed.
+type inFlightBody struct {
+	io.ReadCloser
+	target *upstreamTarget
+	closed int32
+}
+
+func (b *inFlightBody) Close() error {
+	if atomic.CompareAndSwapInt32(&b.closed, 0, 1) {
+		atomic.AddInt64(&b.target.inFlight, -1)
+	}
+	return b.ReadCloser.Close()
+}
+
+// idempotentRequest reports whether req can be sent again: its method is idempotent or it
+// has an Idempotency-Key header.
+func idempotentRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled)
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
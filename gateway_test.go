@@ -0,0 +1,265 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newUpstreamServer(name string, status *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/health" {
+			w.WriteHeader(int(atomic.LoadInt32(status)))
+			return
+		}
+		body, _ := io.ReadAll(req.Body)
+		w.Header().Set("Server", "upstream")
+		w.Header().Set("X-Upstream", name)
+		w.WriteHeader(int(atomic.LoadInt32(status)))
+		_, _ = io.WriteString(w, name+" "+req.URL.Path+" "+req.Header.Get("X-Gateway")+" "+string(body))
+	}))
+}
+
+func TestGatewayConfig(t *testing.T) {
+	config, err := ParseGatewayConfig([]byte(`
+upstreams:
+  users:
+    targets: [http://127.0.0.1:8081, http://127.0.0.1:8082]
+    balancing: least-connections
+    health_check: /health
+    health_interval: 5s
+routes:
+  - method: GET
+    path: /users/*path
+    upstream: users
+    strip_prefix: /api
+    retries: 2
+    request_headers:
+      set: {X-Gateway: gin}
+    response_headers:
+      remove: [Server]
+`))
+	assert.NoError(t, err)
+	assert.Equal(t, 5*time.Second, config.Upstreams["users"].HealthInterval)
+	assert.Equal(t, LoadBalanceLeastConnections, config.Upstreams["users"].Balancing)
+	assert.Equal(t, "gin", config.Routes[0].RequestHeaders.Set["X-Gateway"])
+	assert.Equal(t, []string{"Server"}, config.Routes[0].ResponseHeaders.Remove)
+
+	_, err = ParseGatewayConfig([]byte("upstream: {}"))
+	assert.Error(t, err)
+}
+
+func TestGatewayInvalid(t *testing.T) {
+	router := New()
+	router.GET("/taken", func(c *Context) {})
+	_, err := router.Gateway(&GatewayConfig{
+		Upstreams: map[string]UpstreamConfig{
+			"a": {Targets: []string{"http://127.0.0.1:1"}, Balancing: "fastest"},
+			"b": {Targets: []string{"not a url"}},
+			"c": {},
+		},
+		Routes: []GatewayRoute{{Method: "GET", Path: "/x", Upstream: "d"}},
+	})
+	var tableErr *RouteTableError
+	if assert.True(t, errors.As(err, &tableErr)) {
+		assert.Len(t, tableErr.Problems, 4)
+		assert.Contains(t, err.Error(), `unknown load balancing "fastest"`)
+		assert.Contains(t, err.Error(), `invalid target "not a url"`)
+		assert.Contains(t, err.Error(), "upstream c: no target")
+		assert.Contains(t, err.Error(), `unknown upstream "d"`)
+	}
+
+	_, err = router.Gateway(&GatewayConfig{
+		Upstreams: map[string]UpstreamConfig{"a": {Targets: []string{"http://127.0.0.1:1"}}},
+		Routes:    []GatewayRoute{{Method: "GET", Path: "/taken", Upstream: "a"}},
+	})
+	assert.Error(t, err)
+	assert.Len(t, router.Routes(), 1)
+}
+
+func TestGatewayProxy(t *testing.T) {
+	okStatus, failStatus := int32(http.StatusOK), int32(http.StatusServiceUnavailable)
+	one := newUpstreamServer("one", &okStatus)
+	defer one.Close()
+	two := newUpstreamServer("two", &failStatus)
+	defer two.Close()
+
+	router := New()
+	gateway, err := router.Group("/api").Gateway(&GatewayConfig{
+		Upstreams: map[string]UpstreamConfig{
+			"users": {Targets: []string{one.URL, two.URL + "/v2"}},
+		},
+		Routes: []GatewayRoute{{
+			Method:          http.MethodPost,
+			Path:            "/users/*path",
+			Upstream:        "users",
+			StripPrefix:     "/api",
+			Retries:         1,
+			RequestHeaders:  HeaderPolicy{Set: map[string]string{"X-Gateway": "gin"}},
+			ResponseHeaders: HeaderPolicy{Remove: []string{"Server"}, Set: map[string]string{"X-Proxied": "true"}},
+		}},
+	})
+	assert.NoError(t, err)
+	defer gateway.Close()
+
+	// the failing target is retried on the other one, with the same body
+	for i := 0; i < 4; i++ {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPost, "/api/users/42", strings.NewReader("body"))
+		req.Header.Set("Idempotency-Key", "k"+strconv.Itoa(i))
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "one /users/42 gin body", w.Body.String())
+		assert.Empty(t, w.Header().Get("Server"))
+		assert.Equal(t, "true", w.Header().Get("X-Proxied"))
+	}
+
+	// the requests which are not idempotent are not retried
+	codes := map[int]int{}
+	for i := 0; i < 2; i++ {
+		codes[PerformRequest(router, http.MethodPost, "/api/users/42").Code]++
+	}
+	assert.Equal(t, map[int]int{http.StatusOK: 1, http.StatusServiceUnavailable: 1}, codes)
+
+	// without retries the target answer is returned as is
+	atomic.StoreInt32(&okStatus, http.StatusServiceUnavailable)
+	w := PerformRequest(router, http.MethodPost, "/api/users/42")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
+
+func TestStripPathPrefix(t *testing.T) {
+	for _, tt := range []struct{ path, prefix, want string }{
+		{"/api/users", "/api", "/users"},
+		{"/api/users", "/api/", "/users"},
+		{"/api", "/api", "/"},
+		{"/api/", "/api", "/"},
+		{"/apikeys", "/api", "/apikeys"},
+		{"/users", "/api", "/users"},
+		{"/users", "", "/users"},
+	} {
+		assert.Equal(t, tt.want, stripPathPrefix(tt.path, tt.prefix), "%s without %s", tt.path, tt.prefix)
+	}
+}
+
+func TestGatewayRetryBodySize(t *testing.T) {
+	okStatus, failStatus := int32(http.StatusOK), int32(http.StatusServiceUnavailable)
+	one := newUpstreamServer("one", &okStatus)
+	defer one.Close()
+	two := newUpstreamServer("two", &failStatus)
+	defer two.Close()
+
+	router := New()
+	gateway, err := router.Gateway(&GatewayConfig{
+		Upstreams: map[string]UpstreamConfig{"users": {Targets: []string{two.URL, one.URL}}},
+		Routes: []GatewayRoute{{
+			Method: http.MethodPut, Path: "/users/*path", Upstream: "users",
+			StripPrefix: "/users", Retries: 1, MaxRetryBodySize: 4,
+		}},
+	})
+	assert.NoError(t, err)
+	defer gateway.Close()
+
+	send := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodPut, "/users/42", strings.NewReader(body))
+		req.ContentLength, req.GetBody = -1, nil
+		router.ServeHTTP(w, req)
+		return w
+	}
+	w := send("body")
+	assert.Equal(t, "one /42  body", w.Body.String(), "the stripped path keeps its leading slash")
+	codes := map[int]int{}
+	for i := 0; i < 2; i++ {
+		codes[send("large body").Code]++
+	}
+	assert.Equal(t, map[int]int{http.StatusOK: 1, http.StatusServiceUnavailable: 1}, codes,
+		"the large bodies are not buffered for retries")
+
+	for _, target := range gateway.upstreams["users"].targets {
+		assert.Zero(t, atomic.LoadInt64(&target.inFlight))
+	}
+}
+
+func TestGatewayInFlight(t *testing.T) {
+	release := make(chan struct{})
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.(http.Flusher).Flush()
+		<-release
+		_, _ = io.WriteString(w, "done")
+	}))
+	defer upstream.Close()
+
+	router := New()
+	gateway, err := router.Gateway(&GatewayConfig{
+		Upstreams: map[string]UpstreamConfig{"slow": {Targets: []string{upstream.URL}}},
+		Routes:    []GatewayRoute{{Method: http.MethodGet, Path: "/slow", Upstream: "slow"}},
+	})
+	assert.NoError(t, err)
+	defer gateway.Close()
+	target := gateway.upstreams["slow"].targets[0]
+
+	done := make(chan struct{})
+	go func() {
+		PerformRequest(router, http.MethodGet, "/slow")
+		close(done)
+	}()
+	assert.Eventually(t, func() bool { return atomic.LoadInt64(&target.inFlight) == 1 }, time.Second, time.Millisecond)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, int64(1), atomic.LoadInt64(&target.inFlight), "in flight until the body is read")
+	close(release)
+	<-done
+	assert.Zero(t, atomic.LoadInt64(&target.inFlight))
+}
+
+func TestGatewayHealthCheck(t *testing.T) {
+	okStatus, failStatus := int32(http.StatusOK), int32(http.StatusInternalServerError)
+	one := newUpstreamServer("one", &okStatus)
+	defer one.Close()
+	two := newUpstreamServer("two", &failStatus)
+	defer two.Close()
+
+	router := New()
+	gateway, err := router.Gateway(&GatewayConfig{
+		Upstreams: map[string]UpstreamConfig{
+			"users": {
+				Targets:        []string{one.URL, two.URL},
+				Balancing:      LoadBalanceRandom,
+				HealthCheck:    "/health",
+				HealthInterval: 5 * time.Millisecond,
+			},
+		},
+		Routes: []GatewayRoute{{Method: http.MethodGet, Path: "/users", Upstream: "users"}},
+	})
+	assert.NoError(t, err)
+	defer gateway.Close()
+
+	assert.Eventually(t, func() bool {
+		healthy := gateway.Healthy("users")
+		return len(healthy) == 1 && healthy[0] == one.URL
+	}, time.Second, 5*time.Millisecond)
+	assert.Nil(t, gateway.Healthy("missing"))
+
+	for i := 0; i < 5; i++ {
+		w := PerformRequest(router, http.MethodGet, "/users")
+		assert.Equal(t, "one", w.Header().Get("X-Upstream"))
+	}
+
+	atomic.StoreInt32(&okStatus, http.StatusInternalServerError)
+	assert.Eventually(t, func() bool {
+		return len(gateway.Healthy("users")) == 0
+	}, time.Second, 5*time.Millisecond)
+	w := PerformRequest(router, http.MethodGet, "/users")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
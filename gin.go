@@ -13,6 +13,8 @@ import (
 	"path"
 	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/gin-gonic/gin/internal/bytesconv"
 	"github.com/gin-gonic/gin/render"
@@ -56,15 +58,54 @@ func (c HandlersChain) Last() HandlerFunc {
 
 // RouteInfo represents a request route's specification which contains method and path and its handler.
 type RouteInfo struct {
-	Method      string
+	Method string
+	// Host is the host pattern of the route, for the routes registered with Engine.Host.
+	Host        string
 	Path        string
 	Handler     string
 	HandlerFunc HandlerFunc
+	Meta        map[string]any
+
+	// middleware is the number of handlers running before HandlerFunc.
+	middleware int
 }
 
 // RoutesInfo defines a RouteInfo slice.
 type RoutesInfo []RouteInfo
 
+// DuplicateRoutePolicy defines what happens when a route is registered twice with the same
+// method and path.
+type DuplicateRoutePolicy uint8
+
+const (
+	// DuplicateRoutePanic panics, which is the default.
+	DuplicateRoutePanic DuplicateRoutePolicy = iota
+	// DuplicateRouteReplace replaces the handlers of the route with the new ones.
+	DuplicateRouteReplace
+	// DuplicateRouteAppend appends the new handlers to the chain of the route, they run
+	// after the existing ones. The middleware of the new registration run a second time.
+	DuplicateRouteAppend
+)
+
+// InlineParams is the number of params the contexts store in an array of their own with
+// the ParamsInline strategy.
+const InlineParams = 8
+
+// ParamsStrategy defines where the contexts store the params of the routes.
+type ParamsStrategy uint8
+
+const (
+	// ParamsInline stores the params in an array of the context, which is the default, or
+	// in a slice allocated like with ParamsPreallocated if a route has more than
+	// InlineParams params. The params of the routes registered after the context was
+	// allocated are stored without allocation as well, up to InlineParams.
+	ParamsInline ParamsStrategy = iota
+	// ParamsPreallocated stores the params in a slice allocated for each context, with the
+	// capacity of the route with the most params, within MaxPreallocatedParams, which saves
+	// the memory of the array for the applications with few params.
+	ParamsPreallocated
+)
+
 // Trusted platforms
 const (
 	// PlatformGoogleAppEngine when running on Google App Engine. Trust X-Appengine-Remote-Addr
@@ -150,6 +191,61 @@ type Engine struct {
 	// ContextWithFallback enable fallback Context.Deadline(), Context.Done(), Context.Err() and Context.Value() when Context.Request.Context() is not nil.
 	ContextWithFallback bool
 
+	// ParamsInRequestContext enables copying the route params into the context of the request,
+	// so code that only receives the *http.Request, such as handlers wrapped with WrapH, can
+	// read them with ParamsFromContext.
+	ParamsInRequestContext bool
+
+	// DuplicateRoutes defines what happens when a route is registered twice, e.g. by plugins
+	// or test fixtures overriding a route. By default it panics.
+	DuplicateRoutes DuplicateRoutePolicy
+
+	// EnableStaticFastPath if enabled, the routes without params nor wildcards are looked up
+	// in a hash index before walking the trees, which is cheaper for static endpoints.
+	// Static routes always take precedence in the trees, so the routing is the same.
+	EnableStaticFastPath bool
+
+	// EnableResponseFastPath if enabled, Context.JSON writes the responses in a single
+	// Write with their Content-Length, from preallocated header values for the small ones,
+	// so that the server sends their status, headers and body at once, without the chunked
+	// encoding it otherwise uses for the bodies above 2 KiB. Enable it only if no
+	// middleware changes the length of the bodies, the Compression middleware excepted.
+	EnableResponseFastPath bool
+
+	// MaxPreallocatedParams caps the capacity of the params slice allocated up front for each
+	// pooled context, which otherwise matches the route with the most params. Requests with
+	// more params grow the slice, which is then kept for reuse. Zero means no cap.
+	MaxPreallocatedParams uint16
+
+	// ParamsStrategy selects where the pooled contexts store the params, in an array of the
+	// context itself by default. It must be set before serving.
+	ParamsStrategy ParamsStrategy
+
+	// MaxPreallocatedSections caps the capacity of the backtracking slice allocated up front
+	// for each pooled context, like MaxPreallocatedParams does for params. Zero means no cap.
+	MaxPreallocatedSections uint16
+
+	// MaintenanceRetryAfter is the delay advertised in the Retry-After header of the responses
+	// sent in maintenance mode, see SetMaintenanceMode. If zero, 120 seconds are advertised.
+	MaintenanceRetryAfter time.Duration
+
+	// MaxHandleContextDepth is the number of nested HandleContext calls allowed for a
+	// request, above which the request is aborted with ErrHandleContextDepth, to stop the
+	// internal redirect loops. Zero means no limit.
+	MaxHandleContextDepth int
+
+	// EnableMatrixParams enables the matrix params, e.g. /items;color=red/rest: the params
+	// following a semicolon in a path segment are removed before routing and exposed by
+	// Context.SegmentParams. Enable UseRawPath too, so that an escaped semicolon is not taken
+	// for a separator.
+	EnableMatrixParams bool
+
+	// AllowedHosts, if not empty, are the hostnames, e.g. "example.com" or "*.example.com",
+	// the requests must be addressed to, see Context.Host. Other requests are answered with
+	// 421 before routing, protecting against DNS rebinding and Host header injection in the
+	// generated URLs. Requests with a missing or malformed Host header are answered with 400.
+	AllowedHosts []string
+
 	delims           render.Delims
 	secureJSONPrefix string
 	HTMLRender       render.HTMLRender
@@ -158,12 +254,48 @@ type Engine struct {
 	allNoMethod      HandlersChain
 	noRoute          HandlersChain
 	noMethod         HandlersChain
+	allFallback      HandlersChain
+	fallback         http.Handler
 	pool             sync.Pool
+	poolStats        *poolCounters
+	runtime          *runtimeState
+	allocStats       *allocationCounters
 	trees            methodTrees
+	staticRoutes     map[string]map[string]staticRoute
+	frozen           bool
+	detached         bool
+	routeNames       map[string]string
 	maxParams        uint16
 	maxSections      uint16
 	trustedProxies   []string
 	trustedCIDRs     []*net.IPNet
+	trustedExplicit  bool
+	groups           []*RouterGroup
+	assetPrefix      string
+	assetManifest    AssetManifest
+	broker           *Broker
+	brokerOnce       sync.Once
+	eventBus         EventBus
+	eventBusOnce     sync.Once
+	summaryOnce      sync.Once
+	jobQueues        map[string]*JobQueue
+	jobQueuesMu      sync.Mutex
+	customAnyMethods []string
+	namedMiddleware  map[string]NamedMiddleware
+	errorMappings    []errorMapping
+	errorRenderer    func(c *Context, code int, err *Error)
+	messageCatalog   *MessageCatalog
+	maintenance      atomic.Value
+	featureFlags     FeatureFlagProvider
+	tenancy          *tenancy
+	routeMeta        map[string]map[string]any
+	hosts            []*hostRoutes
+	constraints      map[constraintKey][]*constrainedRoute
+	routeTemplates   map[string]string
+	handlerNames     sync.Map
+	pathCleaner      func(string) string
+	deprecatedUsage  sync.Map
+	drain            *drainState
 }
 
 var _ IRouter = &Engine{}
@@ -178,6 +310,11 @@ var _ IRouter = &Engine{}
 // - UnescapePathValues:     true
 func New() *Engine {
 	debugPrintWARNINGNew()
+	return newEngine()
+}
+
+// newEngine returns a new blank Engine, without printing the debug mode warning.
+func newEngine() *Engine {
 	engine := &Engine{
 		RouterGroup: RouterGroup{
 			Handlers: nil,
@@ -200,9 +337,12 @@ func New() *Engine {
 		secureJSONPrefix:       "while(1);",
 		trustedProxies:         []string{"0.0.0.0/0", "::/0"},
 		trustedCIDRs:           defaultTrustedCIDRs,
+		poolStats:              &poolCounters{},
+		drain:                  &drainState{done: make(chan struct{})},
 	}
 	engine.RouterGroup.engine = engine
 	engine.pool.New = func() any {
+		atomic.AddUint64(&engine.poolStats.misses, 1)
 		return engine.allocateContext()
 	}
 	return engine
@@ -226,9 +366,62 @@ func (engine *Engine) Handler() http.Handler {
 }
 
 func (engine *Engine) allocateContext() *Context {
-	v := make(Params, 0, engine.maxParams)
-	skippedNodes := make([]skippedNode, 0, engine.maxSections)
-	return &Context{engine: engine, params: &v, skippedNodes: &skippedNodes}
+	c := &Context{engine: engine}
+	engine.allocateParams(c)
+	return c
+}
+
+// allocateParams gives c the params and backtracking slices used by the router lookups,
+// preallocated for the registered routes within the MaxPreallocated* caps, or backed by
+// the array of c, see ParamsStrategy.
+func (engine *Engine) allocateParams(c *Context) {
+	size := capPrealloc(engine.maxParams, engine.MaxPreallocatedParams)
+	if engine.ParamsStrategy == ParamsInline && size <= InlineParams {
+		c.paramsSlice = c.paramsArray[:0]
+	} else {
+		c.paramsSlice = make(Params, 0, size)
+	}
+	skippedNodes := make([]skippedNode, 0, capPrealloc(engine.maxSections, engine.MaxPreallocatedSections))
+	c.params = &c.paramsSlice
+	c.skippedNodes = &skippedNodes
+}
+
+func capPrealloc(n, max uint16) uint16 {
+	if max > 0 && n > max {
+		return max
+	}
+	return n
+}
+
+// PoolStats reports how the contexts of an Engine are recycled.
+type PoolStats struct {
+	// Gets is the number of contexts taken from the pool to serve requests.
+	Gets uint64
+
+	// Misses is the number of contexts that had to be allocated because the pool was empty.
+	Misses uint64
+}
+
+// Hits returns the number of requests served with a recycled context.
+func (s PoolStats) Hits() uint64 {
+	if s.Misses > s.Gets {
+		return 0
+	}
+	return s.Gets - s.Misses
+}
+
+type poolCounters struct {
+	gets   uint64
+	misses uint64
+}
+
+// PoolStats returns the context pool statistics, to help tuning the preallocation caps.
+// Misses also count contexts allocated by the pool outside of ServeHTTP.
+func (engine *Engine) PoolStats() PoolStats {
+	return PoolStats{
+		Gets:   atomic.LoadUint64(&engine.poolStats.gets),
+		Misses: atomic.LoadUint64(&engine.poolStats.misses),
+	}
 }
 
 // Delims sets template left and right delims and returns an Engine instance.
@@ -297,6 +490,35 @@ func (engine *Engine) NoMethod(handlers ...HandlerFunc) {
 	engine.rebuild405Handlers()
 }
 
+// SetFallbackHandler sets a http.Handler the request is delegated to when no route matches
+// and no NoRoute handler is set. If HandleMethodNotAllowed is enabled and no NoMethod handler is
+// set, it is also used instead of the 405 response.
+// This enables gradual migrations where gin fronts an existing mux and delegates unknown paths to it.
+// Global middleware are executed before the fallback handler.
+func (engine *Engine) SetFallbackHandler(handler http.Handler) {
+	engine.fallback = handler
+	engine.rebuildFallbackHandlers()
+}
+
+// SetAnyMethods sets the HTTP methods Any and AnyExcept register routes for, it only affects
+// the routes registered afterwards. Calling it without methods restores the default ones:
+// GET, POST, PUT, PATCH, HEAD, OPTIONS, DELETE, CONNECT, TRACE.
+func (engine *Engine) SetAnyMethods(methods ...string) {
+	for _, method := range methods {
+		if matched := regEnLetter.MatchString(method); !matched {
+			panic("http method " + method + " is not valid")
+		}
+	}
+	engine.customAnyMethods = methods
+}
+
+func (engine *Engine) anyMethods() []string {
+	if len(engine.customAnyMethods) > 0 {
+		return engine.customAnyMethods
+	}
+	return anyMethods
+}
+
 // Use attaches a global middleware to the router. i.e. the middleware attached through Use() will be
 // included in the handlers chain for every single request. Even 404, 405, static files...
 // For example, this is the right place for a logger or error management middleware.
@@ -304,6 +526,7 @@ func (engine *Engine) Use(middleware ...HandlerFunc) IRoutes {
 	engine.RouterGroup.Use(middleware...)
 	engine.rebuild404Handlers()
 	engine.rebuild405Handlers()
+	engine.rebuildFallbackHandlers()
 	return engine
 }
 
@@ -315,12 +538,35 @@ func (engine *Engine) rebuild405Handlers() {
 	engine.allNoMethod = engine.combineHandlers(engine.noMethod)
 }
 
+func (engine *Engine) rebuildFallbackHandlers() {
+	if engine.fallback == nil {
+		engine.allFallback = nil
+		return
+	}
+	engine.allFallback = engine.combineHandlers(HandlersChain{WrapH(engine.fallback)})
+}
+
+// serveFallback delegates the request to the fallback handler, if any, and reports whether it did.
+func (engine *Engine) serveFallback(c *Context, handlers HandlersChain) bool {
+	if engine.allFallback == nil || len(handlers) > 0 {
+		return false
+	}
+	c.handlers = engine.allFallback
+	c.Next()
+	c.writermem.WriteHeaderNow()
+	return true
+}
+
 func (engine *Engine) addRoute(method, path string, handlers HandlersChain) {
 	assert1(path[0] == '/', "path must begin with '/'")
 	assert1(method != "", "HTTP method can not be empty")
 	assert1(len(handlers) > 0, "there must be at least one handler")
+	assert1(!engine.frozen, "routes can not be added once the engine is frozen")
+	path = engine.internRoute(path)
 
-	debugPrintRoute(method, path, handlers)
+	if !engine.detached {
+		debugPrintRoute(method, path, handlers)
+	}
 
 	root := engine.trees.get(method)
 	if root == nil {
@@ -328,7 +574,14 @@ func (engine *Engine) addRoute(method, path string, handlers HandlersChain) {
 		root.fullPath = "/"
 		engine.trees = append(engine.trees, methodTree{method: method, root: root})
 	}
+	if engine.DuplicateRoutes != DuplicateRoutePanic {
+		if n := engine.routeNode(method, path); n != nil {
+			engine.replaceRoute(n, method, path, handlers)
+			return
+		}
+	}
 	root.addRoute(path, handlers)
+	engine.indexStaticRoute(method, path, handlers)
 
 	// Update maxParams
 	if paramsCount := countParams(path); paramsCount > engine.maxParams {
@@ -340,15 +593,114 @@ func (engine *Engine) addRoute(method, path string, handlers HandlersChain) {
 	}
 }
 
+// replaceRoute applies the DuplicateRoutes policy to the route already registered in n.
+func (engine *Engine) replaceRoute(n *node, method, path string, handlers HandlersChain) {
+	if engine.DuplicateRoutes == DuplicateRouteAppend {
+		finalSize := len(n.handlers) + len(handlers)
+		assert1(finalSize < int(abortIndex), "too many handlers")
+		merged := make(HandlersChain, 0, finalSize)
+		merged = append(merged, n.handlers...)
+		handlers = append(merged, handlers...)
+	}
+	n.handlers = handlers
+	engine.indexStaticRoute(method, path, handlers)
+}
+
+// routeNode returns the tree node holding the handlers of the route registered with
+// method and path, or nil if there is none. The tree is walked down along the path
+// template, so that checking a route costs a lookup, not a walk of all the routes.
+func (engine *Engine) routeNode(method, path string) *node {
+	root := engine.trees.get(method)
+	if root == nil {
+		return nil
+	}
+	return findRouteNode(root, path, path)
+}
+
+// findRouteNode returns the node below n holding the route of the template fullPath, of
+// which path is the part left to match.
+func findRouteNode(n *node, path, fullPath string) *node {
+	if !strings.HasPrefix(path, n.path) {
+		return nil
+	}
+	path = path[len(n.path):]
+	if path == "" {
+		if len(n.handlers) > 0 && n.fullPath == fullPath {
+			return n
+		}
+		return nil
+	}
+	for i := 0; i < len(n.indices); i++ {
+		if n.indices[i] == path[0] {
+			if found := findRouteNode(n.children[i], path, fullPath); found != nil {
+				return found
+			}
+			break
+		}
+	}
+	if n.wildChild {
+		return findRouteNode(n.children[len(n.children)-1], path, fullPath)
+	}
+	return nil
+}
+
 // Routes returns a slice of registered routes, including some useful information, such as:
 // the http method, path and the handler name.
-func (engine *Engine) Routes() (routes RoutesInfo) {
+func (engine *Engine) Routes() RoutesInfo {
+	return engine.constrainedRoutes(engine.treeRoutes())
+}
+
+// treeRoutes returns the routes held by the trees, one per method and path.
+func (engine *Engine) treeRoutes() (routes RoutesInfo) {
 	for _, tree := range engine.trees {
+		start := len(routes)
 		routes = iterate("", tree.method, routes, tree.root)
+		method, host := splitHostMethod(tree.method)
+		for i := start; i < len(routes); i++ {
+			routes[i].Meta = engine.routeMeta[routeKey(tree.method, routes[i].Path)]
+			routes[i].Method, routes[i].Host = method, host
+		}
 	}
 	return routes
 }
 
+// Lookup queries the router without serving: it returns the route that a request with
+// method and path would be dispatched to, along with its params, or a nil route if there
+// is none. tsr is then true if the path with a trailing slash added or removed matches,
+// so the request would be redirected when RedirectTrailingSlash is enabled.
+// path is matched as the request path, e.g. cleaned first if RemoveExtraSlash is enabled.
+func (engine *Engine) Lookup(method, path string) (route *RouteInfo, params Params, tsr bool) {
+	if engine.RemoveExtraSlash {
+		path = engine.cleanPath(path)
+	}
+	root := engine.trees.get(method)
+	if root == nil {
+		return nil, nil, false
+	}
+	skippedNodes := make([]skippedNode, 0, engine.maxSections)
+	ps := make(Params, 0, engine.maxParams)
+	value := root.getValue(path, &ps, &skippedNodes, false)
+	var constrained *constrainedRoute
+	if len(engine.constraints) > 0 {
+		value, constrained = engine.constrainValue(method, value, &ps, &skippedNodes, false)
+	}
+	if value.handlers == nil {
+		return nil, nil, value.tsr
+	}
+	handlerFunc := value.handlers.Last()
+	meta := engine.routeMeta[routeKey(method, value.fullPath)]
+	if constrained != nil {
+		meta = constrained.meta
+	}
+	return &RouteInfo{
+		Method:      method,
+		Path:        value.fullPath,
+		Handler:     engine.HandlerName(handlerFunc),
+		HandlerFunc: handlerFunc,
+		Meta:        meta,
+	}, ps, false
+}
+
 func iterate(path, method string, routes RoutesInfo, root *node) RoutesInfo {
 	path += root.path
 	if len(root.handlers) > 0 {
@@ -358,6 +710,7 @@ func iterate(path, method string, routes RoutesInfo, root *node) RoutesInfo {
 			Path:        path,
 			Handler:     nameOfFunction(handlerFunc),
 			HandlerFunc: handlerFunc,
+			middleware:  len(root.handlers) - 1,
 		})
 	}
 	for _, child := range root.children {
@@ -379,17 +732,22 @@ func (engine *Engine) Run(addr ...string) (err error) {
 
 	address := resolveAddress(addr)
 	debugPrint("Listening and serving HTTP on %s\n", address)
-	err = http.ListenAndServe(address, engine.Handler())
+	err = engine.newServer(address).ListenAndServe()
 	return
 }
 
 func (engine *Engine) prepareTrustedCIDRs() ([]*net.IPNet, error) {
-	if engine.trustedProxies == nil {
+	return parseCIDRs(engine.trustedProxies)
+}
+
+// parseCIDRs parses a list of IP addresses and CIDRs as a list of networks.
+func parseCIDRs(proxies []string) ([]*net.IPNet, error) {
+	if proxies == nil {
 		return nil, nil
 	}
 
-	cidr := make([]*net.IPNet, 0, len(engine.trustedProxies))
-	for _, trustedProxy := range engine.trustedProxies {
+	cidr := make([]*net.IPNet, 0, len(proxies))
+	for _, trustedProxy := range proxies {
 		if !strings.Contains(trustedProxy, "/") {
 			ip := parseIP(trustedProxy)
 			if ip == nil {
@@ -419,9 +777,11 @@ func (engine *Engine) prepareTrustedCIDRs() ([]*net.IPNet, error) {
 // feature is enabled by default, and it also trusts all proxies
 // by default. If you want to disable this feature, use
 // Engine.SetTrustedProxies(nil), then Context.ClientIP() will
-// return the remote address directly.
+// return the remote address directly. The Forwarded and X-Forwarded-* headers read by
+// Context.Scheme and Context.Host are only honored once the proxies are set explicitly.
 func (engine *Engine) SetTrustedProxies(trustedProxies []string) error {
 	engine.trustedProxies = trustedProxies
+	engine.trustedExplicit = true
 	return engine.parseTrustedProxies()
 }
 
@@ -439,10 +799,12 @@ func (engine *Engine) parseTrustedProxies() error {
 
 // isTrustedProxy will check whether the IP address is included in the trusted list according to Engine.trustedCIDRs
 func (engine *Engine) isTrustedProxy(ip net.IP) bool {
-	if engine.trustedCIDRs == nil {
-		return false
-	}
-	for _, cidr := range engine.trustedCIDRs {
+	return containsIP(engine.trustedCIDRs, ip)
+}
+
+// containsIP reports whether ip is included in one of the networks.
+func containsIP(cidrs []*net.IPNet, ip net.IP) bool {
+	for _, cidr := range cidrs {
 		if cidr.Contains(ip) {
 			return true
 		}
@@ -450,8 +812,9 @@ func (engine *Engine) isTrustedProxy(ip net.IP) bool {
 	return false
 }
 
-// validateHeader will parse X-Forwarded-For header and return the trusted client IP address
-func (engine *Engine) validateHeader(header string) (clientIP string, valid bool) {
+// validateHeader will parse X-Forwarded-For header and return the client IP address,
+// trusting the proxies of the given networks
+func validateHeader(header string, trustedCIDRs []*net.IPNet) (clientIP string, valid bool) {
 	if header == "" {
 		return "", false
 	}
@@ -465,7 +828,7 @@ func (engine *Engine) validateHeader(header string) (clientIP string, valid bool
 
 		// X-Forwarded-For is appended by proxy
 		// Check IPs in reverse order and stop when find untrusted proxy
-		if (i == 0) || (!engine.isTrustedProxy(ip)) {
+		if (i == 0) || (!containsIP(trustedCIDRs, ip)) {
 			return ipStr, true
 		}
 	}
@@ -498,7 +861,7 @@ func (engine *Engine) RunTLS(addr, certFile, keyFile string) (err error) {
 			"Please check https://pkg.go.dev/github.com/gin-gonic/gin#readme-don-t-trust-all-proxies for details.")
 	}
 
-	err = http.ListenAndServeTLS(addr, certFile, keyFile, engine.Handler())
+	err = engine.newServer(addr).ListenAndServeTLS(certFile, keyFile)
 	return
 }
 
@@ -521,7 +884,7 @@ func (engine *Engine) RunUnix(file string) (err error) {
 	defer listener.Close()
 	defer os.Remove(file)
 
-	err = http.Serve(listener, engine.Handler())
+	err = engine.newServer("").Serve(listener)
 	return
 }
 
@@ -558,31 +921,34 @@ func (engine *Engine) RunListener(listener net.Listener) (err error) {
 			"Please check https://pkg.go.dev/github.com/gin-gonic/gin#readme-don-t-trust-all-proxies for details.")
 	}
 
-	err = http.Serve(listener, engine.Handler())
+	err = engine.newServer("").Serve(listener)
 	return
 }
 
 // ServeHTTP conforms to the http.Handler interface.
 func (engine *Engine) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	atomic.AddUint64(&engine.poolStats.gets, 1)
 	c := engine.pool.Get().(*Context)
 	c.writermem.reset(w)
 	c.Request = req
 	c.reset()
 
 	engine.handleHTTPRequest(c)
+	if len(c.pendingJobs) > 0 {
+		c.enqueuePendingJobs()
+	}
 
 	engine.pool.Put(c)
 }
 
 // HandleContext re-enters a context that has been rewritten.
 // This can be done by setting c.Request.URL.Path to your new target.
-// Disclaimer: You can loop yourself to deal with this, use wisely.
+// The params, keys and errors are reset for the new route, and restored on return, see
+// HandleContextWithOptions. Re-entering more than MaxHandleContextDepth times aborts the
+// request with ErrHandleContextDepth. To serve another route without rewriting the
+// request, see Context.Forward.
 func (engine *Engine) HandleContext(c *Context) {
-	oldIndexValue := c.index
-	c.reset()
-	engine.handleHTTPRequest(c)
-
-	c.index = oldIndexValue
+	engine.HandleContextWithOptions(c, ReentryOptions{}) // nolint: errcheck
 }
 
 func (engine *Engine) handleHTTPRequest(c *Context) {
@@ -595,24 +961,68 @@ func (engine *Engine) handleHTTPRequest(c *Context) {
 	}
 
 	if engine.RemoveExtraSlash {
-		rPath = cleanPath(rPath)
+		rPath = engine.cleanPath(rPath)
+	}
+
+	// CONNECT requests in authority-form have no path
+	if rPath == "" && httpMethod == http.MethodConnect {
+		rPath = "/"
+	}
+
+	if engine.EnableMatrixParams {
+		rPath, c.segmentParams = parseMatrixParams(rPath, unescape)
+	}
+
+	if len(engine.AllowedHosts) > 0 && engine.rejectHost(c) {
+		return
+	}
+
+	if engine.serveMaintenance(c, rPath) {
+		return
+	}
+
+	if engine.tenancy != nil {
+		var ok bool
+		if rPath, ok = engine.resolveTenant(c, rPath); !ok {
+			return
+		}
+	}
+
+	// the routes of the host groups are in trees of their own, see Engine.Host
+	routeMethod := httpMethod
+	if len(engine.hosts) > 0 {
+		routeMethod = engine.hostMethod(c, httpMethod)
+		c.hostMethod = routeMethod
+	}
+
+	if engine.EnableStaticFastPath && engine.serveStaticRoute(c, routeMethod, rPath) {
+		return
 	}
 
 	// Find root of the tree for the given HTTP method
 	t := engine.trees
 	for i, tl := 0, len(t); i < tl; i++ {
-		if t[i].method != httpMethod {
+		if t[i].method != routeMethod {
 			continue
 		}
 		root := t[i].root
 		// Find route in tree
 		value := root.getValue(rPath, c.params, c.skippedNodes, unescape)
+		if len(engine.constraints) > 0 {
+			value, c.constrained = engine.constrainValue(routeMethod, value, c.params, c.skippedNodes, unescape)
+		}
 		if value.params != nil {
 			c.Params = *value.params
 		}
 		if value.handlers != nil {
 			c.handlers = value.handlers
 			c.fullPath = value.fullPath
+			if c.inheritedParams != nil {
+				c.inheritParams()
+			}
+			if engine.ParamsInRequestContext && len(c.Params) > 0 {
+				c.Request = requestWithParams(c.Request, c.Params)
+			}
 			c.Next()
 			c.writermem.WriteHeaderNow()
 			return
@@ -630,17 +1040,27 @@ func (engine *Engine) handleHTTPRequest(c *Context) {
 	}
 
 	if engine.HandleMethodNotAllowed {
+		_, host := splitHostMethod(routeMethod)
 		for _, tree := range engine.trees {
-			if tree.method == httpMethod {
+			if tree.method == routeMethod {
+				continue
+			}
+			if _, treeHost := splitHostMethod(tree.method); treeHost != host {
 				continue
 			}
 			if value := tree.root.getValue(rPath, nil, c.skippedNodes, unescape); value.handlers != nil {
+				if engine.serveFallback(c, engine.noMethod) {
+					return
+				}
 				c.handlers = engine.allNoMethod
 				serveError(c, http.StatusMethodNotAllowed, default405Body)
 				return
 			}
 		}
 	}
+	if engine.serveFallback(c, engine.noRoute) {
+		return
+	}
 	c.handlers = engine.allNoRoute
 	serveError(c, http.StatusNotFound, default404Body)
 }
@@ -654,10 +1074,17 @@ func serveError(c *Context, code int, defaultMessage []byte) {
 		return
 	}
 	if c.writermem.Status() == code {
+		messageCode := MessageNotFound
+		if code == http.StatusMethodNotAllowed {
+			messageCode = MessageMethodNotAllowed
+		}
+		if c.writeMessage(code, messageCode, c.messageData(nil)) {
+			return
+		}
 		c.writermem.Header()["Content-Type"] = mimePlain
 		_, err := c.Writer.Write(defaultMessage)
 		if err != nil {
-			debugPrint("cannot write message to writer during serve error: %v", err)
+			debugPrintCategory(DebugRender, "cannot write message to writer during serve error: %v", err)
 		}
 		return
 	}
@@ -681,7 +1108,7 @@ func redirectFixedPath(c *Context, root *node, trailingSlash bool) bool {
 	req := c.Request
 	rPath := req.URL.Path
 
-	if fixedPath, ok := root.findCaseInsensitivePath(cleanPath(rPath), trailingSlash); ok {
+	if fixedPath, ok := root.findCaseInsensitivePath(c.engine.cleanPath(rPath), trailingSlash); ok {
 		req.URL.Path = bytesconv.BytesToString(fixedPath)
 		redirectRequest(c)
 		return true
@@ -693,12 +1120,24 @@ func redirectRequest(c *Context) {
 	req := c.Request
 	rPath := req.URL.Path
 	rURL := req.URL.String()
+	if c.isForwarded() {
+		// the request path is not the one of the client, build an absolute URL
+		rURL = c.BaseURL() + rURL
+	}
 
 	code := http.StatusMovedPermanently // Permanent redirect, request with GET method
 	if req.Method != http.MethodGet {
 		code = http.StatusTemporaryRedirect
 	}
-	debugPrint("redirecting request %d: %s --> %s", code, rPath, rURL)
-	http.Redirect(c.Writer, req, rURL, code)
+	debugPrintLazy(DebugTree, func() string {
+		return fmt.Sprintf("redirecting request %d: %s --> %s", code, rPath, rURL)
+	})
+	if c.engine.pathCleaner != nil {
+		// http.Redirect would clean the path again with the built-in semantics
+		c.Header("Location", rURL)
+		c.Writer.WriteHeader(code)
+	} else {
+		http.Redirect(c.Writer, req, rURL, code)
+	}
 	c.writermem.WriteHeaderNow()
 }
@@ -93,11 +93,31 @@ func HEAD(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
 	return engine().HEAD(relativePath, handlers...)
 }
 
+// CONNECT is a shortcut for router.Handle("CONNECT", path, handle)
+func CONNECT(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return engine().CONNECT(relativePath, handlers...)
+}
+
+// TRACE is a shortcut for router.Handle("TRACE", path, handle)
+func TRACE(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return engine().TRACE(relativePath, handlers...)
+}
+
 // Any is a wrapper for Engine.Any.
 func Any(relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
 	return engine().Any(relativePath, handlers...)
 }
 
+// AnyExcept is a wrapper for Engine.AnyExcept.
+func AnyExcept(methods []string, relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return engine().AnyExcept(methods, relativePath, handlers...)
+}
+
+// Match is a wrapper for Engine.Match.
+func Match(methods []string, relativePath string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return engine().Match(methods, relativePath, handlers...)
+}
+
 // StaticFile is a wrapper for Engine.StaticFile.
 func StaticFile(relativePath, filepath string) gin.IRoutes {
 	return engine().StaticFile(relativePath, filepath)
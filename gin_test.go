@@ -517,6 +517,97 @@ func TestListOfRoutes(t *testing.T) {
 	})
 }
 
+func TestEngineLookup(t *testing.T) {
+	router := New()
+	router.GET("/users/:id", handlerTest1)
+	router.WithMeta("public", true).GET("/about/", handlerTest2)
+
+	route, params, tsr := router.Lookup(http.MethodGet, "/users/42")
+	if assert.NotNil(t, route) {
+		assert.Equal(t, http.MethodGet, route.Method)
+		assert.Equal(t, "/users/:id", route.Path)
+		assert.Regexp(t, "^(.*/vendor/)?github.com/gin-gonic/gin.handlerTest1$", route.Handler)
+	}
+	assert.Equal(t, Params{{Key: "id", Value: "42"}}, params)
+	assert.False(t, tsr)
+
+	route, params, _ = router.Lookup(http.MethodGet, "/about/")
+	if assert.NotNil(t, route) {
+		assert.Equal(t, true, route.Meta["public"])
+	}
+	assert.Empty(t, params)
+
+	route, _, tsr = router.Lookup(http.MethodGet, "/about")
+	assert.Nil(t, route)
+	assert.True(t, tsr)
+
+	route, _, tsr = router.Lookup(http.MethodPost, "/users/42")
+	assert.Nil(t, route)
+	assert.False(t, tsr)
+
+	route, _, _ = router.Lookup(http.MethodGet, "//users//42")
+	assert.Nil(t, route)
+	router.RemoveExtraSlash = true
+	route, _, _ = router.Lookup(http.MethodGet, "//users//42")
+	assert.NotNil(t, route)
+}
+
+func TestEngineDuplicateRoutes(t *testing.T) {
+	register := func(policy DuplicateRoutePolicy) *Engine {
+		router := New()
+		router.DuplicateRoutes = policy
+		router.Use(func(c *Context) {
+			c.Set("runs", c.GetInt("runs")+1)
+		})
+		router.GET("/route", func(c *Context) {
+			c.Header("X-First", "true")
+		})
+		router.GET("/route", func(c *Context) {
+			c.String(http.StatusOK, "%s:%d", "second", c.GetInt("runs"))
+		})
+		return router
+	}
+
+	assert.PanicsWithValue(t, "handlers are already registered for path '/route'", func() {
+		register(DuplicateRoutePanic)
+	})
+
+	router := register(DuplicateRouteReplace)
+	w := PerformRequest(router, http.MethodGet, "/route")
+	assert.Equal(t, "second:1", w.Body.String())
+	assert.Empty(t, w.Header().Get("X-First"))
+	assert.Len(t, router.Routes(), 1)
+
+	router = register(DuplicateRouteAppend)
+	w = PerformRequest(router, http.MethodGet, "/route")
+	assert.Equal(t, "second:2", w.Body.String())
+	assert.Equal(t, "true", w.Header().Get("X-First"))
+
+	// the static fast path serves the new handlers as well
+	router = register(DuplicateRouteReplace)
+	router.EnableStaticFastPath = true
+	w = PerformRequest(router, http.MethodGet, "/route")
+	assert.Equal(t, "second:1", w.Body.String())
+}
+
+func TestEngineRouteNode(t *testing.T) {
+	router := New()
+	paths := []string{"/", "/users", "/users/", "/users/:id", "/users/:id/posts", "/users/new", "/files/*path", "/u"}
+	for _, path := range paths {
+		router.GET(path, func(c *Context) {})
+	}
+	for _, path := range paths {
+		n := router.routeNode(http.MethodGet, path)
+		if assert.NotNil(t, n, path) {
+			assert.Equal(t, path, n.fullPath)
+		}
+	}
+	for _, path := range []string{"/user", "/users/:name", "/users/42", "/files/", "/files/*other", "/users/:id/"} {
+		assert.Nil(t, router.routeNode(http.MethodGet, path), path)
+	}
+	assert.Nil(t, router.routeNode(http.MethodPost, "/users"))
+}
+
 func TestEngineHandleContext(t *testing.T) {
 	r := New()
 	r.GET("/", func(c *Context) {
@@ -571,6 +662,81 @@ func TestEngineHandleContextManyReEntries(t *testing.T) {
 	assert.Equal(t, int64(expectValue), middlewareCounter)
 }
 
+func TestEnginePreallocationCaps(t *testing.T) {
+	r := New()
+	r.ParamsStrategy = ParamsPreallocated
+	r.GET("/:a/:b/:c/:d", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.Param("a")+c.Param("b")+c.Param("c")+c.Param("d"))
+	})
+
+	c := r.allocateContext()
+	assert.Equal(t, 4, cap(*c.params))
+
+	r.MaxPreallocatedParams = 2
+	r.MaxPreallocatedSections = 1
+	c = r.allocateContext()
+	assert.Equal(t, 2, cap(*c.params))
+	assert.Equal(t, 1, cap(*c.skippedNodes))
+
+	// the params slice grows beyond the cap when needed
+	w := PerformRequest(r, "GET", "/1/2/3/4")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "1234", w.Body.String())
+}
+
+func TestEngineParamsStrategy(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, r := CreateTestContext(w)
+	assert.Equal(t, InlineParams, cap(*c.params))
+
+	// the context was allocated before the route with params was registered
+	r.GET("/:a/:b", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.Param("a")+c.Param("b"))
+	})
+	c.Request, _ = http.NewRequest(http.MethodGet, "/1/2", nil)
+	r.HandleContext(c)
+	assert.Equal(t, "12", w.Body.String())
+	assert.Same(t, &c.paramsArray[0], &(*c.params)[:1][0], "no allocation")
+
+	r.GET("/many/:a/:b/:c/:d/:e/:f/:g/:h/:i", func(c *Context) {})
+	c = r.allocateContext()
+	assert.Equal(t, 9, cap(*c.params), "more params than InlineParams")
+	r.MaxPreallocatedParams = InlineParams
+	c = r.allocateContext()
+	assert.Equal(t, InlineParams, cap(*c.params))
+	w = PerformRequest(r, http.MethodGet, "/many/1/2/3/4/5/6/7/8/9")
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestEngineHandleContextCopy(t *testing.T) {
+	r := New()
+	r.GET("/:name", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.Param("name"))
+	})
+
+	c, _ := CreateTestContext(httptest.NewRecorder())
+	w := httptest.NewRecorder()
+	cp := c.Copy()
+	cp.writermem.reset(w)
+	cp.Request, _ = http.NewRequest("GET", "/gopher", nil)
+	r.HandleContext(cp)
+	assert.Equal(t, "gopher", w.Body.String())
+}
+
+func TestEnginePoolStats(t *testing.T) {
+	r := New()
+	r.GET("/", func(c *Context) {})
+
+	for i := 0; i < 3; i++ {
+		PerformRequest(r, "GET", "/")
+	}
+	stats := r.PoolStats()
+	assert.Equal(t, uint64(3), stats.Gets)
+	assert.GreaterOrEqual(t, stats.Misses, uint64(1))
+	assert.Equal(t, stats.Gets-stats.Misses, stats.Hits())
+	assert.Equal(t, uint64(0), PoolStats{Gets: 1, Misses: 2}.Hits())
+}
+
 func TestPrepareTrustedCIRDsWith(t *testing.T) {
 	r := New()
 
@@ -0,0 +1,180 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+// Package gintest provides helpers for testing gin applications.
+package gintest
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// UpdateSnapshotsEnv is the environment variable which, when not empty, makes Snapshot
+// write the snapshot files instead of comparing the responses to them:
+//
+//	GIN_UPDATE_SNAPSHOTS=1 go test ./...
+const UpdateSnapshotsEnv = "GIN_UPDATE_SNAPSHOTS"
+
+// Redacted is the value replacing the redacted JSON fields.
+const Redacted = "<redacted>"
+
+var (
+	timestampPattern = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`)
+	uuidPattern      = regexp.MustCompile(`[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`)
+)
+
+type redaction struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+type snapshotConfig struct {
+	fields     map[string]bool
+	redactions []redaction
+}
+
+// SnapshotOption changes how Snapshot normalizes the responses.
+type SnapshotOption func(*snapshotConfig)
+
+// RedactFields replaces the values of the JSON fields named names, at any depth, with
+// Redacted.
+func RedactFields(names ...string) SnapshotOption {
+	return func(conf *snapshotConfig) {
+		for _, name := range names {
+			conf.fields[name] = true
+		}
+	}
+}
+
+// RedactPattern replaces the matches of pattern with replacement, in the JSON strings, or
+// in the whole body if it is not JSON.
+func RedactPattern(pattern *regexp.Regexp, replacement string) SnapshotOption {
+	return func(conf *snapshotConfig) {
+		conf.redactions = append(conf.redactions, redaction{pattern: pattern, replacement: replacement})
+	}
+}
+
+// RedactTimestamps replaces the RFC 3339 timestamps with "<timestamp>".
+func RedactTimestamps() SnapshotOption {
+	return RedactPattern(timestampPattern, "<timestamp>")
+}
+
+// RedactUUIDs replaces the UUIDs with "<uuid>".
+func RedactUUIDs() SnapshotOption {
+	return RedactPattern(uuidPattern, "<uuid>")
+}
+
+// Snapshot compares the body of the recorded response to the golden file filename, and
+// fails t if they differ. JSON bodies are indented, with their object keys sorted, so that
+// the snapshots are stable and diff well. The volatile values, e.g. timestamps and IDs,
+// are redacted with options. The file is written instead if it does not exist or if the
+// UpdateSnapshotsEnv environment variable is set.
+//
+//	w := httptest.NewRecorder()
+//	router.ServeHTTP(w, req)
+//	gintest.Snapshot(t, w, "testdata/user_show.json", gintest.RedactFields("id"), gintest.RedactTimestamps())
+func Snapshot(t testing.TB, w *httptest.ResponseRecorder, filename string, options ...SnapshotOption) {
+	t.Helper()
+	snapshot(t, w.Header().Get("Content-Type"), w.Body.Bytes(), filename, options)
+}
+
+// SnapshotResponse is like Snapshot for the responses of a http.Client, e.g. the one of
+// Engine.Client. The body of resp is read, and replaced so that it can be read again.
+func SnapshotResponse(t testing.TB, resp *http.Response, filename string, options ...SnapshotOption) {
+	t.Helper()
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		t.Fatalf("gintest: reading response body: %v", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	snapshot(t, resp.Header.Get("Content-Type"), body, filename, options)
+}
+
+func snapshot(t testing.TB, contentType string, body []byte, filename string, options []SnapshotOption) {
+	t.Helper()
+	conf := snapshotConfig{fields: make(map[string]bool)}
+	for _, option := range options {
+		option(&conf)
+	}
+	got, err := conf.normalize(contentType, body)
+	if err != nil {
+		t.Fatalf("gintest: normalizing response body: %v", err)
+	}
+
+	want, err := os.ReadFile(filename)
+	if os.IsNotExist(err) || os.Getenv(UpdateSnapshotsEnv) != "" {
+		if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+			t.Fatalf("gintest: %v", err)
+		}
+		if err := os.WriteFile(filename, got, 0o644); err != nil {
+			t.Fatalf("gintest: %v", err)
+		}
+		t.Logf("gintest: wrote snapshot %s", filename)
+		return
+	}
+	if err != nil {
+		t.Fatalf("gintest: %v", err)
+	}
+	assert.Equal(t, string(want), string(got), "response does not match snapshot %s, set %s to update it", filename, UpdateSnapshotsEnv)
+}
+
+// normalize returns the snapshot of body.
+func (conf *snapshotConfig) normalize(contentType string, body []byte) ([]byte, error) {
+	if !strings.Contains(contentType, "json") {
+		for _, r := range conf.redactions {
+			body = r.pattern.ReplaceAll(body, []byte(r.replacement))
+		}
+		return body, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var value any
+	if err := dec.Decode(&value); err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(conf.redact(value)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// redact returns the JSON value with its fields and strings redacted.
+func (conf *snapshotConfig) redact(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for k, v := range value {
+			if conf.fields[k] {
+				value[k] = Redacted
+			} else {
+				value[k] = conf.redact(v)
+			}
+		}
+	case []any:
+		for i, v := range value {
+			value[i] = conf.redact(v)
+		}
+	case string:
+		for _, r := range conf.redactions {
+			value = r.pattern.ReplaceAllString(value, r.replacement)
+		}
+		return value
+	}
+	return value
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gintest
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingT records the failures instead of failing the test.
+type recordingT struct {
+	testing.TB
+	failures int
+}
+
+func (t *recordingT) Errorf(format string, args ...any) {
+	t.failures++
+}
+
+func (t *recordingT) Fatalf(format string, args ...any) {
+	t.failures++
+}
+
+func newSnapshotRouter(name string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/user", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{
+			"name":       name,
+			"id":         "42",
+			"created_at": "2026-10-16T08:00:00Z",
+			"tags":       []gin.H{{"token": "6ba7b810-9dad-11d1-80b4-00c04fd430c8"}},
+		})
+	})
+	router.GET("/text", func(c *gin.Context) {
+		c.String(http.StatusOK, "served at 2026-10-16 08:00:00 to %s", name)
+	})
+	return router
+}
+
+func serve(router *gin.Engine, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+func TestSnapshot(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "testdata", "user.json")
+	options := []SnapshotOption{RedactFields("id"), RedactTimestamps(), RedactUUIDs()}
+
+	Snapshot(t, serve(newSnapshotRouter("gopher"), "/user"), filename, options...)
+	data, err := os.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, `{
+  "created_at": "<timestamp>",
+  "id": "<redacted>",
+  "name": "gopher",
+  "tags": [
+    {
+      "token": "<uuid>"
+    }
+  ]
+}
+`, string(data))
+
+	rt := &recordingT{TB: t}
+	Snapshot(rt, serve(newSnapshotRouter("gopher"), "/user"), filename, options...)
+	assert.Zero(t, rt.failures)
+
+	Snapshot(rt, serve(newSnapshotRouter("gordon"), "/user"), filename, options...)
+	assert.Equal(t, 1, rt.failures)
+
+	Snapshot(rt, serve(newSnapshotRouter("gopher"), "/user"), filename)
+	assert.Equal(t, 2, rt.failures)
+}
+
+func TestSnapshotUpdate(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "text.golden")
+	pattern := RedactPattern(regexp.MustCompile(`\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}`), "<time>")
+
+	Snapshot(t, serve(newSnapshotRouter("gopher"), "/text"), filename, pattern)
+	data, err := os.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "served at <time> to gopher", string(data))
+
+	t.Setenv(UpdateSnapshotsEnv, "1")
+	rt := &recordingT{TB: t}
+	Snapshot(rt, serve(newSnapshotRouter("gordon"), "/text"), filename, pattern)
+	assert.Zero(t, rt.failures)
+	data, err = os.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, "served at <time> to gordon", string(data))
+}
+
+func TestSnapshotResponse(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "user.json")
+	client := newSnapshotRouter("gopher").Client()
+
+	resp, err := client.Get("http://example.com/user")
+	assert.NoError(t, err)
+	SnapshotResponse(t, resp, filename, RedactFields("id", "created_at", "tags"))
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"name":"gopher"`)
+
+	data, err := os.ReadFile(filename)
+	assert.NoError(t, err)
+	assert.Equal(t, `{
+  "created_at": "<redacted>",
+  "id": "<redacted>",
+  "name": "gopher",
+  "tags": "<redacted>"
+}
+`, string(data))
+}
@@ -0,0 +1,126 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import "net/http"
+
+// HeaderPolicy declares headers to manage centrally, e.g. the Server header, cache-control
+// defaults or security headers, see ResponseHeaders.
+type HeaderPolicy struct {
+	// Set are the headers to always set, replacing any value.
+	Set map[string]string `yaml:"set,omitempty"`
+
+	// SetIfAbsent are the headers to set unless they already have a value.
+	SetIfAbsent map[string]string `yaml:"set_if_absent,omitempty"`
+
+	// Remove are the headers to remove.
+	Remove []string `yaml:"remove,omitempty"`
+}
+
+func (p HeaderPolicy) apply(header http.Header) {
+	applyHeaderPolicies(header, []HeaderPolicy{p})
+}
+
+type headerAction struct {
+	kind  uint8
+	value string
+}
+
+const (
+	headerRemove uint8 = iota
+	headerSet
+	headerSetIfAbsent
+)
+
+// applyHeaderPolicies applies the policies to header. The last policy mentioning a header,
+// that is the most specific one, decides what happens to it.
+func applyHeaderPolicies(header http.Header, policies []HeaderPolicy) {
+	actions := make(map[string]headerAction)
+	for _, p := range policies {
+		for _, key := range p.Remove {
+			actions[http.CanonicalHeaderKey(key)] = headerAction{kind: headerRemove}
+		}
+		for key, value := range p.SetIfAbsent {
+			actions[http.CanonicalHeaderKey(key)] = headerAction{kind: headerSetIfAbsent, value: value}
+		}
+		for key, value := range p.Set {
+			actions[http.CanonicalHeaderKey(key)] = headerAction{kind: headerSet, value: value}
+		}
+	}
+	for key, action := range actions {
+		switch action.kind {
+		case headerRemove:
+			header.Del(key)
+		case headerSet:
+			header.Set(key, action.value)
+		case headerSetIfAbsent:
+			if header.Get(key) == "" {
+				header.Set(key, action.value)
+			}
+		}
+	}
+}
+
+// headerPolicyWriter applies the header policies right before the header is written.
+type headerPolicyWriter struct {
+	ResponseWriter
+	policies []HeaderPolicy
+	applied  bool
+}
+
+func (w *headerPolicyWriter) applyPolicies() {
+	if !w.applied && !w.ResponseWriter.Written() {
+		w.applied = true
+		applyHeaderPolicies(w.Header(), w.policies)
+	}
+}
+
+func (w *headerPolicyWriter) WriteHeaderNow() {
+	w.applyPolicies()
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+func (w *headerPolicyWriter) Write(data []byte) (int, error) {
+	w.applyPolicies()
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *headerPolicyWriter) WriteString(s string) (int, error) {
+	w.applyPolicies()
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *headerPolicyWriter) Flush() {
+	w.applyPolicies()
+	w.ResponseWriter.Flush()
+}
+
+// ResponseHeaders returns a middleware applying the header policy to the responses once
+// the handlers are done with the headers, i.e. right before they are written. When used
+// by both the engine and a group, or by nested groups, the policies are combined and the
+// most specific one decides for the headers they both mention.
+//
+//	router.Use(gin.ResponseHeaders(gin.HeaderPolicy{
+//		Set:         map[string]string{"X-Content-Type-Options": "nosniff"},
+//		SetIfAbsent: map[string]string{"Cache-Control": "no-store"},
+//		Remove:      []string{"Server"},
+//	}))
+func ResponseHeaders(policy HeaderPolicy) HandlerFunc {
+	return func(c *Context) {
+		if w, ok := c.Writer.(*headerPolicyWriter); ok {
+			w.policies = append(w.policies[:len(w.policies):len(w.policies)], policy)
+			c.Next()
+			return
+		}
+
+		w := &headerPolicyWriter{ResponseWriter: c.Writer, policies: []HeaderPolicy{policy}}
+		c.Writer = w
+		defer func() {
+			c.Writer = w.ResponseWriter
+		}()
+		c.Next()
+		w.applyPolicies()
+	}
+}
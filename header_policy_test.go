@@ -0,0 +1,74 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseHeaders(t *testing.T) {
+	router := New()
+	router.Use(ResponseHeaders(HeaderPolicy{
+		Set:         map[string]string{"server": "gin", "X-Frame-Options": "DENY"},
+		SetIfAbsent: map[string]string{"Cache-Control": "no-store"},
+		Remove:      []string{"X-Powered-By"},
+	}))
+	router.GET("/", func(c *Context) {
+		c.Header("Server", "handler")
+		c.Header("X-Powered-By", "go")
+		c.String(http.StatusOK, "%s", "ok")
+	})
+	router.GET("/cached", func(c *Context) {
+		c.Header("Cache-Control", "max-age=60")
+		c.Status(http.StatusNoContent)
+	})
+	router.GET("/empty", func(c *Context) {})
+
+	w := PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, "gin", w.Header().Get("Server"))
+	assert.Equal(t, "DENY", w.Header().Get("X-Frame-Options"))
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	assert.Empty(t, w.Header().Get("X-Powered-By"))
+	assert.Equal(t, "ok", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/cached")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, "max-age=60", w.Header().Get("Cache-Control"))
+
+	// the policy applies even if the handlers don't write anything
+	w = PerformRequest(router, http.MethodGet, "/empty")
+	assert.Equal(t, "gin", w.Header().Get("Server"))
+}
+
+func TestResponseHeadersNested(t *testing.T) {
+	router := New()
+	router.Use(ResponseHeaders(HeaderPolicy{
+		Set:         map[string]string{"Server": "gin", "X-Frame-Options": "DENY"},
+		SetIfAbsent: map[string]string{"Cache-Control": "no-store"},
+	}))
+	static := router.Group("/static", ResponseHeaders(HeaderPolicy{
+		SetIfAbsent: map[string]string{"Cache-Control": "max-age=3600"},
+		Remove:      []string{"X-Frame-Options"},
+	}))
+	static.GET("/app.js", func(c *Context) {
+		c.String(http.StatusOK, "%s", "js")
+	})
+	router.GET("/api", func(c *Context) {
+		c.String(http.StatusOK, "%s", "api")
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/static/app.js")
+	assert.Equal(t, "gin", w.Header().Get("Server"))
+	assert.Equal(t, "max-age=3600", w.Header().Get("Cache-Control"))
+	assert.Empty(t, w.Header().Get("X-Frame-Options"))
+
+	// the group policy is not leaked to the other routes
+	w = PerformRequest(router, http.MethodGet, "/api")
+	assert.Equal(t, "no-store", w.Header().Get("Cache-Control"))
+	assert.Equal(t, "DENY", w.Header().Get("X-Frame-Options"))
+}
@@ -0,0 +1,38 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+
+	"golang.org/x/net/http/httpguts"
+)
+
+var (
+	default400Body = []byte("400 bad request")
+	default421Body = []byte("421 misdirected request")
+)
+
+// rejectHost answers the requests whose host is not one of Engine.AllowedHosts and reports
+// whether it did. The host checked is the one of the request, unless a proxy trusted
+// explicitly forwarded another, as a client could forge it otherwise.
+func (engine *Engine) rejectHost(c *Context) bool {
+	host := c.Request.Host
+	if forwarded := c.forwardedHost(); forwarded != "" {
+		host = forwarded
+	}
+	code, body := 0, []byte(nil)
+	switch {
+	case host == "" || !httpguts.ValidHostHeader(host):
+		code, body = http.StatusBadRequest, default400Body
+	case !hostAllowed(host, engine.AllowedHosts):
+		code, body = http.StatusMisdirectedRequest, default421Body
+	default:
+		return false
+	}
+	c.handlers = engine.Handlers
+	serveError(c, code, body)
+	return true
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowedHosts(t *testing.T) {
+	router := New()
+	router.AllowedHosts = []string{"example.com", "*.example.org", "localhost:8080"}
+	router.GET("/", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.Host())
+	})
+
+	for host, code := range map[string]int{
+		"example.com":       http.StatusOK,
+		"EXAMPLE.com:443":   http.StatusOK,
+		"api.example.org":   http.StatusOK,
+		"localhost:8080":    http.StatusOK,
+		"example.org":       http.StatusMisdirectedRequest,
+		"localhost":         http.StatusMisdirectedRequest,
+		"evil.com":          http.StatusMisdirectedRequest,
+		"127.0.0.1":         http.StatusMisdirectedRequest,
+		"example.com\\evil": http.StatusBadRequest,
+		"":                  http.StatusBadRequest,
+	} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Host = host
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, code, w.Code, host)
+	}
+}
+
+func TestAllowedHostsForwarded(t *testing.T) {
+	router := New()
+	router.AllowedHosts = []string{"example.com"}
+	assert.NoError(t, router.SetTrustedProxies([]string{"192.0.2.1"}))
+	router.GET("/", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.BaseURL())
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/", header{"X-Forwarded-Host", "example.com"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "http://example.com", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/", header{"X-Forwarded-Host", "evil.com"})
+	assert.Equal(t, http.StatusMisdirectedRequest, w.Code)
+	assert.Equal(t, "421 misdirected request", w.Body.String())
+}
+
+func TestAllowedHostsForgedForwardedHost(t *testing.T) {
+	router := New()
+	router.AllowedHosts = []string{"example.com"}
+	router.GET("/", func(*Context) {})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Host = "attacker.test"
+	req.Header.Set("X-Forwarded-Host", "example.com")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusMisdirectedRequest, w.Code, "the proxies trusted by default can not forward the host")
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net"
+	"sort"
+	"strings"
+)
+
+// hostRoutes are the routes of a host pattern, see Engine.Host. They are registered in
+// trees of their own, under the methods qualified by the pattern, e.g. "GET api.example.com",
+// which also key their metadata.
+type hostRoutes struct {
+	pattern string
+	methods map[string]string
+}
+
+// qualify returns method qualified by the host pattern, or method itself for the routes
+// registered outside of the host groups.
+func (h *hostRoutes) qualify(method string) string {
+	if h == nil {
+		return method
+	}
+	qualified, ok := h.methods[method]
+	if !ok {
+		qualified = method + " " + h.pattern
+		h.methods[method] = qualified
+	}
+	return qualified
+}
+
+func (h *hostRoutes) matches(hostname string) bool {
+	if strings.HasPrefix(h.pattern, "*.") {
+		suffix := h.pattern[1:]
+		return len(hostname) > len(suffix) && strings.HasSuffix(hostname, suffix)
+	}
+	return hostname == h.pattern
+}
+
+// splitHostMethod returns the method and the host pattern of a qualified method.
+func splitHostMethod(method string) (string, string) {
+	if i := strings.IndexByte(method, ' '); i >= 0 {
+		return method[:i], method[i+1:]
+	}
+	return method, ""
+}
+
+// treeMethod returns the method of the route qualified by its host pattern, if any.
+func (info RouteInfo) treeMethod() string {
+	if info.Host == "" {
+		return info.Method
+	}
+	return info.Method + " " + info.Host
+}
+
+// Host returns a group, with the global middleware, whose routes only match the requests
+// addressed to the hosts matching pattern, e.g. "api.example.com" or "*.example.com", as
+// returned by Context.Host, without its port. The requests to such a host are routed with
+// its routes only, and the other requests with the routes registered outside of the host
+// groups. The exact patterns are matched first, then the wildcards from the longest.
+//
+//	api := router.Host("api.example.com")
+//	api.GET("/users", listUsers)
+//	router.Host("*.example.com").GET("/", tenantHome)
+//	router.GET("/", home)
+func (engine *Engine) Host(pattern string) *RouterGroup {
+	pattern = strings.ToLower(pattern)
+	wildcard := strings.LastIndexByte(pattern, '*')
+	valid := pattern != "" && !strings.ContainsAny(pattern, " /:") &&
+		(wildcard < 0 || wildcard == 0 && len(pattern) > 2 && pattern[1] == '.')
+	assert1(valid, "host pattern '"+pattern+"' must be a hostname, optionally starting with '*.'")
+
+	var host *hostRoutes
+	for _, h := range engine.hosts {
+		if h.pattern == pattern {
+			host = h
+		}
+	}
+	if host == nil {
+		host = &hostRoutes{pattern: pattern, methods: make(map[string]string)}
+		engine.hosts = append(engine.hosts, host)
+		sort.SliceStable(engine.hosts, func(i, j int) bool {
+			a, b := engine.hosts[i].pattern, engine.hosts[j].pattern
+			if wa, wb := a[0] == '*', b[0] == '*'; wa != wb {
+				return wb
+			}
+			return len(a) > len(b)
+		})
+	}
+	group := engine.RouterGroup.derive("", nil)
+	group.host = host
+	return group
+}
+
+// hostMethod returns the method of the request qualified by the host pattern it matches,
+// if any, to select the trees routing it. The host is the one of the request, unless a
+// proxy trusted explicitly forwarded another, see Context.Host.
+func (engine *Engine) hostMethod(c *Context, method string) string {
+	hostname := c.Host()
+	if h, _, err := net.SplitHostPort(hostname); err == nil {
+		hostname = h
+	}
+	hostname = strings.ToLower(strings.TrimSuffix(hostname, "."))
+	for _, h := range engine.hosts {
+		if h.matches(hostname) {
+			if qualified, ok := h.methods[method]; ok {
+				return qualified
+			}
+			return method + " " + h.pattern
+		}
+	}
+	return method
+}
+
+// routeMethod returns the method keying the trees and the metadata of the route of the
+// request, see Engine.Host.
+func (c *Context) routeMethod() string {
+	if c.hostMethod != "" {
+		return c.hostMethod
+	}
+	return c.Request.Method
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func performHostRequest(r http.Handler, method, host, path string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, nil)
+	req.Host = host
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestEngineHost(t *testing.T) {
+	router := New()
+	router.Use(func(c *Context) {
+		c.Header("X-Global", "1")
+	})
+	reply := func(body string) HandlerFunc {
+		return func(c *Context) {
+			c.String(http.StatusOK, body+" "+c.FullPath())
+		}
+	}
+	router.GET("/", reply("home"))
+	router.GET("/users/:id", reply("users"))
+	api := router.Host("API.example.com")
+	api.GET("/users/:name", reply("api"))
+	api.Group("/v2").WithMeta("version", 2).GET("/users", func(c *Context) {
+		version, _ := c.RouteMeta("version")
+		c.JSON(http.StatusOK, version)
+	})
+	router.Host("*.example.com").GET("/", reply("tenant"))
+	router.Host("*.eu.example.com").GET("/", reply("eu"))
+
+	for _, tt := range []struct {
+		host, path, body string
+	}{
+		{"example.com", "/", "home /"},
+		{"example.com", "/users/1", "users /users/:id"},
+		{"api.example.com", "/users/gin", "api /users/:name"},
+		{"API.Example.com:8080", "/users/gin", "api /users/:name"},
+		{"api.example.com", "/v2/users", "2"},
+		{"acme.example.com", "/", "tenant /"},
+		{"acme.eu.example.com", "/", "eu /"},
+		{"localhost", "/", "home /"},
+	} {
+		w := performHostRequest(router, http.MethodGet, tt.host, tt.path)
+		assert.Equal(t, http.StatusOK, w.Code, tt.host+tt.path)
+		assert.Equal(t, tt.body, w.Body.String(), tt.host+tt.path)
+		assert.Equal(t, "1", w.Header().Get("X-Global"), "global middleware")
+	}
+
+	w := performHostRequest(router, http.MethodGet, "api.example.com", "/")
+	assert.Equal(t, http.StatusNotFound, w.Code, "the requests to a host are routed with its routes only")
+	w = performHostRequest(router, http.MethodGet, "example.com", "/v2/users")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	w = performHostRequest(router, http.MethodPost, "api.example.com", "/users/gin")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	router.HandleMethodNotAllowed = true
+	w = performHostRequest(router, http.MethodPost, "api.example.com", "/users/gin")
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	w = performHostRequest(router, http.MethodPost, "acme.example.com", "/users/1")
+	assert.Equal(t, http.StatusNotFound, w.Code, "the routes of the other hosts are not allowed")
+}
+
+func TestEngineHostRoutes(t *testing.T) {
+	router := New()
+	router.GET("/users", func(*Context) {})
+	router.Host("api.example.com").WithMeta("api", true).GET("/users", func(*Context) {})
+	assert.Same(t, router.Host("api.example.com").host, router.Host("API.EXAMPLE.COM").host)
+
+	routes := router.Routes()
+	assert.Len(t, routes, 2)
+	assert.Equal(t, RouteInfo{Method: http.MethodGet, Path: "/users"}, RouteInfo{Method: routes[0].Method, Host: routes[0].Host, Path: routes[0].Path})
+	assert.Equal(t, http.MethodGet, routes[1].Method)
+	assert.Equal(t, "api.example.com", routes[1].Host)
+	assert.Equal(t, map[string]any{"api": true}, routes[1].Meta)
+
+	bundle := NewRoutes()
+	bundle.GET("/users", func(*Context) {})
+	assert.NoError(t, router.Host("admin.example.com").Attach("/", bundle))
+	assert.Error(t, router.Host("api.example.com").Attach("/", bundle))
+	w := performHostRequest(router, http.MethodGet, "admin.example.com", "/users")
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestEngineHostAttach(t *testing.T) {
+	router := New()
+	bundle := NewRoutes()
+	bundle.Use(func(c *Context) {
+		c.Header("X-Bundle", "1")
+	})
+	bundle.WithMeta("scope", "admin").GET("/users/:id", func(c *Context) {
+		scope, _ := c.RouteMeta("scope")
+		c.String(http.StatusOK, "%s %s %v", c.FullPath(), c.Param("id"), scope)
+	})
+	admin := router.Host("admin.example.com")
+	assert.NoError(t, admin.Attach("/v1", bundle))
+
+	routes := router.Routes()
+	assert.Len(t, routes, 1)
+	assert.Equal(t, "admin.example.com", routes[0].Host)
+	assert.Equal(t, "/v1/users/:id", routes[0].Path)
+
+	w := performHostRequest(router, http.MethodGet, "admin.example.com", "/v1/users/42")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "/v1/users/:id 42 admin", w.Body.String())
+	assert.Equal(t, "1", w.Header().Get("X-Bundle"))
+	w = performHostRequest(router, http.MethodGet, "example.com", "/v1/users/42")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	var conflict *RouteConflictError
+	assert.ErrorAs(t, admin.Attach("/v1", bundle), &conflict)
+	assert.Len(t, conflict.Conflicts, 1)
+}
+
+func TestEngineHostForwarded(t *testing.T) {
+	router := New()
+	router.Host("admin.internal").GET("/", func(c *Context) {
+		c.String(http.StatusOK, "admin")
+	})
+	router.GET("/", func(c *Context) {
+		c.String(http.StatusOK, "public")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	req.Host = "example.com"
+	req.Header.Set("X-Forwarded-Host", "admin.internal")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, "public", w.Body.String(), "the proxies trusted by default can not forward the host")
+
+	assert.NoError(t, router.SetTrustedProxies([]string{"192.0.2.1"}))
+	req.RemoteAddr = "192.0.2.1:1234"
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, "admin", w.Body.String())
+}
+
+func TestEngineHostFallThrough(t *testing.T) {
+	router := New()
+	router.EnableStaticFastPath = true
+	api := router.Host("api.example.com")
+	api.GET("/docs/*page", func(c *Context) {
+		c.FallThrough()
+	})
+	api.GET("/:section/index", func(c *Context) {
+		c.String(http.StatusOK, "index "+c.Param("section"))
+	})
+	api.GET("/status", func(c *Context) {
+		c.String(http.StatusOK, "api")
+	})
+	router.GET("/status", func(c *Context) {
+		c.String(http.StatusOK, "default")
+	})
+
+	w := performHostRequest(router, http.MethodGet, "api.example.com", "/docs/index")
+	assert.Equal(t, "index docs", w.Body.String())
+	w = performHostRequest(router, http.MethodGet, "api.example.com", "/status")
+	assert.Equal(t, "api", w.Body.String())
+	w = performHostRequest(router, http.MethodGet, "example.com", "/status")
+	assert.Equal(t, "default", w.Body.String())
+}
+
+func TestEngineHostInvalidPattern(t *testing.T) {
+	router := New()
+	for _, pattern := range []string{"", "example.com/api", "*", "*example.com", "api.*.com", "example.com:8080"} {
+		assert.Panics(t, func() { router.Host(pattern) }, pattern)
+	}
+}
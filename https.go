@@ -0,0 +1,120 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HSTSConfig defines the Strict-Transport-Security header sent by RequireHTTPS.
+type HSTSConfig struct {
+	// MaxAge is the time the clients only use HTTPS to reach the host. Optional. Default
+	// value is one year.
+	MaxAge time.Duration
+
+	// IncludeSubDomains applies the policy to all the subdomains of the host. Optional.
+	IncludeSubDomains bool
+
+	// Preload allows the host to be included in the browsers HSTS preload lists. Optional.
+	Preload bool
+}
+
+// String returns the value of the Strict-Transport-Security header.
+func (conf HSTSConfig) String() string {
+	maxAge := conf.MaxAge
+	if maxAge == 0 {
+		maxAge = 365 * 24 * time.Hour
+	}
+	value := "max-age=" + strconv.FormatInt(int64(maxAge/time.Second), 10)
+	if conf.IncludeSubDomains {
+		value += "; includeSubDomains"
+	}
+	if conf.Preload {
+		value += "; preload"
+	}
+	return value
+}
+
+// HTTPSConfig defines the config for RequireHTTPS middleware.
+type HTTPSConfig struct {
+	// RedirectCode is the status code of the redirects to HTTPS. Optional. Default value is
+	// 301 for GET and HEAD requests and 308 for the others, so that the method and body are
+	// preserved.
+	RedirectCode int
+
+	// Reject aborts the plaintext requests with 403 instead of redirecting them. Optional.
+	Reject bool
+
+	// HSTS is the Strict-Transport-Security policy sent with the HTTPS responses. Optional.
+	// No header is sent when nil.
+	HSTS *HSTSConfig
+
+	// ExcludePaths are the paths, or path prefixes when ending with a '/', allowed over
+	// plaintext HTTP, e.g. the health checks of a load balancer. Optional.
+	ExcludePaths []string
+}
+
+func (conf *HTTPSConfig) excluded(path string) bool {
+	for _, excluded := range conf.ExcludePaths {
+		if path == excluded || (strings.HasSuffix(excluded, "/") && strings.HasPrefix(path, excluded)) {
+			return true
+		}
+	}
+	return false
+}
+
+// httpsHost returns the host to redirect to, without the default HTTP port.
+func httpsHost(host string) string {
+	if h, port, err := net.SplitHostPort(host); err == nil && port == "80" {
+		if strings.Contains(h, ":") {
+			return "[" + h + "]"
+		}
+		return h
+	}
+	return host
+}
+
+// RequireHTTPS returns a middleware redirecting, or rejecting, the plaintext requests to
+// HTTPS. The scheme is resolved with Context.Scheme, so the requests forwarded by a proxy
+// terminating TLS, trusted with Engine.SetTrustedProxies, are handled consistently.
+func RequireHTTPS(conf HTTPSConfig) HandlerFunc {
+	if conf.RedirectCode != 0 && (conf.RedirectCode < http.StatusMultipleChoices || conf.RedirectCode > http.StatusPermanentRedirect) {
+		panic("invalid redirect code " + strconv.Itoa(conf.RedirectCode))
+	}
+	var hsts string
+	if conf.HSTS != nil {
+		hsts = conf.HSTS.String()
+	}
+
+	return func(c *Context) {
+		if c.Scheme() == "https" {
+			if hsts != "" {
+				c.Header("Strict-Transport-Security", hsts)
+			}
+			return
+		}
+		if conf.excluded(c.Request.URL.Path) {
+			return
+		}
+		if conf.Reject {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+
+		code := conf.RedirectCode
+		if code == 0 {
+			code = http.StatusMovedPermanently
+			if c.Request.Method != http.MethodGet && c.Request.Method != http.MethodHead {
+				code = http.StatusPermanentRedirect
+			}
+		}
+		http.Redirect(c.Writer, c.Request, "https://"+httpsHost(c.Host())+c.Request.URL.RequestURI(), code)
+		c.Abort()
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newHTTPSRouter(conf HTTPSConfig) *Engine {
+	router := New()
+	router.Use(RequireHTTPS(conf))
+	router.Any("/*path", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.Scheme())
+	})
+	return router
+}
+
+func TestRequireHTTPSRedirect(t *testing.T) {
+	router := newHTTPSRouter(HTTPSConfig{})
+
+	w := PerformRequest(router, http.MethodGet, "http://example.com:80/path?q=1")
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "https://example.com/path?q=1", w.Header().Get("Location"))
+
+	w = PerformRequest(router, http.MethodPost, "http://example.com:8080/path")
+	assert.Equal(t, http.StatusPermanentRedirect, w.Code)
+	assert.Equal(t, "https://example.com:8080/path", w.Header().Get("Location"))
+
+	router = newHTTPSRouter(HTTPSConfig{RedirectCode: http.StatusFound})
+	w = PerformRequest(router, http.MethodGet, "http://example.com/path")
+	assert.Equal(t, http.StatusFound, w.Code)
+
+	assert.Panics(t, func() { RequireHTTPS(HTTPSConfig{RedirectCode: http.StatusOK}) })
+}
+
+func TestRequireHTTPSReject(t *testing.T) {
+	router := newHTTPSRouter(HTTPSConfig{Reject: true, ExcludePaths: []string{"/healthz", "/.well-known/"}})
+
+	w := PerformRequest(router, http.MethodGet, "/path")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/healthz")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/.well-known/acme-challenge/token")
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/healthz/more")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestRequireHTTPSForwarded(t *testing.T) {
+	router := newHTTPSRouter(HTTPSConfig{HSTS: &HSTSConfig{IncludeSubDomains: true, Preload: true}})
+	assert.NoError(t, router.SetTrustedProxies([]string{"192.0.2.1"}))
+
+	w := PerformRequest(router, http.MethodGet, "/path", header{"X-Forwarded-Proto", "https"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "https", w.Body.String())
+	assert.Equal(t, "max-age=31536000; includeSubDomains; preload", w.Header().Get("Strict-Transport-Security"))
+
+	w = PerformRequest(router, http.MethodGet, "/path", header{"X-Forwarded-Proto", "http"}, header{"X-Forwarded-Host", "example.com"})
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "https://example.com/path", w.Header().Get("Location"))
+	assert.Empty(t, w.Header().Get("Strict-Transport-Security"))
+
+	// untrusted clients can not claim HTTPS
+	assert.NoError(t, router.SetTrustedProxies(nil))
+	w = PerformRequest(router, http.MethodGet, "/path", header{"X-Forwarded-Proto", "https"})
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+}
+
+func TestHSTSConfigString(t *testing.T) {
+	assert.Equal(t, "max-age=31536000", HSTSConfig{}.String())
+	assert.Equal(t, "max-age=60; includeSubDomains", HSTSConfig{MaxAge: time.Minute, IncludeSubDomains: true}.String())
+}
@@ -0,0 +1,101 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+// Package bufpool provides the byte buffers shared by the renders and the bindings,
+// recycled by size classes so that the large buffers are not handed out for the small
+// payloads, and the huge ones are left to the garbage collector.
+package bufpool
+
+import (
+	"bytes"
+	"sync"
+	"sync/atomic"
+)
+
+// classSizes are the minimum capacities of the buffers of each class.
+var classSizes = [...]int{1 << 10, 8 << 10, 64 << 10, 512 << 10}
+
+// MaxSize is the capacity above which the buffers are not recycled.
+const MaxSize = 4 << 20
+
+type class struct {
+	pool    sync.Pool
+	gets    uint64
+	news    uint64
+	puts    uint64
+	dropped uint64
+}
+
+var classes [len(classSizes)]class
+
+func init() {
+	for i := range classes {
+		size := classSizes[i]
+		c := &classes[i]
+		c.pool.New = func() any {
+			atomic.AddUint64(&c.news, 1)
+			return bytes.NewBuffer(make([]byte, 0, size))
+		}
+	}
+}
+
+// Get returns an empty buffer, with a capacity of at least sizeHint bytes when sizeHint
+// does not exceed the largest class. It must be returned with Put once its content is no
+// longer used.
+func Get(sizeHint int) *bytes.Buffer {
+	i := 0
+	for i < len(classSizes)-1 && classSizes[i] < sizeHint {
+		i++
+	}
+	c := &classes[i]
+	atomic.AddUint64(&c.gets, 1)
+	return c.pool.Get().(*bytes.Buffer)
+}
+
+// Put returns buf to the class of its capacity, or drops it if it is larger than MaxSize.
+func Put(buf *bytes.Buffer) {
+	size := buf.Cap()
+	i := len(classSizes) - 1
+	for i > 0 && classSizes[i] > size {
+		i--
+	}
+	c := &classes[i]
+	if size > MaxSize || size < classSizes[0] {
+		atomic.AddUint64(&c.dropped, 1)
+		return
+	}
+	buf.Reset()
+	atomic.AddUint64(&c.puts, 1)
+	c.pool.Put(buf)
+}
+
+// ClassStats are the counters of a size class.
+type ClassStats struct {
+	// Size is the minimum capacity of the buffers of the class.
+	Size int
+	// Gets is the number of buffers taken from the class.
+	Gets uint64
+	// News is the number of buffers allocated because the class was empty.
+	News uint64
+	// Puts is the number of buffers returned to the class.
+	Puts uint64
+	// Dropped is the number of buffers of the class not recycled, being too large.
+	Dropped uint64
+}
+
+// Stats returns the counters of the size classes, from the smallest.
+func Stats() []ClassStats {
+	stats := make([]ClassStats, len(classes))
+	for i := range classes {
+		c := &classes[i]
+		stats[i] = ClassStats{
+			Size:    classSizes[i],
+			Gets:    atomic.LoadUint64(&c.gets),
+			News:    atomic.LoadUint64(&c.news),
+			Puts:    atomic.LoadUint64(&c.puts),
+			Dropped: atomic.LoadUint64(&c.dropped),
+		}
+	}
+	return stats
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package bufpool
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGet(t *testing.T) {
+	before := Stats()
+
+	buf := Get(0)
+	assert.Zero(t, buf.Len())
+	assert.GreaterOrEqual(t, buf.Cap(), 1<<10)
+	buf.WriteString("data")
+	Put(buf)
+
+	buf = Get(100 << 10)
+	assert.GreaterOrEqual(t, buf.Cap(), 100<<10)
+	Put(buf)
+
+	buf = Get(10 << 20)
+	assert.GreaterOrEqual(t, buf.Cap(), 512<<10)
+	Put(buf)
+
+	after := Stats()
+	assert.Equal(t, before[0].Gets+1, after[0].Gets)
+	assert.Equal(t, before[0].Puts+1, after[0].Puts)
+	assert.Equal(t, before[3].Gets+2, after[3].Gets)
+	assert.Equal(t, before[3].Puts+2, after[3].Puts)
+	assert.Equal(t, before[2].Gets, after[2].Gets)
+}
+
+func TestPut(t *testing.T) {
+	before := Stats()
+
+	Put(bytes.NewBuffer(make([]byte, 0, 16)))
+	Put(bytes.NewBuffer(make([]byte, 0, MaxSize+1)))
+	buf := bytes.NewBuffer(make([]byte, 0, 10<<10))
+	buf.WriteString("stale")
+	Put(buf)
+
+	after := Stats()
+	assert.Equal(t, before[0].Dropped+1, after[0].Dropped)
+	assert.Equal(t, before[3].Dropped+1, after[3].Dropped)
+	assert.Equal(t, before[1].Puts+1, after[1].Puts)
+	assert.Zero(t, buf.Len(), "buffers are reset")
+	assert.Equal(t, []int{1 << 10, 8 << 10, 64 << 10, 512 << 10}, []int{after[0].Size, after[1].Size, after[2].Size, after[3].Size})
+}
+
+func BenchmarkGetPut(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf := Get(0)
+		buf.WriteString("benchmark")
+		Put(buf)
+	}
+}
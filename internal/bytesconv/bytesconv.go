@@ -2,6 +2,12 @@
 // Use of this source code is governed by a MIT style
 // license that can be found in the LICENSE file.
 
+//go:build !nounsafe && !appengine
+// +build !nounsafe,!appengine
+
+// Package bytesconv converts between strings and byte slices. The conversions share the
+// memory of their argument, unless built with the nounsafe or appengine tags for the
+// environments prohibiting the unsafe package, where they copy it.
 package bytesconv
 
 import (
@@ -0,0 +1,18 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+//go:build nounsafe || appengine
+// +build nounsafe appengine
+
+package bytesconv
+
+// StringToBytes converts string to byte slice, copying it.
+func StringToBytes(s string) []byte {
+	return []byte(s)
+}
+
+// BytesToString converts byte slice to string, copying it.
+func BytesToString(b []byte) string {
+	return string(b)
+}
@@ -0,0 +1,25 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+//go:build nounsafe || appengine
+// +build nounsafe appengine
+
+package bytesconv
+
+import "testing"
+
+func TestSafeConversionsCopy(t *testing.T) {
+	b := []byte("gin")
+	s := BytesToString(b)
+	b[0] = 'p'
+	if s != "gin" {
+		t.Fatalf("BytesToString shares the memory of its argument: %q", s)
+	}
+
+	b = StringToBytes(s)
+	b[0] = 'p'
+	if s != "gin" {
+		t.Fatalf("StringToBytes shares the memory of its argument: %q", s)
+	}
+}
@@ -0,0 +1,376 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrJobQueueFull is reported when a job is enqueued on a full queue.
+	ErrJobQueueFull = errors.New("gin: job queue is full")
+	// ErrJobQueueClosed is reported when a job is enqueued on a closed queue.
+	ErrJobQueueClosed = errors.New("gin: job queue is closed")
+)
+
+// Job is a unit of work run by a JobQueue. ctx is canceled when the queue is closed and
+// its deadline passed. A job returning an error is retried, up to JobQueueConfig.MaxRetries
+// times.
+type Job func(ctx context.Context) error
+
+// JobPanicError is the error of a job that panicked.
+type JobPanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *JobPanicError) Error() string {
+	return fmt.Sprintf("gin: job panicked: %v", e.Value)
+}
+
+// JobQueueConfig defines the config for NewJobQueue.
+type JobQueueConfig struct {
+	// Workers is the number of jobs run concurrently. Optional. Default value is 1.
+	Workers int
+
+	// QueueSize is the number of jobs waiting for a worker, above which jobs are dropped
+	// with ErrJobQueueFull. Optional. Default value is 100.
+	QueueSize int
+
+	// MaxRetries is the number of times a failed job is retried. Optional.
+	MaxRetries int
+
+	// Backoff returns the delay before the retry of a job that failed attempt times.
+	// Optional. Default value doubles from 100 milliseconds up to 30 seconds.
+	Backoff func(attempt int) time.Duration
+
+	// OnError is called with the jobs that are dropped, and with the errors of the jobs
+	// that failed for good. Optional. By default, they are logged in debug mode.
+	OnError func(queue string, err error)
+}
+
+// JobQueueStats are the counters of a JobQueue.
+type JobQueueStats struct {
+	// Queued is the number of jobs waiting for a worker, or for their retry.
+	Queued int
+	// Running is the number of jobs being run.
+	Running int
+	// Succeeded is the number of jobs that succeeded.
+	Succeeded uint64
+	// Retried is the number of retries.
+	Retried uint64
+	// Failed is the number of jobs that failed for good.
+	Failed uint64
+	// Panicked is the number of runs that panicked, counted as failures.
+	Panicked uint64
+	// Dropped is the number of jobs dropped because the queue was full or closed.
+	Dropped uint64
+}
+
+// queuedJob is a job waiting in a JobQueue.
+type queuedJob struct {
+	job      Job
+	attempts int
+}
+
+// JobQueue runs jobs in the background, e.g. the emails to send once the response is
+// written, see Context.Enqueue, with a bounded number of workers, retries with backoff,
+// and panic isolation: a panicking job fails without crashing the process.
+type JobQueue struct {
+	name   string
+	config JobQueueConfig
+	jobs   chan queuedJob
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu      sync.Mutex
+	stats   JobQueueStats
+	closed  bool
+	pending sync.WaitGroup
+	workers sync.WaitGroup
+	retries sync.WaitGroup
+}
+
+// NewJobQueue returns a JobQueue named name, whose workers run until Close is called.
+func NewJobQueue(name string, config JobQueueConfig) *JobQueue {
+	if config.Workers <= 0 {
+		config.Workers = 1
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = 100
+	}
+	if config.Backoff == nil {
+		config.Backoff = defaultJobBackoff
+	}
+	if config.OnError == nil {
+		config.OnError = func(queue string, err error) {
+			debugPrint("[WARNING] Job of queue %s failed: %v\n", queue, err)
+		}
+	}
+	q := &JobQueue{name: name, config: config, jobs: make(chan queuedJob, config.QueueSize)}
+	q.ctx, q.cancel = context.WithCancel(context.Background())
+	q.workers.Add(config.Workers)
+	for i := 0; i < config.Workers; i++ {
+		go q.work()
+	}
+	return q
+}
+
+func defaultJobBackoff(attempt int) time.Duration {
+	backoff := 100 * time.Millisecond
+	for i := 1; i < attempt && backoff < 30*time.Second; i++ {
+		backoff *= 2
+	}
+	if backoff > 30*time.Second {
+		backoff = 30 * time.Second
+	}
+	return backoff
+}
+
+// Name returns the name of the queue.
+func (q *JobQueue) Name() string {
+	return q.name
+}
+
+// Enqueue queues job. It returns ErrJobQueueFull if the queue is full, and
+// ErrJobQueueClosed once the queue is closed.
+func (q *JobQueue) Enqueue(job Job) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		q.stats.Dropped++
+		return ErrJobQueueClosed
+	}
+	select {
+	case q.jobs <- queuedJob{job: job}:
+		q.stats.Queued++
+		q.pending.Add(1)
+		return nil
+	default:
+		q.stats.Dropped++
+		return ErrJobQueueFull
+	}
+}
+
+// Stats returns the counters of the queue.
+func (q *JobQueue) Stats() JobQueueStats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.stats
+}
+
+// Close stops accepting jobs and waits for the queued ones, including their retries, to
+// complete. Once ctx is done, the context of the running jobs is canceled, the jobs not
+// started yet are dropped, and ctx.Err() is returned.
+func (q *JobQueue) Close(ctx context.Context) error {
+	q.mu.Lock()
+	q.closed = true
+	q.mu.Unlock()
+
+	done := make(chan struct{})
+	go func() {
+		q.pending.Wait()
+		close(done)
+	}()
+	var err error
+	select {
+	case <-done:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	q.cancel()
+	q.workers.Wait()
+	// a retry may still queue its job, drained below
+	q.retries.Wait()
+	for {
+		select {
+		case <-q.jobs:
+			q.drop()
+		default:
+			return err
+		}
+	}
+}
+
+func (q *JobQueue) work() {
+	defer q.workers.Done()
+	for {
+		select {
+		case queued := <-q.jobs:
+			q.run(queued)
+		case <-q.ctx.Done():
+			return
+		}
+	}
+}
+
+// run runs a job, and schedules its retry if it fails.
+func (q *JobQueue) run(queued queuedJob) {
+	if q.ctx.Err() != nil {
+		q.drop()
+		return
+	}
+	q.mu.Lock()
+	q.stats.Queued--
+	q.stats.Running++
+	q.mu.Unlock()
+
+	err := q.call(queued.job)
+	queued.attempts++
+
+	q.mu.Lock()
+	q.stats.Running--
+	var panicErr *JobPanicError
+	if errors.As(err, &panicErr) {
+		q.stats.Panicked++
+	}
+	switch {
+	case err == nil:
+		q.stats.Succeeded++
+	case queued.attempts <= q.config.MaxRetries && q.ctx.Err() == nil:
+		q.stats.Retried++
+		q.stats.Queued++
+		q.mu.Unlock()
+		q.retries.Add(1)
+		go q.retry(queued)
+		return
+	default:
+		q.stats.Failed++
+	}
+	q.mu.Unlock()
+	q.pending.Done()
+
+	if err != nil {
+		q.config.OnError(q.name, err)
+	}
+}
+
+// retry queues the job again once its backoff elapsed.
+func (q *JobQueue) retry(queued queuedJob) {
+	defer q.retries.Done()
+	timer := time.NewTimer(q.config.Backoff(queued.attempts))
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+	case <-q.ctx.Done():
+		q.drop()
+		return
+	}
+	select {
+	case q.jobs <- queued:
+	case <-q.ctx.Done():
+		q.drop()
+	}
+}
+
+// drop accounts a queued job that will not run, the queue being closed.
+func (q *JobQueue) drop() {
+	q.mu.Lock()
+	q.stats.Queued--
+	q.stats.Dropped++
+	q.mu.Unlock()
+	q.pending.Done()
+}
+
+// call runs job, turning its panic into a JobPanicError.
+func (q *JobQueue) call(job Job) (err error) {
+	defer func() {
+		if value := recover(); value != nil {
+			err = &JobPanicError{Value: value, Stack: debug.Stack()}
+		}
+	}()
+	return job(q.ctx)
+}
+
+// AddJobQueue registers a job queue, named after its name, for Context.Enqueue.
+func (engine *Engine) AddJobQueue(q *JobQueue) {
+	assert1(q != nil, "job queue can not be nil")
+	engine.jobQueuesMu.Lock()
+	defer engine.jobQueuesMu.Unlock()
+	if engine.jobQueues == nil {
+		engine.jobQueues = make(map[string]*JobQueue)
+	}
+	_, exists := engine.jobQueues[q.name]
+	assert1(!exists, "job queue "+q.name+" is already registered")
+	engine.jobQueues[q.name] = q
+}
+
+// JobQueue returns the job queue registered with name, or nil.
+func (engine *Engine) JobQueue(name string) *JobQueue {
+	engine.jobQueuesMu.Lock()
+	defer engine.jobQueuesMu.Unlock()
+	return engine.jobQueues[name]
+}
+
+// CloseJobQueues closes the registered job queues, waiting for their jobs to complete
+// until ctx is done, see JobQueue.Close. Call it after http.Server.Shutdown, so that the
+// jobs of the last requests are run.
+func (engine *Engine) CloseJobQueues(ctx context.Context) error {
+	engine.jobQueuesMu.Lock()
+	queues := make([]*JobQueue, 0, len(engine.jobQueues))
+	for _, q := range engine.jobQueues {
+		queues = append(queues, q)
+	}
+	engine.jobQueuesMu.Unlock()
+
+	errs := make(chan error, len(queues))
+	for _, q := range queues {
+		go func(q *JobQueue) {
+			errs <- q.Close(ctx)
+		}(q)
+	}
+	var err error
+	for range queues {
+		if e := <-errs; e != nil {
+			err = e
+		}
+	}
+	return err
+}
+
+// pendingJob is a job enqueued by a handler, queued once the response is written.
+type pendingJob struct {
+	queue *JobQueue
+	job   Job
+}
+
+// Enqueue queues job on the job queue registered with name once the response is written,
+// so that the client does not wait for the work it does not need the result of, e.g.
+// sending an email. On a copy of the context, see Context.Copy, whose response is out of
+// its scope, the job is queued immediately. It panics if no queue is registered with
+// name. Jobs that can not be queued are reported to JobQueueConfig.OnError.
+//
+//	c.Enqueue("emails", func(ctx context.Context) error {
+//		return mailer.Send(ctx, welcome)
+//	})
+func (c *Context) Enqueue(name string, job Job) {
+	q := c.engine.JobQueue(name)
+	if q == nil {
+		panic("gin: no job queue registered with name " + name)
+	}
+	if c.copied {
+		if err := q.Enqueue(job); err != nil {
+			q.config.OnError(q.name, err)
+		}
+		return
+	}
+	c.pendingJobs = append(c.pendingJobs, pendingJob{queue: q, job: job})
+}
+
+// enqueuePendingJobs queues the jobs enqueued while serving c.
+func (c *Context) enqueuePendingJobs() {
+	for i, pending := range c.pendingJobs {
+		if err := pending.queue.Enqueue(pending.job); err != nil {
+			pending.queue.config.OnError(pending.queue.name, err)
+		}
+		c.pendingJobs[i] = pendingJob{}
+	}
+	c.pendingJobs = c.pendingJobs[:0]
+}
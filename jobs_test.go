@@ -0,0 +1,198 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextEnqueue(t *testing.T) {
+	var mu sync.Mutex
+	var events []string
+	q := NewJobQueue("emails", JobQueueConfig{})
+	r := New()
+	r.AddJobQueue(q)
+	r.POST("/users", func(c *Context) {
+		c.Enqueue("emails", func(ctx context.Context) error {
+			mu.Lock()
+			events = append(events, "job")
+			mu.Unlock()
+			return nil
+		})
+		// the job is queued once the response is written
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		events = append(events, "handler")
+		mu.Unlock()
+		c.Status(http.StatusCreated)
+	})
+
+	w := PerformRequest(r, http.MethodPost, "/users")
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.NoError(t, r.CloseJobQueues(context.Background()))
+	assert.Equal(t, []string{"handler", "job"}, events)
+	assert.Equal(t, JobQueueStats{Succeeded: 1}, q.Stats())
+	assert.Same(t, q, r.JobQueue("emails"))
+	assert.Nil(t, r.JobQueue("missing"))
+
+	assert.Panics(t, func() {
+		r.AddJobQueue(NewJobQueue("emails", JobQueueConfig{}))
+	})
+	c, _ := CreateTestContext(nil)
+	c.engine = r
+	assert.Panics(t, func() {
+		c.Enqueue("missing", func(ctx context.Context) error { return nil })
+	})
+}
+
+func TestContextCopyEnqueue(t *testing.T) {
+	q := NewJobQueue("emails", JobQueueConfig{})
+	r := New()
+	r.AddJobQueue(q)
+	done := make(chan struct{})
+	r.POST("/users", func(c *Context) {
+		cp := c.Copy()
+		go func() {
+			defer close(done)
+			cp.Enqueue("emails", func(ctx context.Context) error { return nil })
+		}()
+		c.Status(http.StatusCreated)
+	})
+
+	PerformRequest(r, http.MethodPost, "/users")
+	<-done
+	assert.NoError(t, r.CloseJobQueues(context.Background()))
+	assert.Equal(t, JobQueueStats{Succeeded: 1}, q.Stats(), "the jobs enqueued on copies are queued immediately")
+}
+
+func TestTimeoutHandlerEnqueue(t *testing.T) {
+	q := NewJobQueue("emails", JobQueueConfig{})
+	r := New()
+	r.AddJobQueue(q)
+	r.Use(TimeoutHandler(time.Second, nil))
+	r.POST("/users", func(c *Context) {
+		c.Enqueue("emails", func(ctx context.Context) error { return nil })
+		c.Status(http.StatusCreated)
+	})
+
+	w := PerformRequest(r, http.MethodPost, "/users")
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.NoError(t, r.CloseJobQueues(context.Background()))
+	assert.Equal(t, JobQueueStats{Succeeded: 1}, q.Stats())
+}
+
+func TestJobQueueRetry(t *testing.T) {
+	var failures []error
+	var attempts int32
+	q := NewJobQueue("retry", JobQueueConfig{
+		MaxRetries: 2,
+		Backoff:    func(int) time.Duration { return time.Millisecond },
+		OnError: func(queue string, err error) {
+			assert.Equal(t, "retry", queue)
+			failures = append(failures, err)
+		},
+	})
+	assert.NoError(t, q.Enqueue(func(ctx context.Context) error {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			return errors.New("unavailable")
+		}
+		return nil
+	}))
+	assert.NoError(t, q.Enqueue(func(ctx context.Context) error {
+		panic("boom")
+	}))
+
+	assert.NoError(t, q.Close(context.Background()))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+	assert.Equal(t, JobQueueStats{Succeeded: 1, Retried: 4, Failed: 1, Panicked: 3}, q.Stats())
+	assert.Len(t, failures, 1)
+	var panicErr *JobPanicError
+	assert.ErrorAs(t, failures[0], &panicErr)
+	assert.Equal(t, "boom", panicErr.Value)
+	assert.Equal(t, "gin: job panicked: boom", panicErr.Error())
+
+	assert.Equal(t, ErrJobQueueClosed, q.Enqueue(func(ctx context.Context) error { return nil }))
+}
+
+func TestJobQueueCloseRetrying(t *testing.T) {
+	for i := 0; i < 20; i++ {
+		q := NewJobQueue("retrying", JobQueueConfig{
+			Workers:    4,
+			MaxRetries: 1000,
+			Backoff:    func(int) time.Duration { return 0 },
+			OnError:    func(string, error) {},
+		})
+		for j := 0; j < 10; j++ {
+			assert.NoError(t, q.Enqueue(func(ctx context.Context) error { return errors.New("failed") }))
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		assert.Equal(t, context.DeadlineExceeded, q.Close(ctx))
+		cancel()
+
+		stats := q.Stats()
+		assert.Zero(t, stats.Queued, "the retried jobs are dropped, not left in the queue")
+		assert.Equal(t, uint64(10), stats.Dropped+stats.Failed)
+		assert.Empty(t, q.jobs)
+	}
+}
+
+func TestJobQueueFull(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var dropped []error
+	q := NewJobQueue("full", JobQueueConfig{QueueSize: 1, OnError: func(queue string, err error) {
+		dropped = append(dropped, err)
+	}})
+	assert.NoError(t, q.Enqueue(func(ctx context.Context) error {
+		close(started)
+		<-release
+		return nil
+	}))
+	<-started
+	assert.NoError(t, q.Enqueue(func(ctx context.Context) error { return nil }))
+	assert.Equal(t, ErrJobQueueFull, q.Enqueue(func(ctx context.Context) error { return nil }))
+	assert.Equal(t, JobQueueStats{Queued: 1, Running: 1, Dropped: 1}, q.Stats())
+
+	close(release)
+	assert.NoError(t, q.Close(context.Background()))
+	assert.Equal(t, JobQueueStats{Succeeded: 2, Dropped: 1}, q.Stats())
+}
+
+func TestJobQueueCloseTimeout(t *testing.T) {
+	q := NewJobQueue("slow", JobQueueConfig{})
+	canceled := make(chan struct{})
+	started := make(chan struct{})
+	assert.NoError(t, q.Enqueue(func(ctx context.Context) error {
+		close(started)
+		<-ctx.Done()
+		close(canceled)
+		return ctx.Err()
+	}))
+	assert.NoError(t, q.Enqueue(func(ctx context.Context) error {
+		t.Error("job run after close")
+		return nil
+	}))
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, q.Close(ctx))
+	<-canceled
+	assert.Equal(t, JobQueueStats{Failed: 1, Dropped: 1}, q.Stats())
+}
+
+func TestDefaultJobBackoff(t *testing.T) {
+	assert.Equal(t, 100*time.Millisecond, defaultJobBackoff(1))
+	assert.Equal(t, 400*time.Millisecond, defaultJobBackoff(3))
+	assert.Equal(t, 30*time.Second, defaultJobBackoff(20))
+}
@@ -0,0 +1,244 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// JSON-RPC 2.0 error codes.
+const (
+	JSONRPCParseError     = -32700
+	JSONRPCInvalidRequest = -32600
+	JSONRPCMethodNotFound = -32601
+	JSONRPCInvalidParams  = -32602
+	JSONRPCInternalError  = -32603
+)
+
+const jsonrpcVersion = "2.0"
+
+// JSONRPCError is a JSON-RPC 2.0 error. Handlers return it to reply with a given code.
+type JSONRPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Data    any    `json:"data,omitempty"`
+}
+
+func (e *JSONRPCError) Error() string {
+	return fmt.Sprintf("jsonrpc: %s (%d)", e.Message, e.Code)
+}
+
+// JSONRPCHandler handles the calls of a JSON-RPC method. params is the raw params of the
+// call, empty if it has none.
+type JSONRPCHandler func(c *Context, params json.RawMessage) (any, error)
+
+// JSONRPCFunc adapts a typed function to a JSONRPCHandler: the params are decoded into a
+// P, the calls with params that can not be decoded failing with JSONRPCInvalidParams.
+//
+//	server.Register("add", gin.JSONRPCFunc(func(c *gin.Context, args [2]int) (int, error) {
+//		return args[0] + args[1], nil
+//	}))
+func JSONRPCFunc[P, R any](f func(c *Context, params P) (R, error)) JSONRPCHandler {
+	return func(c *Context, raw json.RawMessage) (any, error) {
+		var params P
+		if len(raw) > 0 {
+			if err := json.Unmarshal(raw, &params); err != nil {
+				return nil, &JSONRPCError{Code: JSONRPCInvalidParams, Message: "Invalid params", Data: err.Error()}
+			}
+		}
+		return f(c, params)
+	}
+}
+
+// jsonrpcRequest is a request, or a notification when its id is missing.
+type jsonrpcRequest struct {
+	Version string          `json:"jsonrpc"`
+	Method  *string         `json:"method"`
+	Params  json.RawMessage `json:"params"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type jsonrpcResponse struct {
+	Version string          `json:"jsonrpc"`
+	Result  any             `json:"result,omitempty"`
+	Error   *JSONRPCError   `json:"error,omitempty"`
+	ID      json.RawMessage `json:"id"`
+}
+
+var jsonrpcNullID = json.RawMessage("null")
+
+// JSONRPCServer serves JSON-RPC 2.0 calls, single or batched, with the methods registered
+// with Register. Its handler is mounted on a route, so that the calls go through the
+// middleware of the route.
+//
+//	server := gin.NewJSONRPCServer()
+//	server.Register("users.get", getUser)
+//	router.POST("/rpc", auth, server.Handler())
+type JSONRPCServer struct {
+	methods       map[string]JSONRPCHandler
+	errorMappings []errorMapping
+	maxBatch      int
+}
+
+// NewJSONRPCServer returns a JSONRPCServer without methods.
+func NewJSONRPCServer() *JSONRPCServer {
+	return &JSONRPCServer{methods: make(map[string]JSONRPCHandler), maxBatch: 100}
+}
+
+// Register registers the handler of the method name. It panics if name is already
+// registered, or reserved, starting with "rpc.".
+func (s *JSONRPCServer) Register(name string, handler JSONRPCHandler) {
+	assert1(handler != nil, "jsonrpc handler can not be nil")
+	assert1(name != "" && !strings.HasPrefix(name, "rpc."), "invalid jsonrpc method name "+name)
+	_, exists := s.methods[name]
+	assert1(!exists, "jsonrpc method "+name+" is already registered")
+	s.methods[name] = handler
+}
+
+// SetMaxBatch sets the maximum number of calls of a batch, 100 by default, above which
+// the batch is rejected as an invalid request.
+func (s *JSONRPCServer) SetMaxBatch(n int) {
+	assert1(n > 0, "max batch must be positive")
+	s.maxBatch = n
+}
+
+// MapError maps the errors returned by the handlers matching target with errors.Is to the
+// JSON-RPC error code, their message being sent to the client. The other errors, that are
+// not a JSONRPCError, are reported as JSONRPCInternalError without their message.
+func (s *JSONRPCServer) MapError(target error, code int) {
+	assert1(target != nil, "target error can not be nil")
+	s.errorMappings = append(s.errorMappings, errorMapping{target: target, code: code})
+}
+
+// Handler returns the handler serving the JSON-RPC calls posted to the route. The
+// responses are written with a 200, the notifications, and the batches of notifications
+// only, with a 204 No Content. The errors of the calls are attached to the context.
+func (s *JSONRPCServer) Handler() HandlerFunc {
+	return func(c *Context) {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithError(http.StatusBadRequest, err).SetType(ErrorTypeBind) // nolint: errcheck
+			return
+		}
+		body = bytes.TrimSpace(body)
+
+		if len(body) > 0 && body[0] == '[' {
+			var batch []json.RawMessage
+			if err := json.Unmarshal(body, &batch); err != nil {
+				s.reply(c, s.fail(c, jsonrpcNullID, &JSONRPCError{Code: JSONRPCParseError, Message: "Parse error"}))
+				return
+			}
+			if len(batch) == 0 || len(batch) > s.maxBatch {
+				s.reply(c, s.fail(c, jsonrpcNullID, &JSONRPCError{Code: JSONRPCInvalidRequest, Message: "Invalid Request"}))
+				return
+			}
+			responses := make([]*jsonrpcResponse, 0, len(batch))
+			for _, raw := range batch {
+				if resp := s.call(c, raw); resp != nil {
+					responses = append(responses, resp)
+				}
+			}
+			if len(responses) == 0 {
+				c.Status(http.StatusNoContent)
+				return
+			}
+			c.JSON(http.StatusOK, responses)
+			return
+		}
+
+		if !json.Valid(body) {
+			s.reply(c, s.fail(c, jsonrpcNullID, &JSONRPCError{Code: JSONRPCParseError, Message: "Parse error"}))
+			return
+		}
+		s.reply(c, s.call(c, body))
+	}
+}
+
+func (s *JSONRPCServer) reply(c *Context, resp *jsonrpcResponse) {
+	if resp == nil {
+		c.Status(http.StatusNoContent)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
+
+// call serves the call raw, and returns its response, or nil if it is a notification.
+func (s *JSONRPCServer) call(c *Context, raw json.RawMessage) *jsonrpcResponse {
+	var req jsonrpcRequest
+	if err := json.Unmarshal(raw, &req); err != nil || req.Version != jsonrpcVersion || req.Method == nil || !validJSONRPCID(req.ID) {
+		return s.fail(c, jsonrpcNullID, &JSONRPCError{Code: JSONRPCInvalidRequest, Message: "Invalid Request"})
+	}
+	notification := req.ID == nil
+	id := req.ID
+
+	handler, ok := s.methods[*req.Method]
+	if !ok {
+		resp := s.fail(c, id, &JSONRPCError{Code: JSONRPCMethodNotFound, Message: "Method not found"})
+		if notification {
+			return nil
+		}
+		return resp
+	}
+	if len(req.Params) > 0 && req.Params[0] != '[' && req.Params[0] != '{' {
+		if !bytes.Equal(req.Params, jsonrpcNullID) {
+			resp := s.fail(c, id, &JSONRPCError{Code: JSONRPCInvalidParams, Message: "Invalid params"})
+			if notification {
+				return nil
+			}
+			return resp
+		}
+		req.Params = nil
+	}
+
+	result, err := handler(c, req.Params)
+	if notification {
+		if err != nil {
+			c.Error(err).SetType(ErrorTypePrivate) // nolint: errcheck
+		}
+		return nil
+	}
+	if err != nil {
+		return s.fail(c, id, err)
+	}
+	if result == nil {
+		result = jsonrpcNullID
+	}
+	return &jsonrpcResponse{Version: jsonrpcVersion, Result: result, ID: id}
+}
+
+// fail attaches err to the context and returns the error response of the call id.
+func (s *JSONRPCServer) fail(c *Context, id json.RawMessage, err error) *jsonrpcResponse {
+	c.Error(err).SetType(ErrorTypePrivate) // nolint: errcheck
+	var rpcErr *JSONRPCError
+	if !errors.As(err, &rpcErr) {
+		if code, ok := matchErrorMappings(s.errorMappings, err); ok {
+			rpcErr = &JSONRPCError{Code: code, Message: err.Error()}
+		} else {
+			rpcErr = &JSONRPCError{Code: JSONRPCInternalError, Message: "Internal error"}
+		}
+	}
+	if id == nil {
+		id = jsonrpcNullID
+	}
+	return &jsonrpcResponse{Version: jsonrpcVersion, Error: rpcErr, ID: id}
+}
+
+// validJSONRPCID reports whether id is missing, or a string, a number or null.
+func validJSONRPCID(id json.RawMessage) bool {
+	if id == nil {
+		return true
+	}
+	switch id[0] {
+	case '{', '[', 't', 'f':
+		return false
+	}
+	return true
+}
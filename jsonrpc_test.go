@@ -0,0 +1,128 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var errNoUser = errors.New("no such user")
+
+func newTestJSONRPC() (*Engine, *[]string) {
+	server := NewJSONRPCServer()
+	server.Register("add", JSONRPCFunc(func(c *Context, args []int) (int, error) {
+		sum := 0
+		for _, n := range args {
+			sum += n
+		}
+		return sum, nil
+	}))
+	server.Register("greet", JSONRPCFunc(func(c *Context, params struct{ Name string }) (string, error) {
+		return "hello " + params.Name + " from " + c.GetString("user"), nil
+	}))
+	var notified []string
+	server.Register("notify", func(c *Context, params json.RawMessage) (any, error) {
+		notified = append(notified, string(params))
+		return nil, nil
+	})
+	server.Register("user", func(c *Context, params json.RawMessage) (any, error) {
+		return nil, errNoUser
+	})
+	server.Register("crash", func(c *Context, params json.RawMessage) (any, error) {
+		return nil, errors.New("database password is wrong")
+	})
+	server.Register("custom", func(c *Context, params json.RawMessage) (any, error) {
+		return nil, &JSONRPCError{Code: 42, Message: "custom", Data: []int{1}}
+	})
+	server.MapError(errNoUser, -32001)
+
+	r := New()
+	r.POST("/rpc", func(c *Context) {
+		c.Set("user", "gopher")
+	}, server.Handler())
+	return r, &notified
+}
+
+func performJSONRPC(r *Engine, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/rpc", strings.NewReader(body))
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestJSONRPC(t *testing.T) {
+	r, notified := newTestJSONRPC()
+
+	for _, test := range []struct {
+		request, response string
+	}{
+		{`{"jsonrpc": "2.0", "method": "add", "params": [1, 2, 3], "id": 1}`, `{"jsonrpc": "2.0", "result": 6, "id": 1}`},
+		{`{"jsonrpc": "2.0", "method": "add", "params": [], "id": "a"}`, `{"jsonrpc": "2.0", "result": 0, "id": "a"}`},
+		{`{"jsonrpc": "2.0", "method": "greet", "params": {"name": "Bob"}, "id": null}`, `{"jsonrpc": "2.0", "result": "hello Bob from gopher", "id": null}`},
+		{`{"jsonrpc": "2.0", "method": "add", "params": {"a": 1}, "id": 2}`, `{"jsonrpc": "2.0", "error": {"code": -32602, "message": "Invalid params", "data": "json: cannot unmarshal object into Go value of type []int"}, "id": 2}`},
+		{`{"jsonrpc": "2.0", "method": "add", "params": 1, "id": 2}`, `{"jsonrpc": "2.0", "error": {"code": -32602, "message": "Invalid params"}, "id": 2}`},
+		{`{"jsonrpc": "2.0", "method": "missing", "id": 3}`, `{"jsonrpc": "2.0", "error": {"code": -32601, "message": "Method not found"}, "id": 3}`},
+		{`{"jsonrpc": "2.0", "method": "user", "id": 4}`, `{"jsonrpc": "2.0", "error": {"code": -32001, "message": "no such user"}, "id": 4}`},
+		{`{"jsonrpc": "2.0", "method": "crash", "id": 5}`, `{"jsonrpc": "2.0", "error": {"code": -32603, "message": "Internal error"}, "id": 5}`},
+		{`{"jsonrpc": "2.0", "method": "custom", "id": 6}`, `{"jsonrpc": "2.0", "error": {"code": 42, "message": "custom", "data": [1]}, "id": 6}`},
+		{`{"jsonrpc": "2.0", "method": "notify", "id": 7}`, `{"jsonrpc": "2.0", "result": null, "id": 7}`},
+		{`{"jsonrpc": "1.0", "method": "add", "id": 8}`, `{"jsonrpc": "2.0", "error": {"code": -32600, "message": "Invalid Request"}, "id": null}`},
+		{`{"jsonrpc": "2.0", "method": "add", "id": {}}`, `{"jsonrpc": "2.0", "error": {"code": -32600, "message": "Invalid Request"}, "id": null}`},
+		{`{"jsonrpc": "2.0", "method": 1, "id": 9}`, `{"jsonrpc": "2.0", "error": {"code": -32600, "message": "Invalid Request"}, "id": null}`},
+		{`{"jsonrpc": "2.0", "method": "add"`, `{"jsonrpc": "2.0", "error": {"code": -32700, "message": "Parse error"}, "id": null}`},
+		{`[`, `{"jsonrpc": "2.0", "error": {"code": -32700, "message": "Parse error"}, "id": null}`},
+		{`[]`, `{"jsonrpc": "2.0", "error": {"code": -32600, "message": "Invalid Request"}, "id": null}`},
+		{`[1]`, `[{"jsonrpc": "2.0", "error": {"code": -32600, "message": "Invalid Request"}, "id": null}]`},
+		{`[
+			{"jsonrpc": "2.0", "method": "add", "params": [1, 2], "id": "1"},
+			{"jsonrpc": "2.0", "method": "notify", "params": [7]},
+			{"jsonrpc": "2.0", "method": "missing", "id": "2"}
+		]`, `[
+			{"jsonrpc": "2.0", "result": 3, "id": "1"},
+			{"jsonrpc": "2.0", "error": {"code": -32601, "message": "Method not found"}, "id": "2"}
+		]`},
+	} {
+		w := performJSONRPC(r, test.request)
+		assert.Equal(t, http.StatusOK, w.Code, test.request)
+		assert.JSONEq(t, test.response, w.Body.String(), test.request)
+	}
+	assert.Equal(t, []string{"", "[7]"}, *notified)
+}
+
+func TestJSONRPCNotifications(t *testing.T) {
+	r, notified := newTestJSONRPC()
+
+	w := performJSONRPC(r, `{"jsonrpc": "2.0", "method": "notify", "params": {"a": 1}}`)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Empty(t, w.Body.String())
+
+	w = performJSONRPC(r, `[{"jsonrpc": "2.0", "method": "notify"}, {"jsonrpc": "2.0", "method": "missing"}, {"jsonrpc": "2.0", "method": "crash"}]`)
+	assert.Equal(t, http.StatusNoContent, w.Code)
+	assert.Equal(t, []string{`{"a": 1}`, ""}, *notified)
+}
+
+func TestJSONRPCRegister(t *testing.T) {
+	server := NewJSONRPCServer()
+	handler := func(c *Context, params json.RawMessage) (any, error) { return nil, nil }
+	server.Register("a", handler)
+	assert.Panics(t, func() { server.Register("a", handler) })
+	assert.Panics(t, func() { server.Register("rpc.discover", handler) })
+	assert.Panics(t, func() { server.Register("b", nil) })
+	assert.Panics(t, func() { server.SetMaxBatch(0) })
+
+	server.SetMaxBatch(1)
+	r := New()
+	r.POST("/rpc", server.Handler())
+	w := performJSONRPC(r, `[{"jsonrpc": "2.0", "method": "a", "id": 1}, {"jsonrpc": "2.0", "method": "a", "id": 2}]`)
+	assert.JSONEq(t, `{"jsonrpc": "2.0", "error": {"code": -32600, "message": "Invalid Request"}, "id": null}`, w.Body.String())
+	assert.Equal(t, "jsonrpc: Invalid Request (-32600)", (&JSONRPCError{Code: JSONRPCInvalidRequest, Message: "Invalid Request"}).Error())
+}
@@ -0,0 +1,196 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// clfTimeFormat is the time format of the Common Log Format.
+const clfTimeFormat = "02/Jan/2006:15:04:05 -0700"
+
+// CommonLogFormatter formats the entries in the Common Log Format of the Apache and nginx
+// access logs, the user being the one authenticated by BasicAuth, if any:
+//
+//	127.0.0.1 - gopher [16/Oct/2026:08:30:00 +0000] "GET /users?page=2 HTTP/1.1" 200 512
+//
+//	router.Use(gin.LoggerWithFormatter(gin.CommonLogFormatter))
+func CommonLogFormatter(param LogFormatterParams) string {
+	return commonLogEntry(param) + "\n"
+}
+
+// CombinedLogFormatter formats the entries in the Combined Log Format, the Common Log
+// Format followed by the referer and the user agent, see CommonLogFormatter:
+//
+//	127.0.0.1 - - [16/Oct/2026:08:30:00 +0000] "GET / HTTP/1.1" 200 512 "-" "curl/8.0.1"
+func CombinedLogFormatter(param LogFormatterParams) string {
+	referer, userAgent := "-", "-"
+	if param.Request != nil {
+		if v := param.Request.Referer(); v != "" {
+			referer = v
+		}
+		if v := param.Request.UserAgent(); v != "" {
+			userAgent = v
+		}
+	}
+	return commonLogEntry(param) + ` "` + escapeLogField(referer) + `" "` + escapeLogField(userAgent) + `"` + "\n"
+}
+
+func commonLogEntry(param LogFormatterParams) string {
+	user := "-"
+	if v, ok := param.Keys[AuthUserKey].(string); ok && v != "" {
+		user = v
+	}
+	proto := "HTTP/1.1"
+	if param.Request != nil && param.Request.Proto != "" {
+		proto = param.Request.Proto
+	}
+	status := "-"
+	if param.StatusCode > 0 {
+		status = strconv.Itoa(param.StatusCode)
+	}
+	size := "-"
+	if param.BodySize > 0 {
+		size = strconv.Itoa(param.BodySize)
+	}
+	return fmt.Sprintf(`%s - %s [%s] "%s" %s %s`,
+		param.ClientIP,
+		escapeLogField(user),
+		param.TimeStamp.Format(clfTimeFormat),
+		escapeLogField(param.Method+" "+param.Path+" "+proto),
+		status,
+		size,
+	)
+}
+
+// escapeLogField escapes the quotes, the backslashes and the control characters of s as
+// the Apache access logs do.
+func escapeLogField(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		switch b := s[i]; {
+		case b == '"' || b == '\\':
+			sb.WriteByte('\\')
+			sb.WriteByte(b)
+		case b < 0x20 || b == 0x7f:
+			fmt.Fprintf(&sb, `\x%02x`, b)
+		default:
+			sb.WriteByte(b)
+		}
+	}
+	return sb.String()
+}
+
+// jsonLogEntry is an entry of JSONLogFormatter.
+type jsonLogEntry struct {
+	Time      string  `json:"time"`
+	Status    int     `json:"status"`
+	LatencyMS float64 `json:"latency_ms"`
+	ClientIP  string  `json:"client_ip"`
+	Method    string  `json:"method"`
+	Path      string  `json:"path"`
+	Proto     string  `json:"proto,omitempty"`
+	Bytes     int     `json:"bytes"`
+	Referer   string  `json:"referer,omitempty"`
+	UserAgent string  `json:"user_agent,omitempty"`
+	User      string  `json:"user,omitempty"`
+	Error     string  `json:"error,omitempty"`
+	TraceID   string  `json:"trace_id,omitempty"`
+	SpanID    string  `json:"span_id,omitempty"`
+	Route     string  `json:"route,omitempty"`
+	Handler   string  `json:"handler,omitempty"`
+	// RequestBody and ResponseBody are set for the routes logging the bodies.
+	RequestBody  *string `json:"request_body,omitempty"`
+	ResponseBody *string `json:"response_body,omitempty"`
+}
+
+// JSONLogFormatter formats the entries as JSON objects, one per line, for the log
+// pipelines parsing JSON, e.g. ELK:
+//
+//	{"time":"2026-10-16T08:30:00Z","status":200,"latency_ms":1.2,"client_ip":"127.0.0.1","method":"GET","path":"/","bytes":512}
+func JSONLogFormatter(param LogFormatterParams) string {
+	entry := jsonLogEntry{
+		Time:      param.TimeStamp.Format(time.RFC3339Nano),
+		Status:    param.StatusCode,
+		LatencyMS: float64(param.Latency) / float64(time.Millisecond),
+		ClientIP:  param.ClientIP,
+		Method:    param.Method,
+		Path:      param.Path,
+		Bytes:     param.BodySize,
+		Error:     strings.TrimSpace(param.ErrorMessage),
+		TraceID:   param.TraceID,
+		SpanID:    param.SpanID,
+		Route:     param.Route,
+		Handler:   param.Handler,
+	}
+	entry.User, _ = param.Keys[AuthUserKey].(string)
+	if param.RequestBody != nil {
+		requestBody, responseBody := string(param.RequestBody), string(param.ResponseBody)
+		entry.RequestBody, entry.ResponseBody = &requestBody, &responseBody
+	}
+	if param.Request != nil {
+		entry.Proto = param.Request.Proto
+		entry.Referer = param.Request.Referer()
+		entry.UserAgent = param.Request.UserAgent()
+	}
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return ""
+	}
+	return string(data) + "\n"
+}
+
+// LogfmtLogFormatter formats the entries as logfmt key=value pairs, one entry per line:
+//
+//	time=2026-10-16T08:30:00Z status=200 latency=1.2ms client_ip=127.0.0.1 method=GET path=/ bytes=512
+func LogfmtLogFormatter(param LogFormatterParams) string {
+	var sb strings.Builder
+	pair := func(key, value string) {
+		if sb.Len() > 0 {
+			sb.WriteByte(' ')
+		}
+		sb.WriteString(key)
+		sb.WriteByte('=')
+		if value == "" || strings.ContainsAny(value, " =\"\\") || strings.IndexFunc(value, isControlRune) >= 0 {
+			value = strconv.Quote(value)
+		}
+		sb.WriteString(value)
+	}
+	pair("time", param.TimeStamp.Format(time.RFC3339Nano))
+	pair("status", strconv.Itoa(param.StatusCode))
+	pair("latency", param.Latency.String())
+	pair("client_ip", param.ClientIP)
+	pair("method", param.Method)
+	pair("path", param.Path)
+	if param.Route != "" {
+		pair("route", param.Route)
+	}
+	pair("bytes", strconv.Itoa(param.BodySize))
+	if param.Request != nil {
+		if v := param.Request.UserAgent(); v != "" {
+			pair("user_agent", v)
+		}
+	}
+	if user, ok := param.Keys[AuthUserKey].(string); ok && user != "" {
+		pair("user", user)
+	}
+	if msg := strings.TrimSpace(param.ErrorMessage); msg != "" {
+		pair("error", msg)
+	}
+	if param.TraceID != "" {
+		pair("trace_id", param.TraceID)
+		pair("span_id", param.SpanID)
+	}
+	sb.WriteByte('\n')
+	return sb.String()
+}
+
+func isControlRune(r rune) bool {
+	return r < 0x20 || r == 0x7f
+}
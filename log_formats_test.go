@@ -0,0 +1,89 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newLogFormatterParams() LogFormatterParams {
+	req := httptest.NewRequest(http.MethodGet, "/users?page=2", nil)
+	req.Header.Set("Referer", "https://example.com/")
+	req.Header.Set("User-Agent", `curl/8.0.1 "test"`)
+	return LogFormatterParams{
+		Request:    req,
+		TimeStamp:  time.Date(2026, 10, 16, 8, 30, 0, 0, time.UTC),
+		StatusCode: http.StatusOK,
+		Latency:    1500 * time.Microsecond,
+		ClientIP:   "127.0.0.1",
+		Method:     http.MethodGet,
+		Path:       "/users?page=2",
+		BodySize:   512,
+		Keys:       map[string]any{AuthUserKey: "gopher"},
+	}
+}
+
+func TestCommonLogFormatter(t *testing.T) {
+	param := newLogFormatterParams()
+	assert.Equal(t, `127.0.0.1 - gopher [16/Oct/2026:08:30:00 +0000] "GET /users?page=2 HTTP/1.1" 200 512`+"\n", CommonLogFormatter(param))
+
+	param.Keys = nil
+	param.BodySize = 0
+	param.Path = "/a\"b\n"
+	assert.Equal(t, `127.0.0.1 - - [16/Oct/2026:08:30:00 +0000] "GET /a\"b\x0a HTTP/1.1" 200 -`+"\n", CommonLogFormatter(param))
+}
+
+func TestCombinedLogFormatter(t *testing.T) {
+	param := newLogFormatterParams()
+	assert.Equal(t, `127.0.0.1 - gopher [16/Oct/2026:08:30:00 +0000] "GET /users?page=2 HTTP/1.1" 200 512 "https://example.com/" "curl/8.0.1 \"test\""`+"\n", CombinedLogFormatter(param))
+
+	param.Request = nil
+	param.StatusCode = 0
+	assert.Equal(t, `127.0.0.1 - gopher [16/Oct/2026:08:30:00 +0000] "GET /users?page=2 HTTP/1.1" - 512 "-" "-"`+"\n", CombinedLogFormatter(param))
+}
+
+func TestJSONLogFormatter(t *testing.T) {
+	param := newLogFormatterParams()
+	param.ErrorMessage = "Error #01: boom\n"
+	assert.JSONEq(t, `{
+		"time": "2026-10-16T08:30:00Z",
+		"status": 200,
+		"latency_ms": 1.5,
+		"client_ip": "127.0.0.1",
+		"method": "GET",
+		"path": "/users?page=2",
+		"proto": "HTTP/1.1",
+		"bytes": 512,
+		"referer": "https://example.com/",
+		"user_agent": "curl/8.0.1 \"test\"",
+		"user": "gopher",
+		"error": "Error #01: boom"
+	}`, JSONLogFormatter(param))
+	assert.True(t, bytes.HasSuffix([]byte(JSONLogFormatter(param)), []byte("}\n")))
+}
+
+func TestLogfmtLogFormatter(t *testing.T) {
+	param := newLogFormatterParams()
+	param.ErrorMessage = "Error #01: boom\n"
+	assert.Equal(t, `time=2026-10-16T08:30:00Z status=200 latency=1.5ms client_ip=127.0.0.1 method=GET path="/users?page=2" bytes=512 user_agent="curl/8.0.1 \"test\"" user=gopher error="Error #01: boom"`+"\n", LogfmtLogFormatter(param))
+}
+
+func TestLoggerWithLogFormatPresets(t *testing.T) {
+	var buf bytes.Buffer
+	router := New()
+	router.Use(LoggerWithConfig(LoggerConfig{Output: &buf, Formatter: CombinedLogFormatter}))
+	router.GET("/fail", func(c *Context) {
+		c.AbortWithError(http.StatusTeapot, errors.New("boom")) // nolint: errcheck
+	})
+	PerformRequest(router, http.MethodGet, "/fail?x=1", header{"User-Agent", "test"})
+	assert.Regexp(t, `^192\.0\.2\.1 - - \[[^\]]+\] "GET /fail\?x=1 HTTP/1\.1" 418 - "-" "test"\n$`, buf.String())
+}
@@ -7,6 +7,7 @@ package gin
 import (
 	"fmt"
 	"io"
+	"math/rand"
 	"net/http"
 	"os"
 	"time"
@@ -40,13 +41,21 @@ type LoggerConfig struct {
 	// Optional. Default value is gin.defaultLogFormatter
 	Formatter LogFormatter
 
-	// Output is a writer where logs are written.
+	// Output is a writer where logs are written, each entry with a single Write call.
+	// Set it to a dedicated sink, e.g. an AccessLogFile or any RotatableWriter, possibly
+	// wrapped in an AsyncWriter, to keep the access log apart from the application logs.
 	// Optional. Default value is gin.DefaultWriter.
 	Output io.Writer
 
 	// SkipPaths is an url path array which logs are not written.
 	// Optional.
 	SkipPaths []string
+
+	// TraceContext returns the trace and span IDs of the requests, logged to correlate
+	// the logs with the traces, see LogFormatterParams.TraceID. It is called once the
+	// request is served, so it sees the span started by a tracing middleware.
+	// Optional. Default value is gin.TraceParent.
+	TraceContext TraceContextFunc
 }
 
 // LogFormatter gives the signature of the formatter function passed to LoggerWithFormatter
@@ -74,8 +83,23 @@ type LogFormatterParams struct {
 	isTerm bool
 	// BodySize is the size of the Response Body
 	BodySize int
+	// Hijacked is true if the connection was hijacked by the handler, e.g. for websockets.
+	// StatusCode and BodySize are then meaningless and left to zero.
+	Hijacked bool
 	// Keys are the keys set on the request's context.
 	Keys map[string]any
+	// RequestBody and ResponseBody are the beginnings of the bodies of the request and of
+	// the response, for the routes logging them, see RouteLogConfig.Bodies.
+	RequestBody  []byte
+	ResponseBody []byte
+	// TraceID and SpanID identify the trace and the span of the request, if it is traced,
+	// see LoggerConfig.TraceContext.
+	TraceID string
+	SpanID  string
+	// Route is the path template of the matched route, "unmatched" if none, and Handler
+	// the name of its handler, see Context.RouteLabels.
+	Route   string
+	Handler string
 }
 
 // StatusCodeColor is the ANSI color for appropriately logging http status code to a terminal.
@@ -140,7 +164,7 @@ var defaultLogFormatter = func(param LogFormatterParams) string {
 	if param.Latency > time.Minute {
 		param.Latency = param.Latency.Truncate(time.Second)
 	}
-	return fmt.Sprintf("[GIN] %v |%s %3d %s| %13v | %15s |%s %-7s %s %#v\n%s",
+	entry := fmt.Sprintf("[GIN] %v |%s %3d %s| %13v | %15s |%s %-7s %s %#v\n%s",
 		param.TimeStamp.Format("2006/01/02 - 15:04:05"),
 		statusColor, param.StatusCode, resetColor,
 		param.Latency,
@@ -149,6 +173,10 @@ var defaultLogFormatter = func(param LogFormatterParams) string {
 		param.Path,
 		param.ErrorMessage,
 	)
+	if param.RequestBody != nil {
+		entry += fmt.Sprintf("  request body: %q\n  response body: %q\n", param.RequestBody, param.ResponseBody)
+	}
+	return entry
 }
 
 // DisableConsoleColor disables color output in the console.
@@ -211,6 +239,11 @@ func LoggerWithConfig(conf LoggerConfig) HandlerFunc {
 		out = DefaultWriter
 	}
 
+	traceContext := conf.TraceContext
+	if traceContext == nil {
+		traceContext = TraceParent
+	}
+
 	notlogged := conf.SkipPaths
 
 	isTerm := true
@@ -236,16 +269,44 @@ func LoggerWithConfig(conf LoggerConfig) HandlerFunc {
 		path := c.Request.URL.Path
 		raw := c.Request.URL.RawQuery
 
+		_, skipped := skip[path]
+		var requestBody, responseBody *logBuffer
+		if meta, ok := c.RouteMeta(RouteLogMetaKey); ok {
+			routeConf := meta.(RouteLogConfig)
+			switch {
+			case routeConf.Skip:
+				skipped = true
+			case routeConf.Always:
+				skipped = false
+			case routeConf.SampleRate > 0 && rand.Float64() >= routeConf.SampleRate: // nolint: gosec
+				skipped = true
+			}
+			if routeConf.Bodies && !skipped {
+				requestBody = &logBuffer{data: []byte{}, max: routeConf.MaxBodySize}
+				responseBody = &logBuffer{data: []byte{}, max: routeConf.MaxBodySize}
+				if c.Request.Body != nil {
+					c.Request.Body = logBodyReader{ReadCloser: c.Request.Body, buf: requestBody}
+				}
+				w := c.Writer
+				c.Writer = &logBodyWriter{ResponseWriter: w, buf: responseBody}
+				defer func() { c.Writer = w }()
+			}
+		}
+
 		// Process request
 		c.Next()
 
 		// Log only when path is not being skipped
-		if _, ok := skip[path]; !ok {
+		if !skipped {
 			param := LogFormatterParams{
 				Request: c.Request,
 				isTerm:  isTerm,
 				Keys:    c.Keys,
 			}
+			if requestBody != nil {
+				param.RequestBody = requestBody.data
+				param.ResponseBody = responseBody.data
+			}
 
 			// Stop timer
 			param.TimeStamp = time.Now()
@@ -253,10 +314,14 @@ func LoggerWithConfig(conf LoggerConfig) HandlerFunc {
 
 			param.ClientIP = c.ClientIP()
 			param.Method = c.Request.Method
-			param.StatusCode = c.Writer.Status()
+			param.Route, param.Handler = c.RouteLabels()
+			param.TraceID, param.SpanID = traceContext(c)
 			param.ErrorMessage = c.Errors.ByType(ErrorTypePrivate).String()
 
-			param.BodySize = c.Writer.Size()
+			if param.Hijacked = hijacked(c.Writer); !param.Hijacked {
+				param.StatusCode = c.Writer.Status()
+				param.BodySize = c.Writer.Size()
+			}
 
 			if raw != "" {
 				path = path + "?" + raw
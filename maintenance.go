@@ -0,0 +1,83 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultMaintenanceRetryAfter = 120 * time.Second
+
+var default503Body = []byte("503 service unavailable")
+
+// maintenanceState is the allowlist of the maintenance mode, stored in Engine.maintenance
+// while it is enabled.
+type maintenanceState struct {
+	paths    map[string]struct{}
+	prefixes []string
+}
+
+func (m *maintenanceState) allowed(path string) bool {
+	if _, ok := m.paths[path]; ok {
+		return true
+	}
+	for _, prefix := range m.prefixes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetMaintenanceMode enables or disables the maintenance mode. While it is enabled, every
+// request whose path is not in allowlist is answered with a 503 Service Unavailable and a
+// Retry-After header, see MaintenanceRetryAfter, before any route lookup. Only the global
+// middleware run, so the requests are still logged. An allowlist entry ending with '*'
+// matches every path starting with it, the other ones match a single path:
+//
+//	router.SetMaintenanceMode(true, []string{"/healthz", "/admin/*"})
+//
+// It is safe to call while the engine is serving requests, e.g. from a deploy hook.
+func (engine *Engine) SetMaintenanceMode(enabled bool, allowlist []string) {
+	if !enabled {
+		engine.maintenance.Store((*maintenanceState)(nil))
+		return
+	}
+	state := &maintenanceState{paths: make(map[string]struct{}, len(allowlist))}
+	for _, path := range allowlist {
+		if strings.HasSuffix(path, "*") {
+			state.prefixes = append(state.prefixes, strings.TrimSuffix(path, "*"))
+			continue
+		}
+		state.paths[path] = struct{}{}
+	}
+	engine.maintenance.Store(state)
+}
+
+// MaintenanceMode reports whether the maintenance mode is enabled.
+func (engine *Engine) MaintenanceMode() bool {
+	state, _ := engine.maintenance.Load().(*maintenanceState)
+	return state != nil
+}
+
+// serveMaintenance answers the request with a 503 if the maintenance mode is enabled and
+// the path is not allowlisted, and reports whether it did.
+func (engine *Engine) serveMaintenance(c *Context, path string) bool {
+	state, _ := engine.maintenance.Load().(*maintenanceState)
+	if state == nil || state.allowed(path) {
+		return false
+	}
+	retryAfter := engine.MaintenanceRetryAfter
+	if retryAfter <= 0 {
+		retryAfter = defaultMaintenanceRetryAfter
+	}
+	c.Header("Retry-After", strconv.Itoa(int(retryAfter.Round(time.Second)/time.Second)))
+	c.handlers = engine.Handlers
+	serveError(c, http.StatusServiceUnavailable, default503Body)
+	return true
+}
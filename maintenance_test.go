@@ -0,0 +1,49 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaintenanceMode(t *testing.T) {
+	logged := 0
+	router := New()
+	router.Use(func(c *Context) { logged++ })
+	router.GET("/users", func(c *Context) { c.String(http.StatusOK, "%s", "users") })
+	router.GET("/healthz", func(c *Context) { c.String(http.StatusOK, "%s", "ok") })
+	router.GET("/admin/stats", func(c *Context) { c.String(http.StatusOK, "%s", "stats") })
+
+	assert.False(t, router.MaintenanceMode())
+	router.SetMaintenanceMode(true, []string{"/healthz", "/admin/*"})
+	assert.True(t, router.MaintenanceMode())
+
+	w := PerformRequest(router, http.MethodGet, "/users")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "120", w.Header().Get("Retry-After"))
+	assert.Equal(t, "503 service unavailable", w.Body.String())
+	assert.Equal(t, 1, logged)
+
+	w = PerformRequest(router, http.MethodGet, "/missing")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/healthz")
+	assert.Equal(t, "ok", w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/admin/stats")
+	assert.Equal(t, "stats", w.Body.String())
+
+	router.MaintenanceRetryAfter = 5 * time.Minute
+	w = PerformRequest(router, http.MethodGet, "/users")
+	assert.Equal(t, "300", w.Header().Get("Retry-After"))
+
+	router.SetMaintenanceMode(false, nil)
+	assert.False(t, router.MaintenanceMode())
+	w = PerformRequest(router, http.MethodGet, "/users")
+	assert.Equal(t, "users", w.Body.String())
+}
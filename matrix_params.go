@@ -0,0 +1,59 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/url"
+	"strings"
+)
+
+// parseMatrixParams removes the matrix params from the segments of path, returning the
+// path to route and the params of every segment, nil for the segments without any.
+func parseMatrixParams(path string, unescape bool) (string, []url.Values) {
+	if strings.IndexByte(path, ';') < 0 {
+		return path, nil
+	}
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	params := make([]url.Values, len(segments))
+	for i, segment := range segments {
+		name, rest, found := strings.Cut(segment, ";")
+		if !found {
+			continue
+		}
+		segments[i] = name
+		params[i] = url.Values{}
+		for _, pair := range strings.Split(rest, ";") {
+			if pair == "" {
+				continue
+			}
+			key, value, _ := strings.Cut(pair, "=")
+			if unescape {
+				if k, err := url.PathUnescape(key); err == nil {
+					key = k
+				}
+				if v, err := url.PathUnescape(value); err == nil {
+					value = v
+				}
+			}
+			params[i].Add(key, value)
+		}
+	}
+	return "/" + strings.Join(segments, "/"), params
+}
+
+// SegmentParams returns the matrix params of the i-th segment of the request path, counting
+// from zero, e.g. color=red for the segment 0 of /items;color=red/rest. It returns nil if
+// the segment has none or EnableMatrixParams is disabled.
+//
+//	router.EnableMatrixParams = true
+//	router.GET("/items/:id", func(c *gin.Context) {
+//	    color := c.SegmentParams(0).Get("color")
+//	})
+func (c *Context) SegmentParams(i int) url.Values {
+	if i < 0 || i >= len(c.segmentParams) {
+		return nil
+	}
+	return c.segmentParams[i]
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMatrixParams(t *testing.T) {
+	path, params := parseMatrixParams("/items/42", false)
+	assert.Equal(t, "/items/42", path)
+	assert.Nil(t, params)
+
+	path, params = parseMatrixParams("/items;color=red;size=L;color=blue/42;;flag/rest", false)
+	assert.Equal(t, "/items/42/rest", path)
+	assert.Equal(t, []url.Values{
+		{"color": {"red", "blue"}, "size": {"L"}},
+		{"flag": {""}},
+		nil,
+	}, params)
+
+	path, params = parseMatrixParams("/a%3Bb;name=gin%20gonic", true)
+	assert.Equal(t, "/a%3Bb", path)
+	assert.Equal(t, "gin gonic", params[0].Get("name"))
+}
+
+func TestContextSegmentParams(t *testing.T) {
+	router := New()
+	router.GET("/items/:id/rest", func(c *Context) {
+		c.String(http.StatusOK, "%s %s %s", c.Param("id"), c.SegmentParams(0).Get("color"), c.SegmentParams(1).Get("v"))
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/items;color=red/42;v=2/rest")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	router.EnableMatrixParams = true
+	w = PerformRequest(router, http.MethodGet, "/items;color=red/42;v=2/rest")
+	assert.Equal(t, "42 red 2", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/items/42/rest")
+	assert.Equal(t, "42  ", w.Body.String())
+
+	c, _ := CreateTestContext(httptest.NewRecorder())
+	assert.Nil(t, c.SegmentParams(-1))
+	assert.Nil(t, c.SegmentParams(3))
+}
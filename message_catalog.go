@@ -0,0 +1,180 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Codes of the messages of the responses written by the framework itself.
+const (
+	// MessageNotFound is the body of the 404 responses of the unmatched requests.
+	MessageNotFound = "not_found"
+	// MessageMethodNotAllowed is the body of the 405 responses, see
+	// Engine.HandleMethodNotAllowed.
+	MessageMethodNotAllowed = "method_not_allowed"
+	// MessageBindingFailed is the body of the 400 responses of the Bind methods.
+	MessageBindingFailed = "binding_failed"
+	// MessageInternalError is the body of the 500 responses of the Recovery middleware.
+	MessageInternalError = "internal_error"
+)
+
+// MessageData is the data of the message templates.
+type MessageData struct {
+	Method string
+	Path   string
+	// Error is the error of the message, e.g. the binding error, if any. It should not be
+	// exposed for the panics.
+	Error error
+}
+
+// MessageCatalog holds the localized messages of the responses written by the framework,
+// by language and code, as text/template templates executed with a MessageData. The
+// language of a response is picked from the Accept-Language header of the request.
+//
+//	catalog := gin.NewMessageCatalog()
+//	catalog.Set("fr", gin.MessageNotFound, "{{.Path}} introuvable")
+//	router.SetMessageCatalog(catalog)
+type MessageCatalog struct {
+	fallback string
+	messages map[string]map[string]*template.Template
+}
+
+// NewMessageCatalog returns a catalog holding the English messages, English being the
+// fallback language.
+func NewMessageCatalog() *MessageCatalog {
+	catalog := &MessageCatalog{fallback: "en", messages: make(map[string]map[string]*template.Template)}
+	catalog.Set("en", MessageNotFound, string(default404Body))
+	catalog.Set("en", MessageMethodNotAllowed, string(default405Body))
+	catalog.Set("en", MessageBindingFailed, "400 bad request: {{.Error}}")
+	catalog.Set("en", MessageInternalError, "500 internal server error")
+	return catalog
+}
+
+// Set sets the template of the message code in the language lang, e.g. "fr" or "pt-BR".
+// It panics if the template can not be parsed.
+func (mc *MessageCatalog) Set(lang, code, text string) {
+	lang = strings.ToLower(lang)
+	tmpl := template.Must(template.New(lang + "/" + code).Parse(text))
+	if mc.messages[lang] == nil {
+		mc.messages[lang] = make(map[string]*template.Template)
+	}
+	mc.messages[lang][code] = tmpl
+}
+
+// SetFallback sets the language of the messages of the requests accepting none of the
+// languages of the catalog, "en" by default.
+func (mc *MessageCatalog) SetFallback(lang string) {
+	mc.fallback = strings.ToLower(lang)
+}
+
+// Language returns the language of the message code for acceptLanguage, the value of an
+// Accept-Language header: the preferred one having the message, a language matching the
+// ones with a region too, e.g. "fr" matching "fr-CA", or else the fallback language.
+func (mc *MessageCatalog) Language(acceptLanguage, code string) string {
+	for _, lang := range parseAcceptLanguage(acceptLanguage) {
+		if mc.messages[lang][code] != nil {
+			return lang
+		}
+		if i := strings.IndexByte(lang, '-'); i > 0 && mc.messages[lang[:i]][code] != nil {
+			return lang[:i]
+		}
+	}
+	return mc.fallback
+}
+
+// Message returns the message code in the language of the request, see Language, and the
+// language. It returns false if the message does not exist in this language.
+func (mc *MessageCatalog) Message(c *Context, code string, data MessageData) (message, lang string, ok bool) {
+	lang = mc.Language(c.requestHeader("Accept-Language"), code)
+	tmpl := mc.messages[lang][code]
+	if tmpl == nil {
+		return "", "", false
+	}
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		debugPrint("[WARNING] cannot execute message template %s: %v\n", tmpl.Name(), err)
+		return "", "", false
+	}
+	return sb.String(), lang, true
+}
+
+// parseAcceptLanguage returns the languages of the Accept-Language header, in lower case,
+// by decreasing quality, without the refused ones.
+func parseAcceptLanguage(header string) []string {
+	type language struct {
+		tag     string
+		quality float64
+	}
+	var languages []language
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || tag == "*" {
+			continue
+		}
+		quality := 1.0
+		if params = strings.TrimSpace(params); strings.HasPrefix(params, "q=") {
+			if v, err := strconv.ParseFloat(params[2:], 64); err == nil {
+				quality = v
+			}
+		}
+		if quality > 0 {
+			languages = append(languages, language{tag: tag, quality: quality})
+		}
+	}
+	sort.SliceStable(languages, func(i, j int) bool {
+		return languages[i].quality > languages[j].quality
+	})
+	tags := make([]string, len(languages))
+	for i, l := range languages {
+		tags[i] = l.tag
+	}
+	return tags
+}
+
+// SetMessageCatalog sets the catalog of the messages of the responses written by the
+// framework: the 404 and 405 responses, which have plain English bodies by default, and
+// the 400 responses of the Bind methods and the 500 responses of the Recovery middleware,
+// which have none. The Content-Language header of the responses is set, and Accept-Language
+// is added to their Vary header.
+func (engine *Engine) SetMessageCatalog(catalog *MessageCatalog) {
+	engine.messageCatalog = catalog
+}
+
+// writeMessage writes the message code of the catalog of the engine as the plain text
+// body of the response, with status, if the engine has a catalog holding it, and reports
+// whether it did.
+func (c *Context) writeMessage(status int, code string, data MessageData) bool {
+	if c.engine == nil || c.engine.messageCatalog == nil {
+		return false
+	}
+	message, lang, ok := c.engine.messageCatalog.Message(c, code, data)
+	if !ok {
+		return false
+	}
+	header := c.Writer.Header()
+	header["Content-Type"] = mimePlain
+	header.Set("Content-Language", lang)
+	c.AddVary("Accept-Language")
+	c.Writer.WriteHeader(status)
+	if _, err := c.Writer.WriteString(message); err != nil {
+		debugPrintCategory(DebugRender, "cannot write message to writer: %v", err)
+	}
+	return true
+}
+
+// messageData returns the data of the messages of the request.
+func (c *Context) messageData(err error) MessageData {
+	data := MessageData{Error: err}
+	if c.Request != nil {
+		data.Method = c.Request.Method
+		data.Path = c.Request.URL.Path
+	}
+	return data
+}
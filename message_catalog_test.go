@@ -0,0 +1,119 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newCatalogRouter() *Engine {
+	catalog := NewMessageCatalog()
+	catalog.Set("fr", MessageNotFound, "{{.Path}} introuvable")
+	catalog.Set("fr", MessageMethodNotAllowed, "méthode {{.Method}} non autorisée")
+	catalog.Set("fr", MessageBindingFailed, "requête invalide")
+	catalog.Set("de", MessageInternalError, "interner Fehler")
+
+	router := New()
+	router.HandleMethodNotAllowed = true
+	router.SetMessageCatalog(catalog)
+	router.Use(RecoveryWithWriter(io.Discard))
+	router.GET("/users", func(c *Context) {})
+	router.POST("/users", func(c *Context) {
+		var user struct {
+			Name string `json:"name" binding:"required"`
+		}
+		c.BindJSON(&user) // nolint: errcheck
+	})
+	router.GET("/panic", func(c *Context) {
+		panic("boom")
+	})
+	return router
+}
+
+func TestMessageCatalogNotFound(t *testing.T) {
+	router := newCatalogRouter()
+
+	w := PerformRequest(router, http.MethodGet, "/missing", header{"Accept-Language", "fr-CA, en;q=0.5"})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "/missing introuvable", w.Body.String())
+	assert.Equal(t, "fr", w.Header().Get("Content-Language"))
+	assert.Equal(t, "Accept-Language", w.Header().Get("Vary"))
+	assert.Equal(t, MIMEPlain, w.Header().Get("Content-Type"))
+
+	w = PerformRequest(router, http.MethodGet, "/missing", header{"Accept-Language", "es, fr;q=0"})
+	assert.Equal(t, "404 page not found", w.Body.String())
+	assert.Equal(t, "en", w.Header().Get("Content-Language"))
+
+	w = PerformRequest(router, http.MethodDelete, "/users", header{"Accept-Language", "fr"})
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	assert.Equal(t, "méthode DELETE non autorisée", w.Body.String())
+}
+
+func TestMessageCatalogBindingAndPanic(t *testing.T) {
+	router := newCatalogRouter()
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", MIMEJSON)
+	req.Header.Set("Accept-Language", "fr")
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Equal(t, "requête invalide", w.Body.String())
+	assert.Equal(t, "Accept-Language", w.Header().Get("Vary"))
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", MIMEJSON)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.True(t, strings.HasPrefix(w.Body.String(), "400 bad request: Key: "))
+
+	w = PerformRequest(router, http.MethodGet, "/panic", header{"Accept-Language", "de-DE"})
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, "interner Fehler", w.Body.String())
+	assert.Equal(t, "de", w.Header().Get("Content-Language"))
+	assert.Equal(t, "Accept-Language", w.Header().Get("Vary"))
+}
+
+func TestMessageCatalogDisabled(t *testing.T) {
+	router := New()
+	router.Use(RecoveryWithWriter(io.Discard))
+	router.GET("/panic", func(c *Context) {
+		panic("boom")
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/missing", header{"Accept-Language", "fr"})
+	assert.Equal(t, "404 page not found", w.Body.String())
+	assert.Empty(t, w.Header().Get("Content-Language"))
+
+	w = PerformRequest(router, http.MethodGet, "/panic")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Empty(t, w.Body.String())
+}
+
+func TestMessageCatalogLanguage(t *testing.T) {
+	catalog := NewMessageCatalog()
+	catalog.Set("pt-BR", MessageNotFound, "não encontrado")
+	catalog.Set("fr", MessageNotFound, "introuvable")
+
+	assert.Equal(t, "pt-br", catalog.Language("pt-BR", MessageNotFound))
+	assert.Equal(t, "en", catalog.Language("pt", MessageNotFound))
+	assert.Equal(t, "fr", catalog.Language("pt;q=0.4, fr-BE;q=0.8", MessageNotFound))
+	assert.Equal(t, "en", catalog.Language("fr", MessageInternalError))
+	assert.Equal(t, "en", catalog.Language("", MessageNotFound))
+
+	catalog.SetFallback("fr")
+	assert.Equal(t, "fr", catalog.Language("es", MessageNotFound))
+
+	assert.Panics(t, func() {
+		catalog.Set("fr", MessageNotFound, "{{.Path")
+	})
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"strconv"
+	"sync/atomic"
+	"time"
+)
+
+// Names of the metrics recorded by the Metrics middleware, after the prefix.
+const (
+	MetricRequests        = "requests"
+	MetricRequestDuration = "request.duration"
+	MetricResponseSize    = "response.size"
+	MetricInFlight        = "requests.in_flight"
+)
+
+// unmatchedRoute is the route tag of the requests not matching any route, so that the
+// scans of random paths do not create a series per path.
+const unmatchedRoute = "unmatched"
+
+// MetricTag is a dimension of a metric, e.g. the route of the request.
+type MetricTag struct {
+	Name  string
+	Value string
+}
+
+// MetricsExporter sends the metrics to a monitoring system. The methods are called
+// concurrently by the requests, and must not block them.
+type MetricsExporter interface {
+	// Count adds value to the counter name.
+	Count(name string, value int64, tags []MetricTag)
+	// Gauge sets the gauge name to value.
+	Gauge(name string, value float64, tags []MetricTag)
+	// Histogram records a value observed for name.
+	Histogram(name string, value float64, tags []MetricTag)
+	// Timing records a duration observed for name.
+	Timing(name string, d time.Duration, tags []MetricTag)
+}
+
+// MetricsConfig defines the config for the Metrics middleware.
+type MetricsConfig struct {
+	// Exporter sends the metrics.
+	Exporter MetricsExporter
+
+	// Prefix is prepended to the metric names, with a dot. Optional. Default value is
+	// "gin", e.g. "gin.requests".
+	Prefix string
+
+	// SkipPaths is an url path array whose requests are not recorded. Optional.
+	SkipPaths []string
+
+	// OpenTelemetry names and tags the metrics after the OpenTelemetry semantic
+	// conventions, e.g. http.server.duration tagged with http.route, Prefix being
+	// ignored, see OTelMetricRequestDuration. Optional.
+	OpenTelemetry bool
+}
+
+// metricNames are the names of the metrics and of their tags. The metrics with an empty
+// name are not recorded.
+type metricNames struct {
+	requests, duration, responseSize, inFlight string
+	method, route, status                      string
+	// inFlightMethod tags the number of requests being served with the method.
+	inFlightMethod bool
+}
+
+// Metrics returns a middleware recording the per-route metrics of the requests to
+// exporter, see MetricsWithConfig.
+func Metrics(exporter MetricsExporter) HandlerFunc {
+	return MetricsWithConfig(MetricsConfig{Exporter: exporter})
+}
+
+// MetricsWithConfig returns a middleware recording the metrics of the requests: their
+// number, MetricRequests, their duration, MetricRequestDuration, and the size of their
+// response, MetricResponseSize, tagged with the method, the route and the status, and
+// the number of requests being served, MetricInFlight.
+//
+//	statsd, err := gin.NewStatsDExporter(gin.StatsDConfig{Address: "127.0.0.1:8125"})
+//	if err != nil {
+//		log.Fatal(err)
+//	}
+//	defer statsd.Close()
+//	router.Use(gin.Metrics(statsd))
+func MetricsWithConfig(conf MetricsConfig) HandlerFunc {
+	assert1(conf.Exporter != nil, "metrics exporter can not be nil")
+	names := otelMetricNames
+	if !conf.OpenTelemetry {
+		prefix := conf.Prefix
+		if prefix == "" {
+			prefix = "gin"
+		}
+		prefix += "."
+		names = metricNames{
+			requests:     prefix + MetricRequests,
+			duration:     prefix + MetricRequestDuration,
+			responseSize: prefix + MetricResponseSize,
+			inFlight:     prefix + MetricInFlight,
+			method:       "method",
+			route:        "route",
+			status:       "status",
+		}
+	}
+	var skip map[string]struct{}
+	if length := len(conf.SkipPaths); length > 0 {
+		skip = make(map[string]struct{}, length)
+		for _, path := range conf.SkipPaths {
+			skip[path] = struct{}{}
+		}
+	}
+	var inFlight int64
+
+	return func(c *Context) {
+		if _, ok := skip[c.Request.URL.Path]; ok {
+			c.Next()
+			return
+		}
+		start := time.Now()
+		var inFlightTags []MetricTag
+		if names.inFlightMethod {
+			inFlightTags = []MetricTag{{Name: names.method, Value: c.Request.Method}}
+		}
+		conf.Exporter.Gauge(names.inFlight, float64(atomic.AddInt64(&inFlight, 1)), inFlightTags)
+
+		c.Next()
+
+		conf.Exporter.Gauge(names.inFlight, float64(atomic.AddInt64(&inFlight, -1)), inFlightTags)
+		route, _ := c.RouteLabels()
+		tags := []MetricTag{
+			{Name: names.method, Value: c.Request.Method},
+			{Name: names.route, Value: route},
+			{Name: names.status, Value: strconv.Itoa(c.Writer.Status())},
+		}
+		if names.requests != "" {
+			conf.Exporter.Count(names.requests, 1, tags)
+		}
+		conf.Exporter.Timing(names.duration, time.Since(start), tags)
+		size := c.Writer.Size()
+		if size < 0 {
+			size = 0
+		}
+		conf.Exporter.Histogram(names.responseSize, float64(size), tags)
+	}
+}
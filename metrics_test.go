@@ -0,0 +1,89 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingExporter struct {
+	mu      sync.Mutex
+	metrics []string
+}
+
+func (e *recordingExporter) record(typ, name string, value any, tags []MetricTag) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.metrics = append(e.metrics, fmt.Sprintf("%s %s %v %v", typ, name, value, tags))
+}
+
+func (e *recordingExporter) Count(name string, value int64, tags []MetricTag) {
+	e.record("count", name, value, tags)
+}
+
+func (e *recordingExporter) Gauge(name string, value float64, tags []MetricTag) {
+	e.record("gauge", name, value, tags)
+}
+
+func (e *recordingExporter) Histogram(name string, value float64, tags []MetricTag) {
+	e.record("histogram", name, value, tags)
+}
+
+func (e *recordingExporter) Timing(name string, d time.Duration, tags []MetricTag) {
+	e.record("timing", name, d > 0, tags)
+}
+
+func TestMetrics(t *testing.T) {
+	exporter := &recordingExporter{}
+	router := New()
+	router.Use(Metrics(exporter))
+	router.GET("/users/:id", func(c *Context) {
+		c.String(http.StatusOK, "user")
+	})
+
+	PerformRequest(router, http.MethodGet, "/users/42")
+	assert.Equal(t, []string{
+		"gauge gin.requests.in_flight 1 []",
+		"gauge gin.requests.in_flight 0 []",
+		"count gin.requests 1 [{method GET} {route /users/:id} {status 200}]",
+		"timing gin.request.duration true [{method GET} {route /users/:id} {status 200}]",
+		"histogram gin.response.size 4 [{method GET} {route /users/:id} {status 200}]",
+	}, exporter.metrics)
+
+	exporter.metrics = nil
+	PerformRequest(router, http.MethodGet, "/unknown/path")
+	assert.Contains(t, exporter.metrics, "count gin.requests 1 [{method GET} {route unmatched} {status 404}]")
+}
+
+func TestMetricsWithConfig(t *testing.T) {
+	exporter := &recordingExporter{}
+	router := New()
+	router.Use(MetricsWithConfig(MetricsConfig{
+		Exporter:  exporter,
+		Prefix:    "api.http",
+		SkipPaths: []string{"/healthz"},
+	}))
+	router.GET("/healthz", func(c *Context) {})
+	router.POST("/items", func(c *Context) {
+		c.Status(http.StatusCreated)
+	})
+
+	PerformRequest(router, http.MethodGet, "/healthz")
+	assert.Empty(t, exporter.metrics)
+
+	PerformRequest(router, http.MethodPost, "/items")
+	assert.Contains(t, exporter.metrics, "count api.http.requests 1 [{method POST} {route /items} {status 201}]")
+	assert.Contains(t, exporter.metrics, "histogram api.http.response.size 0 [{method POST} {route /items} {status 201}]")
+
+	assert.PanicsWithValue(t, "metrics exporter can not be nil", func() {
+		Metrics(nil)
+	})
+}
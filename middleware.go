@@ -0,0 +1,232 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import "strings"
+
+// Skipper is a function deciding whether a middleware is skipped for the current request.
+// It is evaluated after route resolution, so c.FullPath() is available.
+type Skipper func(c *Context) bool
+
+// SkipWhen returns a middleware that runs middleware unless skipper returns true.
+func SkipWhen(skipper Skipper, middleware HandlerFunc) HandlerFunc {
+	return func(c *Context) {
+		if skipper(c) {
+			return
+		}
+		middleware(c)
+	}
+}
+
+// Skip returns a middleware that runs h unless the matched route template or the request
+// path is one of paths, e.g. to exclude health checks from the logs:
+//
+//	router.Use(gin.Logger().Skip("/healthz", "/static/*filepath"))
+func (h HandlerFunc) Skip(paths ...string) HandlerFunc {
+	skip := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		skip[path] = struct{}{}
+	}
+	return SkipWhen(func(c *Context) bool {
+		if _, ok := skip[c.FullPath()]; ok {
+			return true
+		}
+		_, ok := skip[c.Request.URL.Path]
+		return ok
+	}, h)
+}
+
+// UseWhen attaches middleware to the group that only run when cond returns true.
+// cond is evaluated after route resolution, so c.FullPath() is available.
+func (group *RouterGroup) UseWhen(cond func(c *Context) bool, middleware ...HandlerFunc) IRoutes {
+	return group.Use(conditionalMiddleware(cond, middleware)...)
+}
+
+// UseWhen attaches global middleware that only run when cond returns true.
+// See RouterGroup.UseWhen.
+func (engine *Engine) UseWhen(cond func(c *Context) bool, middleware ...HandlerFunc) IRoutes {
+	return engine.Use(conditionalMiddleware(cond, middleware)...)
+}
+
+func conditionalMiddleware(cond func(c *Context) bool, middleware HandlersChain) HandlersChain {
+	wrapped := make(HandlersChain, len(middleware))
+	for i, h := range middleware {
+		wrapped[i] = SkipWhen(func(c *Context) bool { return !cond(c) }, h)
+	}
+	return wrapped
+}
+
+// NamedMiddleware is a middleware registered on the engine under a unique name, see
+// Engine.RegisterMiddleware. Before and After list the names of the middleware it must
+// run before or after when both are used by a group; names that are not used are ignored.
+type NamedMiddleware struct {
+	Name    string
+	Handler HandlerFunc
+	Before  []string
+	After   []string
+}
+
+// RegisterMiddleware registers named middleware, to be attached to groups with UseNamed.
+// It panics if a name is empty or already registered.
+func (engine *Engine) RegisterMiddleware(middleware ...NamedMiddleware) {
+	if engine.namedMiddleware == nil {
+		engine.namedMiddleware = make(map[string]NamedMiddleware)
+	}
+	for _, m := range middleware {
+		assert1(m.Name != "", "middleware name can not be empty")
+		assert1(m.Handler != nil, "middleware handler can not be nil")
+		if _, ok := engine.namedMiddleware[m.Name]; ok {
+			panic("middleware '" + m.Name + "' is already registered")
+		}
+		engine.namedMiddleware[m.Name] = m
+	}
+}
+
+// UseNamed attaches registered middleware to the group by name. A name already used by the
+// group or one of its parents is ignored, so groups sharing helpers never run a middleware
+// twice. Named middleware run after the unnamed ones of the group, and before the handlers
+// of its child groups, in the order they were first used, adjusted to satisfy their Before
+// and After constraints with the other named middleware of the group.
+// It panics if a name is not registered or if the constraints contain a cycle.
+func (group *RouterGroup) UseNamed(names ...string) IRoutes {
+	for _, name := range names {
+		if _, ok := group.engine.namedMiddleware[name]; !ok {
+			panic("middleware '" + name + "' is not registered")
+		}
+		if !containsString(group.named, name) {
+			group.named = append(group.named, name)
+		}
+	}
+	group.namedChain = group.engine.resolveNamedMiddleware(group.named[group.namedFrom:])
+	return group.returnObj()
+}
+
+// UseNamed attaches registered middleware globally by name. See RouterGroup.UseNamed.
+func (engine *Engine) UseNamed(names ...string) IRoutes {
+	engine.RouterGroup.UseNamed(names...)
+	engine.rebuild404Handlers()
+	engine.rebuild405Handlers()
+	engine.rebuildFallbackHandlers()
+	return engine
+}
+
+// resolveNamedMiddleware returns the handlers of the named middleware ordered to satisfy
+// their constraints. Among the middleware free to run, the one used first runs first,
+// so the resulting order is deterministic.
+func (engine *Engine) resolveNamedMiddleware(names []string) HandlersChain {
+	if len(names) == 0 {
+		return nil
+	}
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[name] = i
+	}
+	successors := make([][]int, len(names))
+	pending := make([]int, len(names))
+	addEdge := func(from, to int) {
+		successors[from] = append(successors[from], to)
+		pending[to]++
+	}
+	for i, name := range names {
+		m := engine.namedMiddleware[name]
+		for _, before := range m.Before {
+			if j, ok := index[before]; ok {
+				addEdge(i, j)
+			}
+		}
+		for _, after := range m.After {
+			if j, ok := index[after]; ok {
+				addEdge(j, i)
+			}
+		}
+	}
+
+	handlers := make(HandlersChain, 0, len(names))
+	done := make([]bool, len(names))
+	for len(handlers) < len(names) {
+		next := -1
+		for i := range names {
+			if !done[i] && pending[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			var cycle []string
+			for i, name := range names {
+				if !done[i] {
+					cycle = append(cycle, name)
+				}
+			}
+			panic("middleware ordering constraints contain a cycle between " + strings.Join(cycle, ", "))
+		}
+		done[next] = true
+		for _, j := range successors[next] {
+			pending[j]--
+		}
+		handlers = append(handlers, engine.namedMiddleware[names[next]].Handler)
+	}
+	return handlers
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// AppendMiddleware adds middleware to a route that is already registered, e.g. so plugins
+// can attach cross-cutting features to existing routes. The middleware run after the ones
+// the route was registered with, right before its final handler. path is the route path
+// as registered, such as /users/:id. It panics if the route does not exist and, like the
+// other registration methods, must not be called while the engine is serving requests.
+func (engine *Engine) AppendMiddleware(method, path string, middleware ...HandlerFunc) {
+	assert1(!engine.frozen, "middleware can not be appended once the engine is frozen")
+	n := engine.routeNode(method, path)
+	if n == nil {
+		panic("route " + method + " " + path + " is not registered")
+	}
+	last := len(n.handlers) - 1
+	finalSize := len(n.handlers) + len(middleware)
+	assert1(finalSize < int(abortIndex), "too many handlers")
+	handlers := make(HandlersChain, 0, finalSize)
+	handlers = append(handlers, n.handlers[:last]...)
+	handlers = append(handlers, middleware...)
+	n.handlers = append(handlers, n.handlers[last])
+	engine.indexStaticRoute(method, path, n.handlers)
+}
+
+// OnlyInDebug returns a middleware that runs middleware only while gin is in debug mode,
+// e.g. for debug-only tooling such as body dumps.
+func OnlyInDebug(middleware HandlerFunc) HandlerFunc {
+	return SkipWhen(func(*Context) bool { return !IsDebugging() }, middleware)
+}
+
+// OnlyInRelease returns a middleware that runs middleware only while gin is in release mode.
+func OnlyInRelease(middleware HandlerFunc) HandlerFunc {
+	return SkipWhen(func(*Context) bool { return Mode() != ReleaseMode }, middleware)
+}
+
+// UseIf attaches middleware to the group only if cond is true. Unlike UseWhen, cond is
+// evaluated once, at registration:
+//
+//	router.UseIf(os.Getenv("TRACE") != "", tracing())
+func (group *RouterGroup) UseIf(cond bool, middleware ...HandlerFunc) IRoutes {
+	if !cond {
+		return group.returnObj()
+	}
+	return group.Use(middleware...)
+}
+
+// UseIf attaches global middleware only if cond is true. See RouterGroup.UseIf.
+func (engine *Engine) UseIf(cond bool, middleware ...HandlerFunc) IRoutes {
+	if !cond {
+		return engine
+	}
+	return engine.Use(middleware...)
+}
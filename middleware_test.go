@@ -251,3 +251,168 @@ func TestMiddlewareWrite(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 	assert.Equal(t, strings.Replace("hola\n<map><foo>bar</foo></map>{\"foo\":\"bar\"}{\"foo\":\"bar\"}event:test\ndata:message\n\n", " ", "", -1), strings.Replace(w.Body.String(), " ", "", -1))
 }
+
+func TestMiddlewareSkip(t *testing.T) {
+	signature := ""
+	router := New()
+	router.Use(HandlerFunc(func(c *Context) {
+		signature += "L"
+		c.Next()
+		signature += "l"
+	}).Skip("/healthz", "/users/:id"))
+	router.GET("/healthz", func(c *Context) { signature += "H" })
+	router.GET("/users/:id", func(c *Context) { signature += "U" })
+	router.GET("/orders", func(c *Context) { signature += "O" })
+	router.NoRoute(func(c *Context) { signature += "N" })
+
+	PerformRequest(router, http.MethodGet, "/healthz")
+	PerformRequest(router, http.MethodGet, "/users/42")
+	PerformRequest(router, http.MethodGet, "/orders")
+	PerformRequest(router, http.MethodGet, "/missing")
+	assert.Equal(t, "HULOlLNl", signature)
+}
+
+func TestMiddlewareUseWhen(t *testing.T) {
+	signature := ""
+	router := New()
+	router.UseWhen(func(c *Context) bool {
+		return strings.HasPrefix(c.FullPath(), "/api")
+	}, func(c *Context) {
+		signature += "A"
+	}, func(c *Context) {
+		signature += "B"
+	})
+	admin := router.Group("/admin")
+	admin.UseWhen(func(c *Context) bool {
+		return c.Query("debug") == "1"
+	}, func(c *Context) {
+		signature += "D"
+	})
+	router.GET("/api/users", func(c *Context) { signature += "U" })
+	admin.GET("/stats", func(c *Context) { signature += "S" })
+	router.NoRoute(func(c *Context) { signature += "N" })
+
+	PerformRequest(router, http.MethodGet, "/api/users")
+	PerformRequest(router, http.MethodGet, "/admin/stats")
+	PerformRequest(router, http.MethodGet, "/admin/stats?debug=1")
+	PerformRequest(router, http.MethodGet, "/api/missing")
+	assert.Equal(t, "ABUSDSN", signature)
+}
+
+func TestNamedMiddleware(t *testing.T) {
+	var order []string
+	track := func(name string) HandlerFunc {
+		return func(c *Context) { order = append(order, name) }
+	}
+
+	router := New()
+	router.RegisterMiddleware(
+		NamedMiddleware{Name: "auth", Handler: track("auth"), After: []string{"session"}},
+		NamedMiddleware{Name: "session", Handler: track("session")},
+		NamedMiddleware{Name: "audit", Handler: track("audit"), Before: []string{"auth", "missing"}},
+	)
+	router.Use(track("logger"))
+	router.UseNamed("session")
+
+	api := router.Group("/api")
+	api.Use(track("api"))
+	api.UseNamed("auth", "session")
+	admin := api.Group("/admin")
+	admin.Use(track("admin"))
+	admin.UseNamed("audit", "auth")
+	admin.GET("/users", track("handler"))
+	internal := router.Group("/internal", track("internal"))
+	internal.UseNamed("auth", "audit")
+	internal.GET("/stats", track("handler"))
+	router.GET("/", track("handler"))
+
+	PerformRequest(router, http.MethodGet, "/api/admin/users")
+	assert.Equal(t, []string{"logger", "session", "api", "auth", "admin", "audit", "handler"}, order)
+
+	order = nil
+	PerformRequest(router, http.MethodGet, "/internal/stats")
+	assert.Equal(t, []string{"logger", "session", "internal", "audit", "auth", "handler"}, order)
+
+	order = nil
+	PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, []string{"logger", "session", "handler"}, order)
+
+	order = nil
+	PerformRequest(router, http.MethodGet, "/missing")
+	assert.Equal(t, []string{"logger", "session"}, order)
+
+	assert.Panics(t, func() { router.UseNamed("unknown") })
+	assert.Panics(t, func() { router.RegisterMiddleware(NamedMiddleware{Name: "auth", Handler: track("auth")}) })
+	assert.Panics(t, func() { router.RegisterMiddleware(NamedMiddleware{Handler: track("noname")}) })
+}
+
+func TestNamedMiddlewareCycle(t *testing.T) {
+	router := New()
+	router.RegisterMiddleware(
+		NamedMiddleware{Name: "a", Handler: func(c *Context) {}, Before: []string{"b"}},
+		NamedMiddleware{Name: "b", Handler: func(c *Context) {}, Before: []string{"a"}},
+	)
+	router.UseNamed("a")
+	assert.PanicsWithValue(t, "middleware ordering constraints contain a cycle between a, b", func() {
+		router.UseNamed("b")
+	})
+}
+
+func TestAppendMiddleware(t *testing.T) {
+	var order []string
+	track := func(name string) HandlerFunc {
+		return func(c *Context) { order = append(order, name) }
+	}
+
+	router := New()
+	router.Use(track("global"))
+	router.GET("/users/:id", track("route"), track("handler"))
+	router.GET("/users/:id/posts", track("handler"))
+
+	router.AppendMiddleware(http.MethodGet, "/users/:id", track("auth"), track("audit"))
+	PerformRequest(router, http.MethodGet, "/users/1")
+	assert.Equal(t, []string{"global", "route", "auth", "audit", "handler"}, order)
+
+	order = nil
+	PerformRequest(router, http.MethodGet, "/users/1/posts")
+	assert.Equal(t, []string{"global", "handler"}, order)
+
+	assert.Len(t, router.routeNode(http.MethodGet, "/users/:id").handlers, 5)
+	assert.Panics(t, func() { router.AppendMiddleware(http.MethodPost, "/users/:id", track("auth")) })
+	assert.Panics(t, func() { router.AppendMiddleware(http.MethodGet, "/users/1", track("auth")) })
+}
+
+func TestOnlyInMode(t *testing.T) {
+	var ran []string
+	router := New()
+	router.Use(OnlyInDebug(func(c *Context) { ran = append(ran, "debug") }))
+	router.Use(OnlyInRelease(func(c *Context) { ran = append(ran, "release") }))
+	router.GET("/", func(c *Context) {})
+
+	defer SetMode(TestMode)
+	SetMode(DebugMode)
+	PerformRequest(router, http.MethodGet, "/")
+	SetMode(ReleaseMode)
+	PerformRequest(router, http.MethodGet, "/")
+	SetMode(TestMode)
+	PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, []string{"debug", "release"}, ran)
+}
+
+func TestUseIf(t *testing.T) {
+	var ran []string
+	track := func(name string) HandlerFunc {
+		return func(c *Context) { ran = append(ran, name) }
+	}
+	router := New()
+	router.UseIf(true, track("global"))
+	router.UseIf(false, track("skipped"))
+	group := router.Group("/api")
+	group.UseIf(true, track("group"))
+	group.UseIf(false, track("skipped"))
+	group.GET("/", func(c *Context) {})
+
+	PerformRequest(router, http.MethodGet, "/api/")
+	assert.Equal(t, []string{"global", "group"}, ran)
+	assert.Len(t, router.Handlers, 1)
+}
@@ -8,6 +8,7 @@ import (
 	"flag"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/gin-gonic/gin/binding"
 )
@@ -50,6 +51,9 @@ var (
 func init() {
 	mode := os.Getenv(EnvGinMode)
 	SetMode(mode)
+	if categories, ok := os.LookupEnv(EnvGinDebugCategories); ok {
+		SetDebugCategories(strings.FieldsFunc(categories, func(r rune) bool { return r == ',' || r == ' ' })...)
+	}
 }
 
 // SetMode sets gin mode according to input string.
@@ -74,6 +78,7 @@ func SetMode(value string) {
 	}
 
 	modeName = value
+	updateDebugOutput()
 }
 
 // DisableBindValidation closes the default validator.
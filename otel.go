@@ -0,0 +1,76 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import "strings"
+
+// Names of the metrics recorded by the Metrics middleware with MetricsConfig.OpenTelemetry,
+// after the OpenTelemetry semantic conventions of the HTTP servers. The number of requests
+// is the count of the duration histogram.
+const (
+	OTelMetricRequestDuration = "http.server.duration"
+	OTelMetricResponseSize    = "http.server.response.size"
+	OTelMetricActiveRequests  = "http.server.active_requests"
+)
+
+var otelMetricNames = metricNames{
+	duration:       OTelMetricRequestDuration,
+	responseSize:   OTelMetricResponseSize,
+	inFlight:       OTelMetricActiveRequests,
+	method:         "http.method",
+	route:          "http.route",
+	status:         "http.status_code",
+	inFlightMethod: true,
+}
+
+// TraceContextFunc returns the hex-encoded IDs of the trace and of the span of the
+// request, or empty strings if it is not traced.
+type TraceContextFunc func(c *Context) (traceID, spanID string)
+
+// TraceParent is a TraceContextFunc reading the W3C traceparent header of the request,
+// the span being the one of the caller. The tracing libraries starting a span for the
+// request, e.g. OpenTelemetry, provide their own:
+//
+//	func(c *gin.Context) (string, string) {
+//		sc := trace.SpanContextFromContext(c.Request.Context())
+//		if !sc.IsValid() {
+//			return "", ""
+//		}
+//		return sc.TraceID().String(), sc.SpanID().String()
+//	}
+func TraceParent(c *Context) (traceID, spanID string) {
+	traceID, spanID, _ = parseTraceParent(c.requestHeader("traceparent"))
+	return traceID, spanID
+}
+
+// parseTraceParent parses a traceparent header, "version-traceid-spanid-flags", e.g.
+// "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01".
+func parseTraceParent(header string) (traceID, spanID string, ok bool) {
+	parts := strings.SplitN(strings.TrimSpace(header), "-", 5)
+	if len(parts) < 4 || !isLowerHex(parts[0], 2) || parts[0] == "ff" ||
+		!isLowerHex(parts[1], 32) || !isLowerHex(parts[2], 16) || !isLowerHex(parts[3], 2) {
+		return "", "", false
+	}
+	if parts[0] == "00" && len(parts) > 4 {
+		return "", "", false
+	}
+	if strings.Trim(parts[1], "0") == "" || strings.Trim(parts[2], "0") == "" {
+		return "", "", false
+	}
+	return parts[1], parts[2], true
+}
+
+// isLowerHex reports whether s is made of n lowercase hexadecimal digits.
+func isLowerHex(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if (s[i] < '0' || s[i] > '9') && (s[i] < 'a' || s[i] > 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,86 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testTraceParent = "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+
+func TestParseTraceParent(t *testing.T) {
+	traceID, spanID, ok := parseTraceParent(testTraceParent)
+	assert.True(t, ok)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", traceID)
+	assert.Equal(t, "00f067aa0ba902b7", spanID)
+
+	_, _, ok = parseTraceParent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-future")
+	assert.True(t, ok)
+
+	for _, header := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-1",
+	} {
+		_, _, ok := parseTraceParent(header)
+		assert.False(t, ok, header)
+	}
+}
+
+func TestLoggerTraceContext(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	router := New()
+	router.Use(LoggerWithConfig(LoggerConfig{Output: buffer, Formatter: JSONLogFormatter}))
+	router.GET("/", func(c *Context) {})
+
+	PerformRequest(router, http.MethodGet, "/", header{"traceparent", testTraceParent})
+	assert.Contains(t, buffer.String(), `"trace_id":"4bf92f3577b34da6a3ce929d0e0e4736","span_id":"00f067aa0ba902b7"`)
+
+	buffer.Reset()
+	PerformRequest(router, http.MethodGet, "/")
+	assert.NotContains(t, buffer.String(), "trace_id")
+
+	buffer.Reset()
+	router = New()
+	router.Use(LoggerWithConfig(LoggerConfig{
+		Output:    buffer,
+		Formatter: LogfmtLogFormatter,
+		TraceContext: func(c *Context) (string, string) {
+			return c.GetString("trace"), "span"
+		},
+	}))
+	router.GET("/", func(c *Context) {
+		c.Set("trace", "started-by-handler")
+	})
+	PerformRequest(router, http.MethodGet, "/", header{"traceparent", testTraceParent})
+	assert.Contains(t, buffer.String(), " trace_id=started-by-handler span_id=span\n")
+}
+
+func TestMetricsOpenTelemetry(t *testing.T) {
+	exporter := &recordingExporter{}
+	router := New()
+	router.Use(MetricsWithConfig(MetricsConfig{Exporter: exporter, Prefix: "ignored", OpenTelemetry: true}))
+	router.GET("/users/:id", func(c *Context) {
+		c.String(http.StatusOK, "user")
+	})
+
+	PerformRequest(router, http.MethodGet, "/users/42")
+	assert.Equal(t, []string{
+		"gauge http.server.active_requests 1 [{http.method GET}]",
+		"gauge http.server.active_requests 0 [{http.method GET}]",
+		"timing http.server.duration true [{http.method GET} {http.route /users/:id} {http.status_code 200}]",
+		"histogram http.server.response.size 4 [{http.method GET} {http.route /users/:id} {http.status_code 200}]",
+	}, exporter.metrics)
+}
@@ -0,0 +1,27 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+// SetPathCleaner sets the function normalizing the request paths looked up by
+// RedirectFixedPath, and all the request paths when RemoveExtraSlash is enabled, instead of
+// the built-in cleaning which removes the repeated slashes and resolves the . and ..
+// elements. It lets the deployments whose URLs give a meaning to double slashes or matrix
+// params keep these features. The redirects then use the path returned by the cleaner as
+// is. The cleaner must return a path beginning with '/'. Passing nil restores the built-in
+// cleaning.
+//
+//	router.SetPathCleaner(func(p string) string {
+//	    return strings.TrimSuffix(p, "/.")
+//	})
+func (engine *Engine) SetPathCleaner(cleaner func(string) string) {
+	engine.pathCleaner = cleaner
+}
+
+func (engine *Engine) cleanPath(p string) string {
+	if engine.pathCleaner != nil {
+		return engine.pathCleaner(p)
+	}
+	return cleanPath(p)
+}
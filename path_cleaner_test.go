@@ -0,0 +1,48 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetPathCleaner(t *testing.T) {
+	router := New()
+	router.RedirectFixedPath = true
+	router.GET("/proxy/*url", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.Param("url"))
+	})
+	router.GET("/users/:id", func(c *Context) {})
+
+	// the built-in cleaning merges the double slashes
+	w := PerformRequest(router, http.MethodGet, "/PROXY/http://example.com")
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/proxy/http:/example.com", w.Header().Get("Location"))
+
+	// lowercase the path only, keeping the double slashes
+	router.SetPathCleaner(func(p string) string {
+		return "/" + strings.TrimLeft(p, "/")
+	})
+	w = PerformRequest(router, http.MethodGet, "/PROXY/http://example.com")
+	assert.Equal(t, "/proxy/http://example.com", w.Header().Get("Location"))
+	w = PerformRequest(router, http.MethodGet, "/USERS/../users/42")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	router.RemoveExtraSlash = true
+	w = PerformRequest(router, http.MethodGet, "//proxy/http://example.com")
+	assert.Equal(t, "/http://example.com", w.Body.String())
+	route, _, _ := router.Lookup(http.MethodGet, "//users/42")
+	assert.Equal(t, "/users/:id", route.Path)
+
+	router.RemoveExtraSlash = false
+	router.SetPathCleaner(nil)
+	w = PerformRequest(router, http.MethodGet, "/USERS/../users/42")
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/users/42", w.Header().Get("Location"))
+}
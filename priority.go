@@ -0,0 +1,45 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+// PriorityMetaKey is the route metadata key holding the Priority of the route, see
+// RouterGroup.WithPriority.
+const PriorityMetaKey = "_gin-gonic/gin/priority"
+
+// Priority tells the load shedding middleware, the WorkerPool and the AdaptiveLimiter,
+// which requests to favor under pressure.
+type Priority int
+
+const (
+	// PriorityLow is for the bulk endpoints, shed first.
+	PriorityLow Priority = -1
+	// PriorityNormal is the priority of the routes without one.
+	PriorityNormal Priority = 0
+	// PriorityHigh is for the endpoints served before the others.
+	PriorityHigh Priority = 1
+	// PriorityCritical is for the health checks and admin endpoints that must stay
+	// responsive, they are never shed: the load shedders always admit them, even past
+	// their limits.
+	PriorityCritical Priority = 2
+)
+
+// WithPriority returns a group, with the same path and middleware, whose routes have the
+// priority p.
+//
+//	router.WithPriority(gin.PriorityCritical).GET("/healthz", healthz)
+//	router.WithPriority(gin.PriorityLow).POST("/export", export)
+func (group *RouterGroup) WithPriority(p Priority) *RouterGroup {
+	return group.WithMeta(PriorityMetaKey, p)
+}
+
+// Priority returns the priority of the matched route, PriorityNormal if it has none.
+func (c *Context) Priority() Priority {
+	if p, ok := c.RouteMeta(PriorityMetaKey); ok {
+		if p, ok := p.(Priority); ok {
+			return p
+		}
+	}
+	return PriorityNormal
+}
@@ -0,0 +1,134 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextPriority(t *testing.T) {
+	router := New()
+	router.GET("/", func(c *Context) {
+		assert.Equal(t, PriorityNormal, c.Priority())
+	})
+	router.WithPriority(PriorityCritical).GET("/healthz", func(c *Context) {
+		assert.Equal(t, PriorityCritical, c.Priority())
+	})
+	PerformRequest(router, http.MethodGet, "/")
+	PerformRequest(router, http.MethodGet, "/healthz")
+
+	routes := router.Routes()
+	assert.Equal(t, PriorityCritical, routes[1].Meta[PriorityMetaKey])
+}
+
+func TestAdaptiveLimiterPriority(t *testing.T) {
+	limiter := NewAdaptiveLimiter(AdaptiveLimitConfig{InitialLimit: 4, MaxLimit: 4})
+	for i := 0; i < 3; i++ {
+		assert.True(t, limiter.acquire(PriorityLow))
+	}
+	assert.False(t, limiter.acquire(PriorityLow))
+	assert.True(t, limiter.acquire(PriorityNormal))
+	assert.False(t, limiter.acquire(PriorityHigh))
+	assert.True(t, limiter.acquire(PriorityCritical))
+	assert.Equal(t, 5, limiter.InFlight())
+}
+
+func newPriorityPoolRouter(pool *WorkerPool, release chan struct{}, order *[]string) *Engine {
+	var mu sync.Mutex
+	router := New()
+	router.Use(pool.Middleware())
+	handler := func(c *Context) {
+		mu.Lock()
+		*order = append(*order, c.FullPath())
+		mu.Unlock()
+		<-release
+	}
+	router.GET("/block", handler)
+	router.WithPriority(PriorityLow).GET("/low", handler)
+	router.GET("/normal", handler)
+	router.WithPriority(PriorityHigh).GET("/high", handler)
+	router.WithPriority(PriorityCritical).GET("/critical", handler)
+	return router
+}
+
+func TestWorkerPoolPriority(t *testing.T) {
+	pool := NewWorkerPool(WorkerPoolConfig{Workers: 1, QueueSize: 3})
+	defer pool.Close()
+	release := make(chan struct{})
+	var order []string
+	router := newPriorityPoolRouter(pool, release, &order)
+
+	var responses []<-chan *httptest.ResponseRecorder
+	for i, path := range []string{"/block", "/low", "/normal", "/high"} {
+		responses = append(responses, performAsync(router, path))
+		waitPoolStats(t, pool, 1, i)
+	}
+	close(release)
+	for _, w := range responses {
+		assert.Equal(t, http.StatusOK, (<-w).Code)
+	}
+	assert.Equal(t, []string{"/block", "/high", "/normal", "/low"}, order)
+}
+
+func TestWorkerPoolPriorityShedding(t *testing.T) {
+	pool := NewWorkerPool(WorkerPoolConfig{Workers: 1, QueueSize: 1})
+	defer pool.Close()
+	release := make(chan struct{})
+	var order []string
+	router := newPriorityPoolRouter(pool, release, &order)
+
+	block := performAsync(router, "/block")
+	waitPoolStats(t, pool, 1, 0)
+	normal := performAsync(router, "/normal")
+	waitPoolStats(t, pool, 1, 1)
+
+	w := PerformRequest(router, http.MethodGet, "/low")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	high := performAsync(router, "/high")
+	assert.Equal(t, http.StatusServiceUnavailable, (<-normal).Code)
+
+	close(release)
+	assert.Equal(t, http.StatusOK, (<-block).Code)
+	assert.Equal(t, http.StatusOK, (<-high).Code)
+	assert.Equal(t, []string{"/block", "/high"}, order)
+}
+
+func TestWorkerPoolPriorityCritical(t *testing.T) {
+	pool := NewWorkerPool(WorkerPoolConfig{Workers: 1, QueueSize: 1, QueueTimeout: 10 * time.Millisecond})
+	defer pool.Close()
+	release := make(chan struct{})
+	var order []string
+	router := newPriorityPoolRouter(pool, release, &order)
+
+	block := performAsync(router, "/block")
+	waitPoolStats(t, pool, 1, 0)
+	var critical []<-chan *httptest.ResponseRecorder
+	for i := 1; i <= 3; i++ {
+		critical = append(critical, performAsync(router, "/critical"))
+		waitPoolStats(t, pool, 1, i)
+	}
+
+	w := PerformRequest(router, http.MethodGet, "/high")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+
+	// the critical requests outlive the queue timeout
+	time.Sleep(20 * time.Millisecond)
+	waitPoolStats(t, pool, 1, 3)
+
+	close(release)
+	assert.Equal(t, http.StatusOK, (<-block).Code)
+	for _, w := range critical {
+		assert.Equal(t, http.StatusOK, (<-w).Code)
+	}
+	assert.Equal(t, []string{"/block", "/critical", "/critical", "/critical"}, order)
+	assert.Equal(t, uint64(1), pool.Stats().Rejected)
+}
@@ -0,0 +1,87 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"runtime/pprof"
+)
+
+// Names of the profiler labels set by the ProfileLabels middleware.
+const (
+	ProfileLabelRoute  = "http.route"
+	ProfileLabelMethod = "http.method"
+)
+
+// profileLabelsKey is set on the requests labeled by the ProfileLabels middleware.
+const profileLabelsKey = "_gin-gonic/gin/profilelabels"
+
+// ProfileLabelsConfig defines the config for the ProfileLabels middleware.
+type ProfileLabelsConfig struct {
+	// Labels returns more labels of the request, as key and value pairs, e.g. its tenant.
+	// Optional.
+	Labels func(c *Context) []string
+
+	// SkipPaths is an url path array whose requests are not labeled. Optional.
+	SkipPaths []string
+}
+
+// ProfileLabels returns a middleware setting the runtime/pprof labels of the requests,
+// see ProfileLabelsWithConfig.
+func ProfileLabels() HandlerFunc {
+	return ProfileLabelsWithConfig(ProfileLabelsConfig{})
+}
+
+// ProfileLabelsWithConfig returns a middleware setting the runtime/pprof labels of the
+// requests, their route, ProfileLabelRoute, and their method, ProfileLabelMethod, while
+// the next handlers run, so that the CPU profiles attribute the time spent to the routes,
+// e.g. with "go tool pprof -tagfocus http.route=/users/:id". The goroutines started by
+// the handlers inherit the labels, and the labels are also set on the request context.
+//
+//	router.Use(gin.ProfileLabels())
+func ProfileLabelsWithConfig(conf ProfileLabelsConfig) HandlerFunc {
+	var skip map[string]struct{}
+	if length := len(conf.SkipPaths); length > 0 {
+		skip = make(map[string]struct{}, length)
+		for _, path := range conf.SkipPaths {
+			skip[path] = struct{}{}
+		}
+	}
+
+	return func(c *Context) {
+		if _, ok := skip[c.Request.URL.Path]; ok {
+			c.Next()
+			return
+		}
+		route, _ := c.RouteLabels()
+		labels := []string{ProfileLabelRoute, route, ProfileLabelMethod, c.Request.Method}
+		if conf.Labels != nil {
+			more := conf.Labels(c)
+			assert1(len(more)%2 == 0, "profile labels must be key and value pairs")
+			labels = append(labels, more...)
+		}
+		c.Set(profileLabelsKey, true)
+		pprof.Do(c.Request.Context(), pprof.Labels(labels...), func(ctx context.Context) {
+			c.Request = c.Request.WithContext(ctx)
+			c.Next()
+		})
+	}
+}
+
+// SetProfileLabels adds the runtime/pprof labels, as key and value pairs, to the request
+// context and, under the ProfileLabels middleware which restores them once the request is
+// served, to the current goroutine, e.g. to tell apart the streams of a long-running
+// streaming handler in the profiles. The goroutines started afterwards inherit them.
+//
+//	c.SetProfileLabels("stream", c.Param("channel"))
+//	c.Stream(func(w io.Writer) bool { ... })
+func (c *Context) SetProfileLabels(kv ...string) {
+	assert1(len(kv)%2 == 0, "profile labels must be key and value pairs")
+	ctx := pprof.WithLabels(c.Request.Context(), pprof.Labels(kv...))
+	c.Request = c.Request.WithContext(ctx)
+	if c.GetBool(profileLabelsKey) {
+		pprof.SetGoroutineLabels(ctx)
+	}
+}
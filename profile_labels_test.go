@@ -0,0 +1,81 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"net/http"
+	"runtime/pprof"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func profileLabels(c *Context) map[string]string {
+	labels := make(map[string]string)
+	pprof.ForLabels(c.Request.Context(), func(key, value string) bool {
+		labels[key] = value
+		return true
+	})
+	return labels
+}
+
+func TestProfileLabels(t *testing.T) {
+	var labels map[string]string
+	router := New()
+	router.Use(ProfileLabelsWithConfig(ProfileLabelsConfig{
+		Labels: func(c *Context) []string {
+			return []string{"tenant", c.GetHeader("X-Tenant")}
+		},
+		SkipPaths: []string{"/healthz"},
+	}))
+	handler := func(c *Context) {
+		labels = profileLabels(c)
+	}
+	router.GET("/users/:id", handler)
+	router.GET("/healthz", handler)
+	router.NoRoute(handler)
+
+	PerformRequest(router, http.MethodGet, "/users/42", header{"X-Tenant", "acme"})
+	assert.Equal(t, map[string]string{"http.route": "/users/:id", "http.method": "GET", "tenant": "acme"}, labels)
+
+	PerformRequest(router, http.MethodGet, "/healthz")
+	assert.Empty(t, labels)
+
+	PerformRequest(router, http.MethodGet, "/unknown")
+	assert.Equal(t, "unmatched", labels["http.route"])
+}
+
+func TestProfileLabelsGoroutines(t *testing.T) {
+	var profile bytes.Buffer
+	router := New()
+	router.Use(ProfileLabels())
+	router.GET("/stream/:channel", func(c *Context) {
+		c.SetProfileLabels("stream", c.Param("channel"))
+		started, done := make(chan struct{}), make(chan struct{})
+		go func() {
+			close(started)
+			<-done
+		}()
+		<-started
+		assert.NoError(t, pprof.Lookup("goroutine").WriteTo(&profile, 1))
+		close(done)
+	})
+
+	PerformRequest(router, http.MethodGet, "/stream/news")
+	assert.Contains(t, profile.String(), `"stream":"news"`)
+	assert.Contains(t, profile.String(), `"http.route":"/stream/:channel"`)
+}
+
+func TestSetProfileLabels(t *testing.T) {
+	c, _ := CreateTestContext(nil)
+	c.Request, _ = http.NewRequest(http.MethodGet, "/", nil)
+	c.SetProfileLabels("job", "export")
+	assert.Equal(t, map[string]string{"job": "export"}, profileLabels(c))
+
+	assert.PanicsWithValue(t, "profile labels must be key and value pairs", func() {
+		c.SetProfileLabels("job")
+	})
+}
@@ -0,0 +1,529 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// httpRuleField is the number of the google.api.http extension of the method options.
+const httpRuleField protowire.Number = 72295728
+
+// httpRule is a google.api.HttpRule, decoded without depending on its generated code.
+type httpRule struct {
+	method       string
+	path         string
+	body         string
+	responseBody string
+	additional   []httpRule
+}
+
+// parseHTTPRule decodes a google.api.HttpRule.
+func parseHTTPRule(b []byte) (httpRule, error) {
+	var rule httpRule
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return rule, protowire.ParseError(n)
+		}
+		b = b[n:]
+		if typ != protowire.BytesType {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return rule, protowire.ParseError(n)
+			}
+			b = b[n:]
+			continue
+		}
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return rule, protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch num {
+		case 2:
+			rule.method, rule.path = http.MethodGet, string(v)
+		case 3:
+			rule.method, rule.path = http.MethodPut, string(v)
+		case 4:
+			rule.method, rule.path = http.MethodPost, string(v)
+		case 5:
+			rule.method, rule.path = http.MethodDelete, string(v)
+		case 6:
+			rule.method, rule.path = http.MethodPatch, string(v)
+		case 7:
+			rule.body = string(v)
+		case 8:
+			custom, err := parseCustomHTTPPattern(v)
+			if err != nil {
+				return rule, err
+			}
+			rule.method, rule.path = custom.method, custom.path
+		case 11:
+			additional, err := parseHTTPRule(v)
+			if err != nil {
+				return rule, err
+			}
+			rule.additional = append(rule.additional, additional)
+		case 12:
+			rule.responseBody = string(v)
+		}
+	}
+	return rule, nil
+}
+
+// parseCustomHTTPPattern decodes a google.api.CustomHttpPattern.
+func parseCustomHTTPPattern(b []byte) (httpRule, error) {
+	var rule httpRule
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return rule, protowire.ParseError(n)
+		}
+		b = b[n:]
+		if typ != protowire.BytesType {
+			if n = protowire.ConsumeFieldValue(num, typ, b); n < 0 {
+				return rule, protowire.ParseError(n)
+			}
+			b = b[n:]
+			continue
+		}
+		v, n := protowire.ConsumeBytes(b)
+		if n < 0 {
+			return rule, protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch num {
+		case 1:
+			rule.method = string(v)
+		case 2:
+			rule.path = string(v)
+		}
+	}
+	return rule, nil
+}
+
+// methodHTTPRules returns the google.api.http rule of md, with its additional bindings,
+// or nil if it has none.
+func methodHTTPRules(md protoreflect.MethodDescriptor) ([]httpRule, error) {
+	opts := md.Options()
+	if opts == nil {
+		return nil, nil
+	}
+	b, err := proto.Marshal(opts)
+	if err != nil {
+		return nil, err
+	}
+	var raw []byte
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		if num == httpRuleField && typ == protowire.BytesType {
+			raw, _ = protowire.ConsumeBytes(b[:n])
+		}
+		b = b[n:]
+	}
+	if raw == nil {
+		return nil, nil
+	}
+	rule, err := parseHTTPRule(raw)
+	if err != nil {
+		return nil, err
+	}
+	return append([]httpRule{rule}, rule.additional...), nil
+}
+
+// protoPathParam is a path param bound to a field of the request message.
+type protoPathParam struct {
+	name     string
+	field    string
+	catchAll bool
+}
+
+// convertPathTemplate converts a google.api.http path template to a route path, e.g.
+// "/v1/shelves/{shelf}/books/{book.id}" to "/v1/shelves/:shelf/books/:book.id". Only the
+// variables matching a whole segment, "{field}" or "{field=*}", or the end of the path,
+// "{field=**}", are supported.
+func convertPathTemplate(template string) (string, []protoPathParam, error) {
+	if !strings.HasPrefix(template, "/") {
+		return "", nil, fmt.Errorf("path template %q must begin with '/'", template)
+	}
+	var sb strings.Builder
+	var params []protoPathParam
+	for i := 0; i < len(template); {
+		switch template[i] {
+		case '{':
+			end := strings.IndexByte(template[i:], '}')
+			if end < 0 {
+				return "", nil, fmt.Errorf("unterminated variable in path template %q", template)
+			}
+			field, pattern, _ := strings.Cut(template[i+1:i+end], "=")
+			i += end + 1
+			if field == "" || template[i-end-2] != '/' || (i < len(template) && template[i] != '/') {
+				return "", nil, fmt.Errorf("unsupported variable in path template %q", template)
+			}
+			switch pattern {
+			case "", "*":
+				sb.WriteString(":" + field)
+				params = append(params, protoPathParam{name: field, field: field})
+			case "**":
+				if i != len(template) {
+					return "", nil, fmt.Errorf("'**' variable must end path template %q", template)
+				}
+				sb.WriteString("*" + field)
+				params = append(params, protoPathParam{name: field, field: field, catchAll: true})
+			default:
+				return "", nil, fmt.Errorf("unsupported variable pattern %q in path template %q", pattern, template)
+			}
+		case ':', '*':
+			return "", nil, fmt.Errorf("unsupported verb or wildcard in path template %q", template)
+		default:
+			sb.WriteByte(template[i])
+			i++
+		}
+	}
+	return sb.String(), params, nil
+}
+
+var (
+	contextType = reflect.TypeOf((*Context)(nil))
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+	messageType = reflect.TypeOf((*proto.Message)(nil)).Elem()
+)
+
+// RegisterProtoService registers the routes of the methods of service annotated with the
+// google.api.http option, and their additional bindings, served by the method of impl
+// with the same name, e.g. the implementation of the gRPC server:
+//
+//	func (s *server) GetBook(ctx context.Context, req *pb.GetBookRequest) (*pb.Book, error)
+//
+//	router.RegisterProtoService(pb.File_library_proto.Services().ByName("Library"), &server{})
+//
+// The request message is read from the path params, the query string and the JSON body,
+// according to the body of the rule, and the response is written as JSON, or its
+// response_body field. The ctx of the calls is the *Context. Errors returned by impl are
+// handled by Context.Fail, and the invalid requests are aborted with a 400. Unsupported
+// path templates, missing or mistyped impl methods and route conflicts are reported as
+// errors, the methods without the option being skipped.
+func (group *RouterGroup) RegisterProtoService(service protoreflect.ServiceDescriptor, impl any) error {
+	assert1(service != nil && impl != nil, "service and implementation can not be nil")
+	v := reflect.ValueOf(impl)
+	methods := service.Methods()
+	for i := 0; i < methods.Len(); i++ {
+		md := methods.Get(i)
+		rules, err := methodHTTPRules(md)
+		if err != nil {
+			return fmt.Errorf("gin: %s: %w", md.FullName(), err)
+		}
+		if len(rules) == 0 {
+			continue
+		}
+		if md.IsStreamingClient() || md.IsStreamingServer() {
+			return fmt.Errorf("gin: %s: streaming methods are not supported", md.FullName())
+		}
+		method := v.MethodByName(string(md.Name()))
+		if !method.IsValid() {
+			return fmt.Errorf("gin: %s: %T has no method %s", md.FullName(), impl, md.Name())
+		}
+		mt := method.Type()
+		if mt.NumIn() != 2 || mt.NumOut() != 2 || !contextType.AssignableTo(mt.In(0)) ||
+			!mt.In(1).Implements(messageType) || !mt.Out(0).Implements(messageType) || mt.Out(1) != errorType {
+			return fmt.Errorf("gin: %s: method %s of %T must be func(context.Context, proto.Message) (proto.Message, error)",
+				md.FullName(), md.Name(), impl)
+		}
+		for _, rule := range rules {
+			if err := group.handleProtoRule(md, method, rule); err != nil {
+				return fmt.Errorf("gin: %s: %w", md.FullName(), err)
+			}
+		}
+	}
+	return nil
+}
+
+func (group *RouterGroup) handleProtoRule(md protoreflect.MethodDescriptor, method reflect.Value, rule httpRule) error {
+	if rule.method == "" {
+		return errors.New("http rule without pattern")
+	}
+	path, params, err := convertPathTemplate(rule.path)
+	if err != nil {
+		return err
+	}
+	for _, param := range params {
+		if _, err := protoFieldPath(md.Input(), param.field); err != nil {
+			return err
+		}
+	}
+	if rule.body != "" && rule.body != "*" {
+		fds, err := protoFieldPath(md.Input(), rule.body)
+		if err != nil {
+			return err
+		}
+		if fd := fds[len(fds)-1]; fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return fmt.Errorf("body field %q is not a message", rule.body)
+		}
+	}
+	if rule.responseBody != "" {
+		fds, err := protoFieldPath(md.Output(), rule.responseBody)
+		if err != nil {
+			return err
+		}
+		if len(fds) != 1 {
+			return fmt.Errorf("response body field %q must be a top-level field", rule.responseBody)
+		}
+	}
+
+	newRequest := protoMessageFactory(md.Input())
+	_, err = group.TryHandle(rule.method, path, func(c *Context) {
+		req := newRequest()
+		if err := bindProtoRequest(c, req, rule, params); err != nil {
+			c.AbortWithError(http.StatusBadRequest, err).SetType(ErrorTypeBind) // nolint: errcheck
+			return
+		}
+		in := reflect.ValueOf(req)
+		if !in.Type().AssignableTo(method.Type().In(1)) {
+			c.AbortWithError(http.StatusInternalServerError, // nolint: errcheck
+				fmt.Errorf("gin: %s: request of type %T is not a %s", md.FullName(), req, method.Type().In(1)))
+			return
+		}
+		out := method.Call([]reflect.Value{reflect.ValueOf(c), in})
+		if err, _ := out[1].Interface().(error); err != nil {
+			c.Fail(err)
+			return
+		}
+		resp, _ := out[0].Interface().(proto.Message)
+		if resp == nil {
+			resp = dynamicpb.NewMessage(md.Output())
+		}
+		writeProtoResponse(c, resp, rule.responseBody)
+	})
+	return err
+}
+
+// protoMessageFactory returns a function creating the messages of md, of their generated
+// type if registered.
+func protoMessageFactory(md protoreflect.MessageDescriptor) func() proto.Message {
+	if mt, err := protoregistry.GlobalTypes.FindMessageByName(md.FullName()); err == nil && mt.Descriptor() == md {
+		return func() proto.Message {
+			return mt.New().Interface()
+		}
+	}
+	return func() proto.Message {
+		return dynamicpb.NewMessage(md)
+	}
+}
+
+// protoFieldPath returns the descriptors of the fields of the dot-separated path.
+func protoFieldPath(md protoreflect.MessageDescriptor, path string) ([]protoreflect.FieldDescriptor, error) {
+	var fds []protoreflect.FieldDescriptor
+	for i, name := range strings.Split(path, ".") {
+		if md == nil {
+			return nil, fmt.Errorf("field %q: %s is not a message", path, fds[i-1].Name())
+		}
+		fd := md.Fields().ByName(protoreflect.Name(name))
+		if fd == nil {
+			fd = md.Fields().ByJSONName(name)
+		}
+		if fd == nil {
+			return nil, fmt.Errorf("field %q: %s has no field %s", path, md.FullName(), name)
+		}
+		fds = append(fds, fd)
+		md = fd.Message()
+		if fd.IsList() || fd.IsMap() {
+			md = nil
+		}
+	}
+	return fds, nil
+}
+
+// bindProtoRequest reads req from the body, the path params and the query string of c.
+func bindProtoRequest(c *Context, req proto.Message, rule httpRule, params []protoPathParam) error {
+	bound := make(map[string]bool, len(params))
+	if rule.body != "" {
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			return err
+		}
+		if len(body) > 0 {
+			target := req.ProtoReflect()
+			if rule.body != "*" {
+				fds, _ := protoFieldPath(target.Descriptor(), rule.body)
+				for _, fd := range fds {
+					target = target.Mutable(fd).Message()
+				}
+				bound[rule.body] = true
+			}
+			if err := protojson.Unmarshal(body, target.Interface()); err != nil {
+				return err
+			}
+		}
+	}
+	for _, param := range params {
+		value := c.Param(param.name)
+		if param.catchAll {
+			value = strings.TrimPrefix(value, "/")
+		}
+		if err := setProtoField(req.ProtoReflect(), param.field, []string{value}); err != nil {
+			return err
+		}
+		bound[param.field] = true
+	}
+	if rule.body == "*" {
+		return nil
+	}
+	for key, values := range c.Request.URL.Query() {
+		if bound[key] {
+			continue
+		}
+		if _, err := protoFieldPath(req.ProtoReflect().Descriptor(), key); err != nil {
+			// unknown query params are ignored
+			continue
+		}
+		if err := setProtoField(req.ProtoReflect(), key, values); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setProtoField sets the scalar field of the dot-separated path to values, all of them if
+// the field is repeated, the last one otherwise.
+func setProtoField(m protoreflect.Message, path string, values []string) error {
+	fds, err := protoFieldPath(m.Descriptor(), path)
+	if err != nil {
+		return err
+	}
+	for _, fd := range fds[:len(fds)-1] {
+		m = m.Mutable(fd).Message()
+	}
+	fd := fds[len(fds)-1]
+	if fd.IsMap() || fd.Message() != nil {
+		return fmt.Errorf("field %q is not a scalar", path)
+	}
+	if fd.IsList() {
+		list := m.Mutable(fd).List()
+		for _, s := range values {
+			v, err := parseProtoScalar(fd, s)
+			if err != nil {
+				return fmt.Errorf("field %q: %w", path, err)
+			}
+			list.Append(v)
+		}
+		return nil
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	v, err := parseProtoScalar(fd, values[len(values)-1])
+	if err != nil {
+		return fmt.Errorf("field %q: %w", path, err)
+	}
+	m.Set(fd, v)
+	return nil
+}
+
+func parseProtoScalar(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		return protoreflect.ValueOfBool(b), err
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfInt32(int32(n)), err
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		return protoreflect.ValueOfInt64(n), err
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		return protoreflect.ValueOfUint32(uint32(n)), err
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		return protoreflect.ValueOfUint64(n), err
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(s, 32)
+		return protoreflect.ValueOfFloat32(float32(f)), err
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(s, 64)
+		return protoreflect.ValueOfFloat64(f), err
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByName(protoreflect.Name(s)); ev != nil {
+			return protoreflect.ValueOfEnum(ev.Number()), nil
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), err
+	case protoreflect.BytesKind:
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			b, err = base64.URLEncoding.DecodeString(s)
+		}
+		return protoreflect.ValueOfBytes(b), err
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported kind %s", fd.Kind())
+}
+
+// writeProtoResponse writes resp, or its field responseBody, as JSON.
+func writeProtoResponse(c *Context, resp proto.Message, responseBody string) {
+	m := resp.ProtoReflect()
+	var data []byte
+	var err error
+	if responseBody == "" {
+		data, err = protojson.Marshal(resp)
+	} else {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(responseBody))
+		if fd == nil {
+			fd = m.Descriptor().Fields().ByJSONName(responseBody)
+		}
+		data, err = marshalProtoField(m, fd)
+	}
+	if err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err) // nolint: errcheck
+		return
+	}
+	c.Data(http.StatusOK, MIMEJSON, data)
+}
+
+// marshalProtoField marshals the field fd of m as JSON, by marshaling a message holding
+// only this field and extracting its value.
+func marshalProtoField(m protoreflect.Message, fd protoreflect.FieldDescriptor) ([]byte, error) {
+	if fd.Message() != nil && !fd.IsList() && !fd.IsMap() {
+		return protojson.Marshal(m.Get(fd).Message().Interface())
+	}
+	holder := m.New()
+	if m.Has(fd) {
+		holder.Set(fd, m.Get(fd))
+	}
+	data, err := protojson.MarshalOptions{EmitUnpopulated: true, UseProtoNames: true}.Marshal(holder.Interface())
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields[string(fd.Name())], nil
+}
@@ -0,0 +1,241 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// encodeHTTPRule encodes a google.api.HttpRule with the fields of the pairs of number
+// and value, the values being strings or nested rules.
+func encodeHTTPRule(fields ...any) []byte {
+	var b []byte
+	for i := 0; i < len(fields); i += 2 {
+		b = protowire.AppendTag(b, protowire.Number(fields[i].(int)), protowire.BytesType)
+		switch v := fields[i+1].(type) {
+		case string:
+			b = protowire.AppendString(b, v)
+		case []byte:
+			b = protowire.AppendBytes(b, v)
+		}
+	}
+	return b
+}
+
+func protoMethod(name, input, output string, rule []byte) *descriptorpb.MethodDescriptorProto {
+	method := &descriptorpb.MethodDescriptorProto{
+		Name:       proto.String(name),
+		InputType:  proto.String(".library." + input),
+		OutputType: proto.String(".library." + output),
+	}
+	if rule != nil {
+		method.Options = &descriptorpb.MethodOptions{}
+		raw := protowire.AppendTag(nil, httpRuleField, protowire.BytesType)
+		method.Options.ProtoReflect().SetUnknown(protowire.AppendBytes(raw, rule))
+	}
+	return method
+}
+
+func protoField(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label, typeName string) *descriptorpb.FieldDescriptorProto {
+	field := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Type:     typ.Enum(),
+		Label:    label.Enum(),
+	}
+	if typeName != "" {
+		field.TypeName = proto.String(".library." + typeName)
+	}
+	return field
+}
+
+func libraryService(t *testing.T, methods ...*descriptorpb.MethodDescriptorProto) protoreflect.ServiceDescriptor {
+	optional, repeated := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL, descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	str, i64, msg := descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_TYPE_INT64, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	file := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("library.proto"),
+		Package: proto.String("library"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:  proto.String("Author"),
+			Field: []*descriptorpb.FieldDescriptorProto{protoField("name", 1, str, optional, "")},
+		}, {
+			Name: proto.String("Book"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				protoField("id", 1, i64, optional, ""),
+				protoField("title", 2, str, optional, ""),
+				protoField("tags", 3, str, repeated, ""),
+				protoField("author", 4, msg, optional, "Author"),
+			},
+		}, {
+			Name: proto.String("GetBookRequest"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				protoField("shelf", 1, str, optional, ""),
+				protoField("book", 2, msg, optional, "Book"),
+			},
+		}},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name:   proto.String("Library"),
+			Method: methods,
+		}},
+	}
+	fd, err := protodesc.NewFile(file, nil)
+	require.NoError(t, err)
+	return fd.Services().Get(0)
+}
+
+type libraryServer struct{}
+
+func (libraryServer) GetBook(ctx context.Context, req *dynamicpb.Message) (*dynamicpb.Message, error) {
+	fields := req.Descriptor().Fields()
+	book := req.Get(fields.ByName("book")).Message()
+	if book.Get(book.Descriptor().Fields().ByName("id")).Int() == 0 {
+		return nil, errors.New("book not found")
+	}
+	resp := dynamicpb.NewMessage(book.Descriptor())
+	resp.Set(resp.Descriptor().Fields().ByName("id"), book.Get(book.Descriptor().Fields().ByName("id")))
+	resp.Set(resp.Descriptor().Fields().ByName("title"), protoreflect.ValueOfString("shelf "+req.Get(fields.ByName("shelf")).String()))
+	return resp, nil
+}
+
+func (libraryServer) CreateBook(c *Context, req *dynamicpb.Message) (*dynamicpb.Message, error) {
+	book := req.Get(req.Descriptor().Fields().ByName("book")).Message().Interface().(*dynamicpb.Message)
+	book.Set(book.Descriptor().Fields().ByName("id"), protoreflect.ValueOfInt64(7))
+	return book, nil
+}
+
+func (libraryServer) UpdateBook(ctx context.Context, req *dynamicpb.Message) (*dynamicpb.Message, error) {
+	return req, nil
+}
+
+func (libraryServer) ListTags(ctx context.Context, req *dynamicpb.Message) (*dynamicpb.Message, error) {
+	return req, nil
+}
+
+func (libraryServer) Internal(ctx context.Context, req *dynamicpb.Message) (*dynamicpb.Message, error) {
+	return req, nil
+}
+
+type badLibraryServer struct{}
+
+func (badLibraryServer) GetBook(req *dynamicpb.Message) error {
+	return nil
+}
+
+func TestRegisterProtoService(t *testing.T) {
+	service := libraryService(t,
+		protoMethod("GetBook", "GetBookRequest", "Book", encodeHTTPRule(
+			2, "/v1/shelves/{shelf}/books/{book.id}",
+			11, encodeHTTPRule(2, "/v1/books/{book.id=*}"),
+		)),
+		protoMethod("CreateBook", "GetBookRequest", "Book", encodeHTTPRule(4, "/v1/shelves/{shelf}/books", 7, "book")),
+		protoMethod("UpdateBook", "Book", "Book", encodeHTTPRule(6, "/v1/books/{id}", 7, "*")),
+		protoMethod("ListTags", "Book", "Book", encodeHTTPRule(8, encodeHTTPRule(1, "LIST", 2, "/v1/tags/{title=**}"), 12, "tags")),
+		protoMethod("Internal", "Book", "Book", nil),
+	)
+	r := New()
+	require.NoError(t, r.Group("/api").RegisterProtoService(service, libraryServer{}))
+
+	var paths []string
+	for _, route := range r.Routes() {
+		paths = append(paths, route.Method+" "+route.Path)
+	}
+	assert.ElementsMatch(t, []string{
+		"GET /api/v1/shelves/:shelf/books/:book.id",
+		"GET /api/v1/books/:book.id",
+		"POST /api/v1/shelves/:shelf/books",
+		"PATCH /api/v1/books/:id",
+		"LIST /api/v1/tags/*title",
+	}, paths)
+
+	w := PerformRequest(r, http.MethodGet, "/api/v1/shelves/fiction/books/42")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, MIMEJSON, w.Header().Get("Content-Type"))
+	assert.JSONEq(t, `{"id": "42", "title": "shelf fiction"}`, w.Body.String())
+
+	w = PerformRequest(r, http.MethodGet, "/api/v1/books/42?shelf=scifi&unknown=1")
+	assert.JSONEq(t, `{"id": "42", "title": "shelf scifi"}`, w.Body.String())
+
+	w = PerformRequest(r, http.MethodGet, "/api/v1/books/abc")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = PerformRequest(r, http.MethodGet, "/api/v1/books/0")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	req := strings.NewReader(`{"title": "Dune", "author": {"name": "Frank Herbert"}}`)
+	w = performRequestBody(r, http.MethodPost, "/api/v1/shelves/scifi/books", req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"id": "7", "title": "Dune", "author": {"name": "Frank Herbert"}}`, w.Body.String())
+
+	w = performRequestBody(r, http.MethodPatch, "/api/v1/books/3?title=ignored", strings.NewReader(`{"title": "Dune", "tags": ["classic"]}`))
+	assert.JSONEq(t, `{"id": "3", "title": "Dune", "tags": ["classic"]}`, w.Body.String())
+
+	w = performRequestBody(r, http.MethodPatch, "/api/v1/books/3", strings.NewReader(`{"title": 1}`))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = PerformRequest(r, "LIST", "/api/v1/tags/a/b?tags=x&tags=y")
+	assert.JSONEq(t, `["x", "y"]`, w.Body.String())
+}
+
+func performRequestBody(r *Engine, method, path string, body *strings.Reader) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, body)
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, req)
+	return w
+}
+
+func TestRegisterProtoServiceErrors(t *testing.T) {
+	for _, test := range []struct {
+		name   string
+		method *descriptorpb.MethodDescriptorProto
+		impl   any
+		err    string
+	}{
+		{"missing method", protoMethod("Missing", "Book", "Book", encodeHTTPRule(2, "/books")), libraryServer{}, "has no method Missing"},
+		{"bad signature", protoMethod("GetBook", "Book", "Book", encodeHTTPRule(2, "/books")), badLibraryServer{}, "must be func"},
+		{"verb", protoMethod("GetBook", "GetBookRequest", "Book", encodeHTTPRule(2, "/books:get")), libraryServer{}, "unsupported verb"},
+		{"pattern", protoMethod("GetBook", "GetBookRequest", "Book", encodeHTTPRule(2, "/{shelf=shelves/*}")), libraryServer{}, "unsupported variable pattern"},
+		{"partial segment", protoMethod("GetBook", "GetBookRequest", "Book", encodeHTTPRule(2, "/book-{shelf}")), libraryServer{}, "unsupported variable"},
+		{"unknown field", protoMethod("GetBook", "GetBookRequest", "Book", encodeHTTPRule(2, "/{isbn}")), libraryServer{}, "has no field isbn"},
+		{"scalar body", protoMethod("GetBook", "GetBookRequest", "Book", encodeHTTPRule(4, "/books", 7, "shelf")), libraryServer{}, "is not a message"},
+		{"no pattern", protoMethod("GetBook", "GetBookRequest", "Book", encodeHTTPRule(7, "*")), libraryServer{}, "without pattern"},
+	} {
+		err := New().RegisterProtoService(libraryService(t, test.method), test.impl)
+		if assert.Error(t, err, test.name) {
+			assert.Contains(t, err.Error(), test.err, test.name)
+		}
+	}
+
+	// route conflicts are reported
+	service := libraryService(t, protoMethod("GetBook", "GetBookRequest", "Book", encodeHTTPRule(2, "/books/{shelf}", 11, encodeHTTPRule(2, "/books/{book.id}"))))
+	assert.Error(t, New().RegisterProtoService(service, libraryServer{}))
+}
+
+func TestConvertPathTemplate(t *testing.T) {
+	path, params, err := convertPathTemplate("/v1/{name}/files/{path=**}")
+	assert.NoError(t, err)
+	assert.Equal(t, "/v1/:name/files/*path", path)
+	assert.Equal(t, []protoPathParam{{name: "name", field: "name"}, {name: "path", field: "path", catchAll: true}}, params)
+
+	for _, template := range []string{"v1/{name}", "/v1/{name", "/{path=**}/files", "/{}"} {
+		_, _, err := convertPathTemplate(template)
+		assert.Error(t, err, template)
+	}
+}
@@ -0,0 +1,458 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"container/list"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultQuotaPeriod        = 24 * time.Hour
+	defaultQuotaFlushInterval = 10 * time.Second
+	defaultQuotaKeyHeader     = "X-API-Key"
+	defaultQuotaMaxKeys       = 10000
+)
+
+// QuotaUsage is the usage of a caller over a quota period.
+type QuotaUsage struct {
+	// Requests is the number of requests.
+	Requests int64
+	// Bytes is the bandwidth, the size of the request bodies plus the size of the responses.
+	Bytes int64
+}
+
+// QuotaLimit is the usage allowed to a caller over a quota period.
+// A zero field means unlimited.
+type QuotaLimit struct {
+	Requests int64
+	Bytes    int64
+}
+
+// QuotaStore persists the quota usage, so it is shared between instances and survives
+// restarts. Usage is bucketed by period, identified by its start time. The usage of the
+// other instances is seen at each flush, so a shared quota is approximate: it can be
+// exceeded by the requests an instance accounts between two flushes.
+type QuotaStore interface {
+	// Load returns the usage of key over the period.
+	Load(period time.Time, key string) (QuotaUsage, error)
+	// Add adds the usage of every key over the period.
+	Add(period time.Time, usage map[string]QuotaUsage) error
+}
+
+// QuotaConfig defines the config for NewQuota.
+type QuotaConfig struct {
+	// Key returns the key the usage of a request is accounted to. Requests with an empty
+	// key are not accounted. The key must identify an authenticated caller, e.g. a key
+	// checked by an authentication middleware registered before the quota: a client
+	// sending made-up keys gets a fresh quota for each of them and evicts the counters
+	// of the other keys.
+	// Optional. Default value is the tenant ID, see Context.Tenant, or else the
+	// X-API-Key request header, which is not checked.
+	Key func(c *Context) string
+
+	// Limit returns the limit of a request. Optional. Default value is Tenant.Quota.
+	Limit func(c *Context) QuotaLimit
+
+	// Period is the length of the quota windows, aligned on the zero time.
+	// Optional. Default value is 24 hours.
+	Period time.Duration
+
+	// Store persists the usage. Optional. By default the usage is only kept in memory.
+	Store QuotaStore
+
+	// FlushInterval is the interval at which the usage is flushed to Store.
+	// Optional. Default value is 10 seconds.
+	FlushInterval time.Duration
+
+	// MaxKeys is the number of keys whose usage is kept in memory, above which the least
+	// recently used keys are evicted. The usage of an evicted key left to flush is added
+	// to Store, and it is lost without a Store, so MaxKeys must exceed the number of
+	// active callers. Optional. Default value is 10000.
+	MaxKeys int
+
+	// ErrorHandler is called when the store fails. Optional.
+	ErrorHandler func(err error)
+}
+
+// quotaCounter is the usage of a key over the current period.
+type quotaCounter struct {
+	key     string
+	elem    *list.Element
+	period  time.Time
+	usage   QuotaUsage
+	pending QuotaUsage
+	// loading is closed once the usage is loaded from the store.
+	loading chan struct{}
+}
+
+// Quota accounts the requests and bandwidth of the callers, identified by API key or tenant,
+// and rejects the callers that exceed their limit, see Quota.Middleware.
+type Quota struct {
+	config   QuotaConfig
+	mu       sync.Mutex
+	counters map[string]*quotaCounter
+	lru      *list.List // counters, the most recently used first
+	swept    time.Time
+	stop     chan struct{}
+	done     chan struct{}
+}
+
+// NewQuota returns a new Quota. If a store is configured, the usage is flushed to it
+// periodically, until Close is called.
+func NewQuota(config QuotaConfig) *Quota {
+	if config.Key == nil {
+		config.Key = defaultQuotaKey
+	}
+	if config.Limit == nil {
+		config.Limit = defaultQuotaLimit
+	}
+	if config.Period <= 0 {
+		config.Period = defaultQuotaPeriod
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = defaultQuotaFlushInterval
+	}
+	if config.MaxKeys <= 0 {
+		config.MaxKeys = defaultQuotaMaxKeys
+	}
+	q := &Quota{config: config, counters: make(map[string]*quotaCounter), lru: list.New()}
+	if config.Store != nil {
+		q.stop = make(chan struct{})
+		q.done = make(chan struct{})
+		go q.flushLoop()
+	}
+	return q
+}
+
+func defaultQuotaKey(c *Context) string {
+	if tenant := c.Tenant(); tenant != nil {
+		return tenant.ID
+	}
+	return c.requestHeader(defaultQuotaKeyHeader)
+}
+
+func defaultQuotaLimit(c *Context) QuotaLimit {
+	if tenant := c.Tenant(); tenant != nil {
+		return tenant.Quota
+	}
+	return QuotaLimit{}
+}
+
+func (q *Quota) flushLoop() {
+	defer close(q.done)
+	ticker := time.NewTicker(q.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			q.reportError(q.Flush())
+		case <-q.stop:
+			return
+		}
+	}
+}
+
+func (q *Quota) reportError(err error) {
+	if err != nil && q.config.ErrorHandler != nil {
+		q.config.ErrorHandler(err)
+	}
+}
+
+// Close stops the periodic flush and flushes the pending usage one last time.
+func (q *Quota) Close() error {
+	if q.stop == nil {
+		return nil
+	}
+	close(q.stop)
+	<-q.done
+	return q.Flush()
+}
+
+// Flush adds the usage accounted since the last flush to the store, then loads the usage
+// of the current period from it, which includes the usage of the other instances.
+func (q *Quota) Flush() error {
+	if q.config.Store == nil {
+		return nil
+	}
+	current := q.currentPeriod()
+	q.mu.Lock()
+	pending := make(map[time.Time]map[string]QuotaUsage)
+	var keys []string
+	for key, counter := range q.counters {
+		if counter.period.Equal(current) && counter.loading == nil {
+			keys = append(keys, key)
+		}
+		takePending(pending, counter)
+	}
+	q.mu.Unlock()
+
+	for period, usage := range pending {
+		if err := q.config.Store.Add(period, usage); err != nil {
+			// keep the usage for the next flush
+			q.mu.Lock()
+			for key, u := range usage {
+				if counter := q.counters[key]; counter != nil && counter.period.Equal(period) {
+					counter.pending.Requests += u.Requests
+					counter.pending.Bytes += u.Bytes
+				}
+			}
+			q.mu.Unlock()
+			return err
+		}
+	}
+
+	for _, key := range keys {
+		usage, err := q.config.Store.Load(current, key)
+		if err != nil {
+			return err
+		}
+		q.mu.Lock()
+		if counter := q.counters[key]; counter != nil && counter.period.Equal(current) {
+			// the usage accounted since the snapshot is not in the store yet
+			usage.Requests += counter.pending.Requests
+			usage.Bytes += counter.pending.Bytes
+			counter.usage = usage
+		}
+		q.mu.Unlock()
+	}
+	q.mu.Lock()
+	q.sweep(current)
+	q.mu.Unlock()
+	return nil
+}
+
+// Usage returns the usage of key over the current period, as accounted by the quota or,
+// if the quota did not see key in the period, as loaded from the store. It does not start
+// accounting the usage of key.
+func (q *Quota) Usage(key string) QuotaUsage {
+	period := q.currentPeriod()
+	q.mu.Lock()
+	if counter := q.counters[key]; counter != nil && counter.period.Equal(period) {
+		if loading := counter.loading; loading != nil {
+			q.mu.Unlock()
+			<-loading
+			q.mu.Lock()
+		}
+		usage := counter.usage
+		q.mu.Unlock()
+		return usage
+	}
+	q.mu.Unlock()
+	if q.config.Store == nil {
+		return QuotaUsage{}
+	}
+	usage, err := q.config.Store.Load(period, key)
+	q.reportError(err)
+	return usage
+}
+
+func (q *Quota) currentPeriod() time.Time {
+	return time.Now().Truncate(q.config.Period)
+}
+
+// sweep drops the counters of the periods before period with no usage left to flush. It
+// must be called with q.mu held.
+func (q *Quota) sweep(period time.Time) {
+	for key, counter := range q.counters {
+		if counter.period.Before(period) && counter.pending == (QuotaUsage{}) {
+			q.lru.Remove(counter.elem)
+			delete(q.counters, key)
+		}
+	}
+	q.swept = period
+}
+
+// evict drops the least recently used counters above MaxKeys, moving their usage left to
+// flush to pending, if not nil. It must be called with q.mu held.
+func (q *Quota) evict(pending map[time.Time]map[string]QuotaUsage) {
+	for len(q.counters) > q.config.MaxKeys {
+		counter := q.lru.Back().Value.(*quotaCounter)
+		if counter.loading != nil {
+			// only the counters being loaded are left, they are dropped once used
+			return
+		}
+		q.lru.Remove(counter.elem)
+		delete(q.counters, counter.key)
+		if pending != nil {
+			takePending(pending, counter)
+		}
+	}
+}
+
+// takePending moves the usage of counter left to flush to pending, by period and key.
+func takePending(pending map[time.Time]map[string]QuotaUsage, counter *quotaCounter) {
+	if counter.pending == (QuotaUsage{}) {
+		return
+	}
+	if pending[counter.period] == nil {
+		pending[counter.period] = make(map[string]QuotaUsage)
+	}
+	pending[counter.period][counter.key] = counter.pending
+	counter.pending = QuotaUsage{}
+}
+
+// counter returns the counter of key for period, loading the usage from the store when
+// the key is first seen in the period and evicting the least recently used counters above
+// MaxKeys. It must be called with q.mu held, which is released while the store is accessed.
+func (q *Quota) counter(key string, period time.Time) *quotaCounter {
+	counter := q.counters[key]
+	if counter != nil && counter.period.Equal(period) {
+		q.lru.MoveToFront(counter.elem)
+		if loading := counter.loading; loading != nil {
+			q.mu.Unlock()
+			<-loading
+			q.mu.Lock()
+		}
+		return counter
+	}
+	if period.After(q.swept) {
+		q.sweep(period)
+	}
+	previous := counter
+	if previous != nil {
+		q.lru.Remove(previous.elem)
+	}
+	counter = &quotaCounter{key: key, period: period}
+	counter.elem = q.lru.PushFront(counter)
+	q.counters[key] = counter
+	if q.config.Store == nil {
+		q.evict(nil)
+		return counter
+	}
+
+	loading := make(chan struct{})
+	counter.loading = loading
+	pending := make(map[time.Time]map[string]QuotaUsage)
+	if previous != nil {
+		takePending(pending, previous)
+	}
+	q.evict(pending)
+	q.mu.Unlock()
+	// flush the usage of the previous period and of the evicted keys before it is lost
+	for p, usage := range pending {
+		q.reportError(q.config.Store.Add(p, usage))
+	}
+	usage, err := q.config.Store.Load(period, key)
+	q.reportError(err)
+	q.mu.Lock()
+	counter.usage = usage
+	counter.loading = nil
+	close(loading)
+	return counter
+}
+
+// Middleware returns a middleware accounting the requests and bandwidth of every caller.
+// Callers over their limit are answered with a 429 Too Many Requests and a Retry-After
+// header. The limit and usage of the caller are reported in the X-Quota-Limit,
+// X-Quota-Remaining and X-Quota-Reset (in seconds) headers, for requests, and in the
+// X-Quota-Bytes-Limit and X-Quota-Bytes-Remaining headers, for bandwidth.
+func (q *Quota) Middleware() HandlerFunc {
+	return func(c *Context) {
+		key := q.config.Key(c)
+		if key == "" {
+			return
+		}
+		limit := q.config.Limit(c)
+		period := q.currentPeriod()
+		reset := period.Add(q.config.Period).Sub(time.Now())
+
+		q.mu.Lock()
+		counter := q.counter(key, period)
+		usage := counter.usage
+		exceeded := (limit.Requests > 0 && usage.Requests >= limit.Requests) ||
+			(limit.Bytes > 0 && usage.Bytes >= limit.Bytes)
+		if !exceeded {
+			counter.usage.Requests++
+			counter.pending.Requests++
+			usage = counter.usage
+		}
+		q.mu.Unlock()
+
+		header := c.Writer.Header()
+		if limit.Requests > 0 {
+			header.Set("X-Quota-Limit", strconv.FormatInt(limit.Requests, 10))
+			header.Set("X-Quota-Remaining", strconv.FormatInt(max64(limit.Requests-usage.Requests, 0), 10))
+		}
+		if limit.Bytes > 0 {
+			header.Set("X-Quota-Bytes-Limit", strconv.FormatInt(limit.Bytes, 10))
+			header.Set("X-Quota-Bytes-Remaining", strconv.FormatInt(max64(limit.Bytes-usage.Bytes, 0), 10))
+		}
+		if limit != (QuotaLimit{}) {
+			header.Set("X-Quota-Reset", formatRetryAfter(reset))
+		}
+		if exceeded {
+			header.Set("Retry-After", formatRetryAfter(reset))
+			c.AbortWithStatus(http.StatusTooManyRequests)
+			return
+		}
+
+		c.Next()
+
+		bytes := int64(c.Writer.Size())
+		if bytes < 0 {
+			bytes = 0
+		}
+		if c.Request.ContentLength > 0 {
+			bytes += c.Request.ContentLength
+		}
+		q.mu.Lock()
+		if counter := q.counters[key]; counter != nil && counter.period.Equal(period) {
+			counter.usage.Bytes += bytes
+			counter.pending.Bytes += bytes
+		}
+		q.mu.Unlock()
+	}
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// MemoryQuotaStore is a QuotaStore keeping the usage in memory, e.g. to share it between
+// several Quota instances of a process, or for testing.
+type MemoryQuotaStore struct {
+	mu    sync.Mutex
+	usage map[time.Time]map[string]QuotaUsage
+}
+
+// NewMemoryQuotaStore returns a new, empty MemoryQuotaStore.
+func NewMemoryQuotaStore() *MemoryQuotaStore {
+	return &MemoryQuotaStore{usage: make(map[time.Time]map[string]QuotaUsage)}
+}
+
+// Load implements QuotaStore.
+func (s *MemoryQuotaStore) Load(period time.Time, key string) (QuotaUsage, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.usage[period][key], nil
+}
+
+// Add implements QuotaStore. The usage of the previous periods is dropped.
+func (s *MemoryQuotaStore) Add(period time.Time, usage map[string]QuotaUsage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for p := range s.usage {
+		if p.Before(period) {
+			delete(s.usage, p)
+		}
+	}
+	if s.usage[period] == nil {
+		s.usage[period] = make(map[string]QuotaUsage)
+	}
+	for key, u := range usage {
+		total := s.usage[period][key]
+		total.Requests += u.Requests
+		total.Bytes += u.Bytes
+		s.usage[period][key] = total
+	}
+	return nil
+}
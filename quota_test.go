@@ -0,0 +1,211 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuotaMiddleware(t *testing.T) {
+	quota := NewQuota(QuotaConfig{
+		Limit: func(c *Context) QuotaLimit { return QuotaLimit{Requests: 2} },
+	})
+	defer quota.Close() // nolint: errcheck
+
+	router := New()
+	router.Use(quota.Middleware())
+	router.GET("/", func(c *Context) { c.String(http.StatusOK, "%s", "hello") })
+
+	key := header{"X-API-Key", "k1"}
+	w := PerformRequest(router, http.MethodGet, "/", key)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "2", w.Header().Get("X-Quota-Limit"))
+	assert.Equal(t, "1", w.Header().Get("X-Quota-Remaining"))
+	assert.NotEmpty(t, w.Header().Get("X-Quota-Reset"))
+
+	w = PerformRequest(router, http.MethodGet, "/", key)
+	assert.Equal(t, "0", w.Header().Get("X-Quota-Remaining"))
+
+	w = PerformRequest(router, http.MethodGet, "/", key)
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "0", w.Header().Get("X-Quota-Remaining"))
+	assert.NotEmpty(t, w.Header().Get("Retry-After"))
+	assert.Equal(t, QuotaUsage{Requests: 2, Bytes: 10}, quota.Usage("k1"))
+
+	// other keys have their own quota, requests without key are not accounted
+	w = PerformRequest(router, http.MethodGet, "/", header{"X-API-Key", "k2"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	w = PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Header().Get("X-Quota-Limit"))
+}
+
+func TestQuotaTenantBandwidth(t *testing.T) {
+	quota := NewQuota(QuotaConfig{})
+	router := New()
+	assert.NoError(t, router.SetTenancy(TenancyConfig{Tenants: []*Tenant{
+		{ID: "acme", Quota: QuotaLimit{Bytes: 8}},
+	}}))
+	router.Use(quota.Middleware())
+	router.POST("/", func(c *Context) { c.String(http.StatusOK, "%s", "hello") })
+
+	send := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader("abc"))
+		req.Header.Set("X-Tenant-ID", "acme")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+	w := send()
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "8", w.Header().Get("X-Quota-Bytes-Remaining"))
+	assert.Equal(t, QuotaUsage{Requests: 1, Bytes: 8}, quota.Usage("acme"))
+
+	w = send()
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "8", w.Header().Get("X-Quota-Bytes-Limit"))
+	assert.Equal(t, "0", w.Header().Get("X-Quota-Bytes-Remaining"))
+}
+
+type failingQuotaStore struct {
+	*MemoryQuotaStore
+	fail bool
+}
+
+func (s *failingQuotaStore) Add(period time.Time, usage map[string]QuotaUsage) error {
+	if s.fail {
+		return errors.New("store unavailable")
+	}
+	return s.MemoryQuotaStore.Add(period, usage)
+}
+
+func TestQuotaStore(t *testing.T) {
+	store := &failingQuotaStore{MemoryQuotaStore: NewMemoryQuotaStore(), fail: true}
+	var storeErr error
+	quota := NewQuota(QuotaConfig{Store: store, FlushInterval: time.Hour, ErrorHandler: func(err error) { storeErr = err }})
+
+	router := New()
+	router.Use(quota.Middleware())
+	router.GET("/", func(c *Context) {})
+	PerformRequest(router, http.MethodGet, "/", header{"X-API-Key", "k1"})
+	PerformRequest(router, http.MethodGet, "/", header{"X-API-Key", "k1"})
+
+	assert.Error(t, quota.Flush())
+	assert.Nil(t, storeErr)
+	store.fail = false
+	assert.NoError(t, quota.Close())
+
+	period := time.Now().Truncate(defaultQuotaPeriod)
+	usage, err := store.Load(period, "k1")
+	assert.NoError(t, err)
+	assert.Equal(t, QuotaUsage{Requests: 2}, usage)
+
+	// another instance starts from the stored usage
+	other := NewQuota(QuotaConfig{Store: store})
+	defer other.Close() // nolint: errcheck
+	assert.Equal(t, QuotaUsage{Requests: 2}, other.Usage("k1"))
+}
+
+func TestQuotaSharedUsage(t *testing.T) {
+	store := NewMemoryQuotaStore()
+	a := NewQuota(QuotaConfig{Store: store, FlushInterval: time.Hour})
+	b := NewQuota(QuotaConfig{Store: store, FlushInterval: time.Hour})
+	defer a.Close() // nolint: errcheck
+	defer b.Close() // nolint: errcheck
+
+	period := time.Now().Truncate(defaultQuotaPeriod)
+	a.mu.Lock()
+	counter := a.counter("k1", period)
+	a.mu.Unlock()
+	assert.Equal(t, QuotaUsage{}, a.Usage("k1"))
+	assert.NoError(t, store.Add(period, map[string]QuotaUsage{"k1": {Requests: 3}}))
+	a.mu.Lock()
+	counter.usage.Requests++
+	counter.pending.Requests++
+	a.mu.Unlock()
+
+	assert.NoError(t, a.Flush())
+	assert.Equal(t, QuotaUsage{Requests: 4}, a.Usage("k1"), "the usage of the other instances is loaded")
+	assert.Equal(t, QuotaUsage{Requests: 4}, b.Usage("k1"))
+}
+
+func TestQuotaSweep(t *testing.T) {
+	quota := NewQuota(QuotaConfig{})
+	quota.mu.Lock()
+	past := quota.currentPeriod().Add(-defaultQuotaPeriod)
+	for _, key := range []string{"k1", "k2", "k3"} {
+		counter := &quotaCounter{key: key, period: past, usage: QuotaUsage{Requests: 1}}
+		counter.elem = quota.lru.PushFront(counter)
+		quota.counters[key] = counter
+	}
+	quota.swept = past
+	quota.counter("k4", quota.currentPeriod())
+	quota.mu.Unlock()
+
+	assert.Len(t, quota.counters, 1, "the counters of the past periods are dropped")
+	assert.Equal(t, 1, quota.lru.Len())
+}
+
+func TestQuotaMaxKeys(t *testing.T) {
+	store := NewMemoryQuotaStore()
+	quota := NewQuota(QuotaConfig{Store: store, FlushInterval: time.Hour, MaxKeys: 2})
+	defer quota.Close() // nolint: errcheck
+
+	router := New()
+	router.Use(quota.Middleware())
+	router.GET("/", func(c *Context) {})
+	for _, key := range []string{"k1", "k2", "k1", "k3"} {
+		PerformRequest(router, http.MethodGet, "/", header{"X-API-Key", key})
+	}
+
+	quota.mu.Lock()
+	assert.Len(t, quota.counters, 2)
+	assert.Nil(t, quota.counters["k2"], "the least recently used key is evicted")
+	quota.mu.Unlock()
+	period := time.Now().Truncate(defaultQuotaPeriod)
+	usage, err := store.Load(period, "k2")
+	assert.NoError(t, err)
+	assert.Equal(t, QuotaUsage{Requests: 1}, usage, "the usage of the evicted key is flushed")
+	assert.Equal(t, QuotaUsage{Requests: 2}, quota.Usage("k1"))
+
+	// looking up the usage does not account the key
+	assert.Equal(t, QuotaUsage{Requests: 1}, quota.Usage("k2"))
+	assert.Equal(t, QuotaUsage{}, quota.Usage("k4"))
+	assert.Len(t, quota.counters, 2)
+}
+
+type slowQuotaStore struct {
+	*MemoryQuotaStore
+	release chan struct{}
+}
+
+func (s *slowQuotaStore) Load(period time.Time, key string) (QuotaUsage, error) {
+	if key == "slow" {
+		<-s.release
+	}
+	return s.MemoryQuotaStore.Load(period, key)
+}
+
+func TestQuotaSlowStore(t *testing.T) {
+	store := &slowQuotaStore{MemoryQuotaStore: NewMemoryQuotaStore(), release: make(chan struct{})}
+	quota := NewQuota(QuotaConfig{Store: store, FlushInterval: time.Hour})
+	defer quota.Close() // nolint: errcheck
+
+	done := make(chan QuotaUsage)
+	go func() {
+		done <- quota.Usage("slow")
+	}()
+	time.Sleep(10 * time.Millisecond)
+	assert.Equal(t, QuotaUsage{}, quota.Usage("fast"), "the other keys are not blocked by the store")
+	close(store.release)
+	assert.Equal(t, QuotaUsage{}, <-done)
+}
@@ -50,24 +50,85 @@ func RecoveryWithWriter(out io.Writer, recovery ...RecoveryFunc) HandlerFunc {
 
 // CustomRecoveryWithWriter returns a middleware for a given writer that recovers from any panics and calls the provided handle func to handle it.
 func CustomRecoveryWithWriter(out io.Writer, handle RecoveryFunc) HandlerFunc {
+	if out == nil {
+		out = io.Discard
+	}
+	return RecoveryWithConfig(RecoveryConfig{Output: out, Handle: handle})
+}
+
+// PanicClass is the category a recovered panic value falls in, see PanicClassifier.
+type PanicClass int
+
+const (
+	// PanicUnexpected is a real panic, logged with its stack trace and handled by the RecoveryFunc.
+	PanicUnexpected PanicClass = iota
+	// PanicExpected is a known condition, such as a canceled database query, logged on a
+	// single line without stack trace, so it is not reported as a crash, and handled by the RecoveryFunc.
+	PanicExpected
+	// PanicBrokenConnection means the client connection is dead: the panic is logged on a
+	// single line and attached to the context, but no response is written.
+	PanicBrokenConnection
+)
+
+// PanicClassifier classifies a recovered panic value. It returns false if it does not know
+// the value, so that the next classifier is tried.
+type PanicClassifier func(err any) (PanicClass, bool)
+
+// RecoveryConfig defines the config for Recovery middleware.
+type RecoveryConfig struct {
+	// Optional. Default value is gin.DefaultErrorWriter.
+	// Nothing is logged if it is set to io.Discard.
+	Output io.Writer
+
+	// Handle writes the response of unexpected and expected panics.
+	// Optional. Default value writes a 500, see DebugPage.
+	Handle RecoveryFunc
+
+	// DebugPage writes an HTML page describing the panic, its stack and the request as the
+	// body of the 500 of the default Handle, in debug mode only. The values of the headers
+	// and params named like secrets, e.g. X-Api-Key, are hidden, but the page still shows
+	// the request and the source code, so it is for local development only.
+	// Optional. Default value is false.
+	DebugPage bool
+
+	// Classifiers are tried in order to classify a recovered panic value, before the
+	// built-in detection of broken connections. Unclassified values are PanicUnexpected.
+	// Optional.
+	Classifiers []PanicClassifier
+}
+
+// RecoveryWithConfig returns a middleware that recovers from any panics, logs them according
+// to their class and calls the configured handle func.
+//
+//	router.Use(gin.RecoveryWithConfig(gin.RecoveryConfig{
+//	    Classifiers: []gin.PanicClassifier{func(err any) (gin.PanicClass, bool) {
+//	        if e, ok := err.(error); ok && errors.Is(e, context.Canceled) {
+//	            return gin.PanicExpected, true
+//	        }
+//	        return gin.PanicUnexpected, false
+//	    }},
+//	}))
+func RecoveryWithConfig(conf RecoveryConfig) HandlerFunc {
+	out := conf.Output
+	if out == nil {
+		out = DefaultErrorWriter
+	}
+	handle := conf.Handle
+	if handle == nil {
+		handle = defaultHandleRecovery
+		if conf.DebugPage {
+			handle = debugPageHandleRecovery
+		}
+	}
 	var logger *log.Logger
-	if out != nil {
+	if out != nil && out != io.Discard {
 		logger = log.New(out, "\n\n\x1b[31m", log.LstdFlags)
 	}
+	classifiers := append(append([]PanicClassifier(nil), conf.Classifiers...), classifyBrokenConnection)
 	return func(c *Context) {
 		defer func() {
 			if err := recover(); err != nil {
-				// Check for a broken connection, as it is not really a
-				// condition that warrants a panic stack trace.
-				var brokenPipe bool
-				if ne, ok := err.(*net.OpError); ok {
-					var se *os.SyscallError
-					if errors.As(ne, &se) {
-						if strings.Contains(strings.ToLower(se.Error()), "broken pipe") || strings.Contains(strings.ToLower(se.Error()), "connection reset by peer") {
-							brokenPipe = true
-						}
-					}
-				}
+				class := classifyPanic(classifiers, err)
 				if logger != nil {
 					stack := stack(3)
 					httpRequest, _ := httputil.DumpRequest(c.Request, false)
@@ -79,8 +140,11 @@ func CustomRecoveryWithWriter(out io.Writer, handle RecoveryFunc) HandlerFunc {
 						}
 					}
 					headersToStr := strings.Join(headers, "\r\n")
-					if brokenPipe {
+					if class == PanicBrokenConnection {
 						logger.Printf("%s\n%s%s", err, headersToStr, reset)
+					} else if class == PanicExpected {
+						logger.Printf("[Recovery] %s expected panic recovered: %s%s",
+							timeFormat(time.Now()), err, reset)
 					} else if IsDebugging() {
 						logger.Printf("[Recovery] %s panic recovered:\n%s\n%s\n%s%s",
 							timeFormat(time.Now()), headersToStr, err, stack, reset)
@@ -89,9 +153,13 @@ func CustomRecoveryWithWriter(out io.Writer, handle RecoveryFunc) HandlerFunc {
 							timeFormat(time.Now()), err, stack, reset)
 					}
 				}
-				if brokenPipe {
+				if class == PanicBrokenConnection {
 					// If the connection is dead, we can't write a status to it.
-					c.Error(err.(error)) // nolint: errcheck
+					if e, ok := err.(error); ok {
+						c.Error(e) // nolint: errcheck
+					} else {
+						c.Error(fmt.Errorf("%v", err)) // nolint: errcheck
+					}
 					c.Abort()
 				} else {
 					handle(c, err)
@@ -102,7 +170,42 @@ func CustomRecoveryWithWriter(out io.Writer, handle RecoveryFunc) HandlerFunc {
 	}
 }
 
+func classifyPanic(classifiers []PanicClassifier, err any) PanicClass {
+	for _, classify := range classifiers {
+		if class, ok := classify(err); ok {
+			return class
+		}
+	}
+	return PanicUnexpected
+}
+
+// classifyBrokenConnection checks for a broken connection, as it is not really a
+// condition that warrants a panic stack trace.
+func classifyBrokenConnection(err any) (PanicClass, bool) {
+	if ne, ok := err.(*net.OpError); ok {
+		var se *os.SyscallError
+		if errors.As(ne, &se) {
+			if strings.Contains(strings.ToLower(se.Error()), "broken pipe") || strings.Contains(strings.ToLower(se.Error()), "connection reset by peer") {
+				return PanicBrokenConnection, true
+			}
+		}
+	}
+	return PanicUnexpected, false
+}
+
+// defaultHandleRecovery writes a 500, with the MessageInternalError message of the catalog
+// of the engine, if any.
 func defaultHandleRecovery(c *Context, err any) {
+	c.writeMessage(http.StatusInternalServerError, MessageInternalError, c.messageData(nil))
+	c.AbortWithStatus(http.StatusInternalServerError)
+}
+
+// debugPageHandleRecovery writes a 500, with the debug page in debug mode, see
+// RecoveryConfig.DebugPage, or else like defaultHandleRecovery.
+func debugPageHandleRecovery(c *Context, err any) {
+	if !c.writeDebugPage(err) {
+		c.writeMessage(http.StatusInternalServerError, MessageInternalError, c.messageData(nil))
+	}
 	c.AbortWithStatus(http.StatusInternalServerError)
 }
 
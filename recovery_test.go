@@ -6,6 +6,7 @@ package gin
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
@@ -247,3 +248,48 @@ func TestRecoveryWithWriterWithCustomRecovery(t *testing.T) {
 
 	SetMode(TestMode)
 }
+
+func TestRecoveryWithConfigClassifiers(t *testing.T) {
+	errCanceled := errors.New("query canceled")
+	buffer := new(bytes.Buffer)
+	router := New()
+	router.Use(RecoveryWithConfig(RecoveryConfig{
+		Output: buffer,
+		Handle: func(c *Context, err any) {
+			c.String(http.StatusServiceUnavailable, "%v", err)
+		},
+		Classifiers: []PanicClassifier{func(err any) (PanicClass, bool) {
+			if e, ok := err.(error); ok && errors.Is(e, errCanceled) {
+				return PanicExpected, true
+			}
+			return PanicUnexpected, false
+		}},
+	}))
+	router.GET("/expected", func(_ *Context) {
+		panic(fmt.Errorf("list users: %w", errCanceled))
+	})
+	router.GET("/unexpected", func(_ *Context) {
+		panic("boom")
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/expected")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "list users: query canceled", w.Body.String())
+	assert.Contains(t, buffer.String(), "expected panic recovered: list users: query canceled")
+	assert.NotContains(t, buffer.String(), t.Name())
+
+	buffer.Reset()
+	w = PerformRequest(router, http.MethodGet, "/unexpected")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Contains(t, buffer.String(), "panic recovered")
+	assert.Contains(t, buffer.String(), t.Name())
+
+	// a broken connection is still detected
+	buffer.Reset()
+	router.GET("/broken", func(_ *Context) {
+		panic(&net.OpError{Err: &os.SyscallError{Err: syscall.EPIPE}})
+	})
+	w = PerformRequest(router, http.MethodGet, "/broken")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, buffer.String(), "broken pipe")
+}
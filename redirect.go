@@ -0,0 +1,93 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// ErrUnsafeRedirect is returned by SafeRedirect when the target is neither a relative URL
+// nor on an allowed host.
+var ErrUnsafeRedirect = errors.New("gin: unsafe redirect target")
+
+// safeRedirectTarget parses target and checks it is a path relative to the current host,
+// or an http or https URL on one of the allowed hosts.
+func safeRedirectTarget(target string, allowedHosts []string) (*url.URL, error) {
+	if target == "" {
+		return nil, ErrUnsafeRedirect
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, ErrUnsafeRedirect
+	}
+	if u.Scheme == "" && u.Host == "" {
+		// "//host" and "/\host" are followed by browsers as absolute URLs
+		if !strings.HasPrefix(target, "/") || strings.HasPrefix(target, "//") || strings.HasPrefix(target, "/\\") {
+			return nil, ErrUnsafeRedirect
+		}
+		return u, nil
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.User != nil || !hostAllowed(u.Host, allowedHosts) {
+		return nil, ErrUnsafeRedirect
+	}
+	return u, nil
+}
+
+// hostAllowed reports whether host matches one of the allowed hosts. An allowed host
+// without port matches any port, and a "*." prefix matches the subdomains.
+func hostAllowed(host string, allowedHosts []string) bool {
+	hostname := host
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		hostname = h
+	}
+	for _, allowed := range allowedHosts {
+		candidate := hostname
+		if _, _, err := net.SplitHostPort(allowed); err == nil {
+			candidate = host
+		} else {
+			allowed = strings.TrimSuffix(strings.TrimPrefix(allowed, "["), "]")
+		}
+		if strings.HasPrefix(allowed, "*.") {
+			if suffix := allowed[1:]; len(candidate) > len(suffix) && strings.HasSuffix(strings.ToLower(candidate), strings.ToLower(suffix)) {
+				return true
+			}
+			continue
+		}
+		if strings.EqualFold(candidate, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// SafeRedirect redirects to target, typically user supplied such as the return URL of a
+// login flow, only if it is a path on the current host or a URL on one of the allowed
+// hosts, e.g. "example.com" or "*.example.com". Otherwise it returns ErrUnsafeRedirect
+// without redirecting.
+//
+//	if err := c.SafeRedirect(c.Query("next"), "accounts.example.com"); err != nil {
+//		c.Redirect(http.StatusFound, "/")
+//	}
+func (c *Context) SafeRedirect(target string, allowedHosts ...string) error {
+	if _, err := safeRedirectTarget(target, allowedHosts); err != nil {
+		return err
+	}
+	c.Redirect(c.redirectCode(), target)
+	return nil
+}
+
+// RedirectBack redirects to the page the request comes from, according to its Referer
+// header, or to fallback if there is none. Referers on other hosts are not followed, to
+// prevent open redirects.
+func (c *Context) RedirectBack(fallback string) {
+	location := fallback
+	if u, err := safeRedirectTarget(c.requestHeader("Referer"), []string{c.Request.Host}); err == nil {
+		location = u.RequestURI()
+	}
+	c.Redirect(c.redirectCode(), location)
+}
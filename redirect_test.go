@@ -0,0 +1,84 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostAllowed(t *testing.T) {
+	allowed := []string{"example.com", "*.trusted.org", "admin.io:8443", "[::1]"}
+	assert.True(t, hostAllowed("example.com", allowed))
+	assert.True(t, hostAllowed("EXAMPLE.com:8080", allowed))
+	assert.True(t, hostAllowed("a.b.trusted.org", allowed))
+	assert.True(t, hostAllowed("admin.io:8443", allowed))
+	assert.True(t, hostAllowed("[::1]:80", allowed))
+	assert.False(t, hostAllowed("trusted.org", allowed))
+	assert.False(t, hostAllowed("eviltrusted.org", allowed))
+	assert.False(t, hostAllowed("admin.io", allowed))
+	assert.False(t, hostAllowed("example.com.evil.com", allowed))
+}
+
+func TestContextSafeRedirect(t *testing.T) {
+	router := New()
+	router.GET("/login", func(c *Context) {
+		if err := c.SafeRedirect(c.Query("next"), "example.com", "*.example.com"); err != nil {
+			c.String(http.StatusBadRequest, "%s", err.Error())
+		}
+	})
+
+	cases := map[string]bool{
+		"/dashboard?tab=1":              true,
+		"https://accounts.example.com/": true,
+		"http://example.com:8080/home":  true,
+		"https://evil.com/":             false,
+		"//evil.com/":                   false,
+		"/\\evil.com/":                  false,
+		"javascript:alert(1)":           false,
+		"https://user@example.com/":     false,
+		"dashboard":                     false,
+		"":                              false,
+		"%zz":                           false,
+	}
+	for target, safe := range cases {
+		w := PerformRequest(router, http.MethodGet, "/login?next="+url.QueryEscape(target))
+		if safe {
+			assert.Equal(t, http.StatusFound, w.Code, target)
+			assert.Equal(t, target, w.Header().Get("Location"))
+		} else {
+			assert.Equal(t, http.StatusBadRequest, w.Code, target)
+			assert.Equal(t, ErrUnsafeRedirect.Error(), w.Body.String())
+		}
+	}
+}
+
+func TestContextRedirectBack(t *testing.T) {
+	router := New()
+	router.POST("/comments", func(c *Context) {
+		c.RedirectBack("/")
+	})
+
+	cases := map[string]string{
+		"":                                   "/",
+		"/articles/1?page=2":                 "/articles/1?page=2",
+		"http://example.com/articles/1":      "/articles/1",
+		"https://EXAMPLE.com/articles/1?a=b": "/articles/1?a=b",
+		"https://evil.com/phishing":          "/",
+		"//evil.com/phishing":                "/",
+		"/\\evil.com/phishing":               "/",
+		"javascript:alert(1)":                "/",
+		"articles/1":                         "/",
+		"%zz":                                "/",
+	}
+	for referer, location := range cases {
+		w := PerformRequest(router, http.MethodPost, "/comments", header{"Referer", referer}, header{"Host", "example.com"})
+		assert.Equal(t, http.StatusSeeOther, w.Code)
+		assert.Equal(t, location, w.Header().Get("Location"), referer)
+	}
+}
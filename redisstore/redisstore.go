@@ -0,0 +1,379 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+// Package redisstore implements gin.Store with Redis, so that the stateful middleware of
+// several instances share their state. It speaks the Redis protocol itself, without
+// dependencies, and only uses the GET, SET, DEL and EVAL commands.
+package redisstore
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	defaultDialTimeout = 5 * time.Second
+	defaultTimeout     = 3 * time.Second
+	defaultPoolSize    = 10
+)
+
+// incrScript increments the key and sets its TTL if it has none, atomically.
+const incrScript = `local n = redis.call('INCRBY', KEYS[1], ARGV[1])
+if tonumber(ARGV[2]) > 0 and redis.call('PTTL', KEYS[1]) == -1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return n`
+
+// errNil is the nil reply of Redis.
+var errNil = errors.New("redisstore: nil reply")
+
+// Error is an error reply of Redis.
+type Error string
+
+func (e Error) Error() string {
+	return "redisstore: " + string(e)
+}
+
+// Config defines the config for New.
+type Config struct {
+	// Addr is the host:port address of the Redis server.
+	Addr string
+
+	// Password authenticates the connections with the AUTH command. Optional.
+	Password string
+
+	// DB is the database selected with the SELECT command. Optional.
+	DB int
+
+	// Prefix is prepended to all the keys. Optional.
+	Prefix string
+
+	// DialTimeout is the timeout to connect to Redis. Optional. Default value is 5 seconds.
+	DialTimeout time.Duration
+
+	// Timeout is the timeout of the commands, unless the context has an earlier deadline.
+	// Optional. Default value is 3 seconds.
+	Timeout time.Duration
+
+	// PoolSize is the maximum number of idle connections kept. Optional. Default value is 10.
+	PoolSize int
+}
+
+// Store is a gin.Store backed by Redis. It is safe for concurrent use.
+type Store struct {
+	config Config
+	idle   chan *conn
+
+	mu     sync.Mutex
+	closed bool
+}
+
+var _ gin.Store = (*Store)(nil)
+
+// New returns a Store connecting to the Redis server of config on demand.
+func New(config Config) *Store {
+	if config.DialTimeout <= 0 {
+		config.DialTimeout = defaultDialTimeout
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = defaultTimeout
+	}
+	if config.PoolSize <= 0 {
+		config.PoolSize = defaultPoolSize
+	}
+	return &Store{config: config, idle: make(chan *conn, config.PoolSize)}
+}
+
+// Close closes the idle connections. The connections in use are closed once released.
+func (s *Store) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		return nil
+	}
+	s.closed = true
+	for {
+		select {
+		case cn := <-s.idle:
+			cn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// Get implements gin.Store.
+func (s *Store) Get(ctx context.Context, key string) ([]byte, error) {
+	reply, err := s.do(ctx, "GET", s.config.Prefix+key)
+	if err == errNil {
+		return nil, gin.ErrStoreMiss
+	}
+	if err != nil {
+		return nil, err
+	}
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, fmt.Errorf("redisstore: unexpected GET reply %v", reply)
+	}
+	return value, nil
+}
+
+// Set implements gin.Store.
+func (s *Store) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	args := []any{"SET", s.config.Prefix + key, value}
+	if ttl > 0 {
+		args = append(args, "PX", ttlMillis(ttl))
+	}
+	_, err := s.do(ctx, args...)
+	return err
+}
+
+// SetNX implements gin.Store.
+func (s *Store) SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	args := []any{"SET", s.config.Prefix + key, value, "NX"}
+	if ttl > 0 {
+		args = append(args, "PX", ttlMillis(ttl))
+	}
+	_, err := s.do(ctx, args...)
+	if err == errNil {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// Incr implements gin.Store.
+func (s *Store) Incr(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	var millis int64
+	if ttl > 0 {
+		millis = ttlMillis(ttl)
+	}
+	reply, err := s.do(ctx, "EVAL", incrScript, 1, s.config.Prefix+key, delta, millis)
+	if err != nil {
+		return 0, err
+	}
+	n, ok := reply.(int64)
+	if !ok {
+		return 0, fmt.Errorf("redisstore: unexpected INCRBY reply %v", reply)
+	}
+	return n, nil
+}
+
+// Delete implements gin.Store.
+func (s *Store) Delete(ctx context.Context, key string) error {
+	_, err := s.do(ctx, "DEL", s.config.Prefix+key)
+	return err
+}
+
+// ttlMillis rounds ttl up to a millisecond, since a zero TTL is refused by Redis.
+func ttlMillis(ttl time.Duration) int64 {
+	return int64((ttl + time.Millisecond - 1) / time.Millisecond)
+}
+
+// deadline returns the deadline of a command: the one of ctx, or the configured timeout
+// if it is earlier.
+func (s *Store) deadline(ctx context.Context) time.Time {
+	deadline := time.Now().Add(s.config.Timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		return d
+	}
+	return deadline
+}
+
+// do sends a command on an idle connection, or a new one, and returns its reply. The
+// connection is closed if ctx is done before the reply is read.
+func (s *Store) do(ctx context.Context, args ...any) (any, error) {
+	cn, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := cn.SetDeadline(s.deadline(ctx)); err != nil {
+		cn.Close()
+		return nil, err
+	}
+	stop := cn.closeOnDone(ctx)
+	reply, err := cn.do(args...)
+	if stop() {
+		return nil, ctx.Err()
+	}
+	var redisErr Error
+	if err != nil && err != errNil && !errors.As(err, &redisErr) {
+		// the connection is out of sync
+		cn.Close()
+		return nil, err
+	}
+	s.put(cn)
+	return reply, err
+}
+
+func (s *Store) get(ctx context.Context) (*conn, error) {
+	select {
+	case cn := <-s.idle:
+		return cn, nil
+	default:
+	}
+
+	dialer := net.Dialer{Timeout: s.config.DialTimeout}
+	nc, err := dialer.DialContext(ctx, "tcp", s.config.Addr)
+	if err != nil {
+		return nil, err
+	}
+	cn := &conn{Conn: nc, r: bufio.NewReader(nc), w: bufio.NewWriter(nc)}
+	nc.SetDeadline(s.deadline(ctx)) // nolint: errcheck
+	if s.config.Password != "" {
+		if _, err := cn.do("AUTH", s.config.Password); err != nil {
+			cn.Close()
+			return nil, err
+		}
+	}
+	if s.config.DB != 0 {
+		if _, err := cn.do("SELECT", s.config.DB); err != nil {
+			cn.Close()
+			return nil, err
+		}
+	}
+	return cn, nil
+}
+
+func (s *Store) put(cn *conn) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.closed {
+		cn.Close()
+		return
+	}
+	select {
+	case s.idle <- cn:
+	default:
+		cn.Close()
+	}
+}
+
+// conn is a connection to Redis.
+type conn struct {
+	net.Conn
+	r *bufio.Reader
+	w *bufio.Writer
+}
+
+// closeOnDone closes the connection once ctx is done, interrupting its I/O, until the
+// returned stop function is called, which reports whether it was closed.
+func (cn *conn) closeOnDone(ctx context.Context) (stop func() bool) {
+	if ctx.Done() == nil {
+		return func() bool { return false }
+	}
+	stopped := make(chan struct{})
+	closed := make(chan bool, 1)
+	go func() {
+		select {
+		case <-ctx.Done():
+			cn.Close()
+			closed <- true
+		case <-stopped:
+			closed <- false
+		}
+	}()
+	return func() bool {
+		close(stopped)
+		return <-closed
+	}
+}
+
+// do writes the command args and reads its reply: a string, an []byte, an int64, a []any,
+// or errNil or an Error.
+func (cn *conn) do(args ...any) (any, error) {
+	fmt.Fprintf(cn.w, "*%d\r\n", len(args))
+	for _, arg := range args {
+		var b []byte
+		switch arg := arg.(type) {
+		case string:
+			b = []byte(arg)
+		case []byte:
+			b = arg
+		case int:
+			b = strconv.AppendInt(nil, int64(arg), 10)
+		case int64:
+			b = strconv.AppendInt(nil, arg, 10)
+		default:
+			return nil, fmt.Errorf("redisstore: unsupported argument type %T", arg)
+		}
+		fmt.Fprintf(cn.w, "$%d\r\n", len(b))
+		cn.w.Write(b)            // nolint: errcheck
+		cn.w.WriteString("\r\n") // nolint: errcheck
+	}
+	if err := cn.w.Flush(); err != nil {
+		return nil, err
+	}
+	return readReply(cn.r)
+}
+
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return "", fmt.Errorf("redisstore: malformed reply %q", line)
+	}
+	return line[:len(line)-2], nil
+}
+
+func readReply(r *bufio.Reader) (any, error) {
+	line, err := readLine(r)
+	if err != nil {
+		return nil, err
+	}
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return nil, Error(line[1:])
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '$':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, errNil
+		}
+		b := make([]byte, n+2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	case '*':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, err
+		}
+		if n < 0 {
+			return nil, errNil
+		}
+		values := make([]any, n)
+		for i := range values {
+			value, err := readReply(r)
+			var redisErr Error
+			switch {
+			case errors.As(err, &redisErr):
+				values[i] = redisErr
+			case err != nil && err != errNil:
+				return nil, err
+			default:
+				values[i] = value
+			}
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("redisstore: malformed reply %q", line)
+}
@@ -0,0 +1,240 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package redisstore
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRedis is a Redis server implementing the commands used by Store.
+type fakeRedis struct {
+	listener net.Listener
+	mu       sync.Mutex
+	values   map[string]string
+	expiries map[string]time.Time
+	commands []string
+}
+
+func newFakeRedis(t *testing.T) *fakeRedis {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	f := &fakeRedis{listener: listener, values: make(map[string]string), expiries: make(map[string]time.Time)}
+	go func() {
+		for {
+			c, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(c)
+		}
+	}()
+	t.Cleanup(func() {
+		listener.Close()
+	})
+	return f
+}
+
+func (f *fakeRedis) serve(c net.Conn) {
+	defer c.Close()
+	r := bufio.NewReader(c)
+	for {
+		reply, err := readReply(r)
+		if err != nil {
+			return
+		}
+		var args []string
+		for _, arg := range reply.([]any) {
+			args = append(args, string(arg.([]byte)))
+		}
+		c.Write([]byte(f.exec(args))) // nolint: errcheck
+	}
+}
+
+func (f *fakeRedis) live(key string) (string, bool) {
+	if expiry, ok := f.expiries[key]; ok && !time.Now().Before(expiry) {
+		delete(f.values, key)
+		delete(f.expiries, key)
+	}
+	value, ok := f.values[key]
+	return value, ok
+}
+
+func (f *fakeRedis) exec(args []string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.commands = append(f.commands, args[0])
+	switch args[0] {
+	case "AUTH":
+		if args[1] != "secret" {
+			return "-WRONGPASS invalid password\r\n"
+		}
+		return "+OK\r\n"
+	case "SELECT":
+		return "+OK\r\n"
+	case "GET":
+		value, ok := f.live(args[1])
+		if !ok {
+			return "$-1\r\n"
+		}
+		return "$" + strconv.Itoa(len(value)) + "\r\n" + value + "\r\n"
+	case "SET":
+		_, exists := f.live(args[1])
+		var expiry time.Time
+		for i := 3; i < len(args); i++ {
+			switch args[i] {
+			case "NX":
+				if exists {
+					return "$-1\r\n"
+				}
+			case "PX":
+				ms, _ := strconv.Atoi(args[i+1])
+				expiry = time.Now().Add(time.Duration(ms) * time.Millisecond)
+				i++
+			}
+		}
+		f.values[args[1]] = args[2]
+		delete(f.expiries, args[1])
+		if !expiry.IsZero() {
+			f.expiries[args[1]] = expiry
+		}
+		return "+OK\r\n"
+	case "DEL":
+		delete(f.values, args[1])
+		delete(f.expiries, args[1])
+		return ":1\r\n"
+	case "EVAL":
+		if args[1] != incrScript {
+			return "-ERR unknown script\r\n"
+		}
+		key := args[3]
+		value, _ := f.live(key)
+		n, err := strconv.ParseInt("0"+value, 10, 64)
+		if err != nil {
+			return "-ERR value is not an integer or out of range\r\n"
+		}
+		delta, _ := strconv.ParseInt(args[4], 10, 64)
+		n += delta
+		f.values[key] = strconv.FormatInt(n, 10)
+		if ms, _ := strconv.Atoi(args[5]); ms > 0 {
+			if _, ok := f.expiries[key]; !ok {
+				f.expiries[key] = time.Now().Add(time.Duration(ms) * time.Millisecond)
+			}
+		}
+		return ":" + strconv.FormatInt(n, 10) + "\r\n"
+	}
+	return "-ERR unknown command '" + args[0] + "'\r\n"
+}
+
+func TestStore(t *testing.T) {
+	f := newFakeRedis(t)
+	s := New(Config{Addr: f.listener.Addr().String(), Password: "secret", DB: 1, Prefix: "app:"})
+	defer s.Close()
+	ctx := context.Background()
+
+	_, err := s.Get(ctx, "missing")
+	assert.Equal(t, gin.ErrStoreMiss, err)
+
+	assert.NoError(t, s.Set(ctx, "greeting", []byte("hello\r\nworld"), 0))
+	value, err := s.Get(ctx, "greeting")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello\r\nworld", string(value))
+	assert.Contains(t, f.values, "app:greeting")
+
+	ok, err := s.SetNX(ctx, "greeting", []byte("other"), time.Minute)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = s.SetNX(ctx, "lock", []byte("1"), time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	n, err := s.Incr(ctx, "counter", 2, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	n, err = s.Incr(ctx, "counter", 3, time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), n)
+
+	_, err = s.Incr(ctx, "greeting", 1, 0)
+	assert.Equal(t, Error("ERR value is not an integer or out of range"), err)
+
+	assert.NoError(t, s.Delete(ctx, "greeting"))
+	_, err = s.Get(ctx, "greeting")
+	assert.Equal(t, gin.ErrStoreMiss, err)
+
+	// the connection is reused
+	f.mu.Lock()
+	assert.Equal(t, []string{"AUTH", "SELECT"}, f.commands[:2])
+	assert.Equal(t, 1, strings.Count(strings.Join(f.commands, " "), "AUTH"))
+	f.mu.Unlock()
+}
+
+func TestStoreExpiry(t *testing.T) {
+	f := newFakeRedis(t)
+	s := New(Config{Addr: f.listener.Addr().String()})
+	defer s.Close()
+	ctx := context.Background()
+
+	assert.NoError(t, s.Set(ctx, "key", []byte("value"), time.Microsecond))
+	time.Sleep(2 * time.Millisecond)
+	_, err := s.Get(ctx, "key")
+	assert.Equal(t, gin.ErrStoreMiss, err)
+}
+
+func TestStoreErrors(t *testing.T) {
+	f := newFakeRedis(t)
+	s := New(Config{Addr: f.listener.Addr().String(), Password: "wrong"})
+	_, err := s.Get(context.Background(), "key")
+	assert.Equal(t, Error("WRONGPASS invalid password"), err)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := listener.Addr().String()
+	listener.Close()
+	s = New(Config{Addr: addr})
+	_, err = s.Get(context.Background(), "key")
+	assert.Error(t, err)
+}
+
+func TestStoreStalled(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	go func() {
+		for {
+			cn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer cn.Close()
+		}
+	}()
+
+	s := New(Config{Addr: listener.Addr().String(), Timeout: 50 * time.Millisecond})
+	start := time.Now()
+	_, err = s.Get(context.Background(), "key")
+	var netErr net.Error
+	assert.ErrorAs(t, err, &netErr)
+	assert.True(t, netErr.Timeout())
+	assert.Less(t, time.Since(start), time.Second)
+
+	s = New(Config{Addr: listener.Addr().String(), Timeout: time.Minute})
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	start = time.Now()
+	_, err = s.Get(ctx, "key")
+	assert.Equal(t, context.Canceled, err)
+	assert.Less(t, time.Since(start), time.Second)
+}
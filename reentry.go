@@ -0,0 +1,99 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"net/http"
+)
+
+// ErrHandleContextDepth is returned, and attached to the context, when a request re-enters
+// the engine more than Engine.MaxHandleContextDepth times.
+var ErrHandleContextDepth = errors.New("gin: too many nested HandleContext calls, internal redirects are probably looping")
+
+// ReentryOptions tells which state of the context the new route of a HandleContext call
+// starts with. By default it starts afresh, like a new request.
+type ReentryOptions struct {
+	// KeepParams keeps the params of the previous route whose names the new route does not
+	// use, after the params of the new route.
+	KeepParams bool
+
+	// KeepKeys shares the keys with the new route, e.g. the user set by an authentication
+	// middleware, instead of starting with none.
+	KeepKeys bool
+
+	// CopyKeys starts the new route with a copy of the keys, the keys it sets being dropped
+	// on return. It is ignored when KeepKeys is set.
+	CopyKeys bool
+
+	// KeepErrors shares the errors with the new route instead of starting with none.
+	KeepErrors bool
+}
+
+// inheritParams appends the inherited params whose names the matched route does not use.
+func (c *Context) inheritParams() {
+	n := len(c.Params)
+	for _, param := range c.inheritedParams {
+		if _, ok := c.Params[:n].Get(param.Key); !ok {
+			c.Params = append(c.Params, param)
+		}
+	}
+	*c.params = c.Params
+}
+
+// HandleContextWithOptions re-enters a context that has been rewritten, like HandleContext,
+// with the state selected by opts. Once the new route is served, the route, params, keys
+// and errors of the context are restored, the keys and errors set by the new route being
+// kept when shared, and the errors it attached being appended otherwise. It returns
+// ErrHandleContextDepth, after aborting the request with a 500, when the request
+// re-entered more than Engine.MaxHandleContextDepth times.
+func (engine *Engine) HandleContextWithOptions(c *Context, opts ReentryOptions) error {
+	if engine.MaxHandleContextDepth > 0 && c.reentryDepth >= engine.MaxHandleContextDepth {
+		debugPrint("[WARNING] %v, %s %s aborted\n", ErrHandleContextDepth, c.Request.Method, c.Request.URL.Path)
+		c.Error(ErrHandleContextDepth).SetType(ErrorTypePrivate) // nolint: errcheck
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return ErrHandleContextDepth
+	}
+	if c.params == nil || c.skippedNodes == nil {
+		// contexts that were not pooled, such as copies, get their own slices once
+		engine.allocateParams(c)
+	}
+
+	// the params and errors of the context are reused by the new route, save them
+	oldIndex, oldHandlers, oldFullPath := c.index, c.handlers, c.fullPath
+	oldParams := append(Params(nil), c.Params...)
+	oldKeys, oldErrors := c.Keys, append(errorMsgs(nil), c.Errors...)
+
+	c.reset()
+	if opts.KeepParams && len(oldParams) > 0 {
+		c.inheritedParams = oldParams
+	}
+	if opts.KeepKeys {
+		c.Keys = oldKeys
+	} else if opts.CopyKeys && oldKeys != nil {
+		c.Keys = make(map[string]any, len(oldKeys))
+		for k, v := range oldKeys {
+			c.Keys[k] = v
+		}
+	}
+	if opts.KeepErrors {
+		c.Errors = append(c.Errors, oldErrors...)
+	}
+	c.reentryDepth++
+	defer func() {
+		c.reentryDepth--
+		c.index, c.handlers, c.fullPath = oldIndex, oldHandlers, oldFullPath
+		c.Params, c.inheritedParams = oldParams, nil
+		if !opts.KeepKeys {
+			c.Keys = oldKeys
+		}
+		if !opts.KeepErrors {
+			c.Errors = append(oldErrors, c.Errors...)
+		}
+	}()
+
+	engine.handleHTTPRequest(c)
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleContextDepth(t *testing.T) {
+	r := New()
+	r.MaxHandleContextDepth = 3
+	var calls int
+	var err error
+	r.GET("/loop", func(c *Context) {
+		calls++
+		if e := r.HandleContextWithOptions(c, ReentryOptions{}); e != nil {
+			err = e
+		}
+	})
+
+	w := PerformRequest(r, http.MethodGet, "/loop")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Equal(t, 4, calls)
+	assert.Equal(t, ErrHandleContextDepth, err)
+
+	// the depth is per request
+	calls = 0
+	PerformRequest(r, http.MethodGet, "/loop")
+	assert.Equal(t, 4, calls)
+}
+
+func TestHandleContextRestoresState(t *testing.T) {
+	r := New()
+	var user, after string
+	r.GET("/users/:id", func(c *Context) {
+		c.Set("user", "gopher")
+		c.Next()
+		user = c.GetString("user")
+	}, func(c *Context) {
+		c.Error(errors.New("outer")) // nolint: errcheck
+		c.Request.URL.Path = "/books/" + c.Param("id")
+		r.HandleContext(c)
+		after = c.FullPath() + " " + c.Param("id")
+		assert.Equal(t, []string{"outer", "inner"}, c.Errors.Errors())
+	})
+	r.GET("/books/:book", func(c *Context) {
+		_, exists := c.Get("user")
+		c.String(http.StatusOK, "%s %t %d", c.Param("book"), exists, len(c.Errors))
+		c.Error(errors.New("inner")) // nolint: errcheck
+	})
+
+	w := PerformRequest(r, http.MethodGet, "/users/42")
+	assert.Equal(t, "42 false 0", w.Body.String())
+	assert.Equal(t, "gopher", user)
+	assert.Equal(t, "/users/:id 42", after)
+}
+
+func TestHandleContextWithOptions(t *testing.T) {
+	r := New()
+	var after string
+	r.GET("/users/:id/:tab", func(c *Context) {
+		c.Set("user", "gopher")
+		c.Error(errors.New("outer")) // nolint: errcheck
+		c.Request.URL.Path = "/books/1"
+		assert.NoError(t, r.HandleContextWithOptions(c, ReentryOptions{KeepParams: true, KeepKeys: true, KeepErrors: true}))
+		after = c.FullPath() + " " + c.Param("id") + " " + c.GetString("book")
+	})
+	r.GET("/books/:id", func(c *Context) {
+		c.Set("book", "read")
+		c.String(http.StatusOK, "%s %s %s %d", c.Param("id"), c.Param("tab"), c.GetString("user"), len(c.Errors))
+	})
+
+	w := PerformRequest(r, http.MethodGet, "/users/42/settings")
+	assert.Equal(t, "1 settings gopher 1", w.Body.String())
+	assert.Equal(t, "/users/:id/:tab 42 read", after)
+}
@@ -7,6 +7,8 @@ package render
 import (
 	"html/template"
 	"net/http"
+
+	"github.com/gin-gonic/gin/internal/bufpool"
 )
 
 // Delims represents a set of Left and Right delimiters for HTML template rendering.
@@ -80,10 +82,19 @@ func (r HTMLDebug) loadTemplate() *template.Template {
 func (r HTML) Render(w http.ResponseWriter) error {
 	r.WriteContentType(w)
 
+	buf := bufpool.Get(0)
+	defer bufpool.Put(buf)
+	var err error
 	if r.Name == "" {
-		return r.Template.Execute(w, r.Data)
+		err = r.Template.Execute(buf, r.Data)
+	} else {
+		err = r.Template.ExecuteTemplate(buf, r.Name, r.Data)
+	}
+	if err != nil {
+		return err
 	}
-	return r.Template.ExecuteTemplate(w, r.Name, r.Data)
+	_, err = w.Write(buf.Bytes())
+	return err
 }
 
 // WriteContentType (HTML) writes HTML ContentType.
@@ -9,7 +9,10 @@ import (
 	"fmt"
 	"html/template"
 	"net/http"
+	"strconv"
+	"sync"
 
+	"github.com/gin-gonic/gin/internal/bufpool"
 	"github.com/gin-gonic/gin/internal/bytesconv"
 	"github.com/gin-gonic/gin/internal/json"
 )
@@ -19,6 +22,11 @@ type JSON struct {
 	Data any
 }
 
+// SizedJSON contains the given interface object, written with its Content-Length.
+type SizedJSON struct {
+	Data any
+}
+
 // IndentedJSON contains the given interface object.
 type IndentedJSON struct {
 	Data any
@@ -68,18 +76,119 @@ func (r JSON) WriteContentType(w http.ResponseWriter) {
 // WriteJSON marshals the given interface object and writes it with custom ContentType.
 func WriteJSON(w http.ResponseWriter, obj any) error {
 	writeContentType(w, jsonContentType)
-	jsonBytes, err := json.Marshal(obj)
+	buf := bufpool.Get(0)
+	defer bufpool.Put(buf)
+	jsonBytes, err := marshalJSON(buf, obj, false)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(jsonBytes)
+	return err
+}
+
+// jsonEncoder is the encoder of the JSON packages gin can be built with.
+type jsonEncoder interface {
+	Encode(v any) error
+	SetIndent(prefix, indent string)
+}
+
+// pooledJSONEncoder is a JSON encoder writing to the buffer it is given, recycled as the
+// encoders are costly to allocate.
+type pooledJSONEncoder struct {
+	buf *bytes.Buffer
+	enc jsonEncoder
+}
+
+func (e *pooledJSONEncoder) Write(p []byte) (int, error) {
+	return e.buf.Write(p)
+}
+
+// jsonEncoders are the pools of the compact and of the indented encoders.
+var jsonEncoders = [2]sync.Pool{
+	{New: func() any {
+		e := &pooledJSONEncoder{}
+		e.enc = json.NewEncoder(e)
+		return e
+	}},
+	{New: func() any {
+		e := &pooledJSONEncoder{}
+		e.enc = json.NewEncoder(e)
+		e.enc.SetIndent("", "    ")
+		return e
+	}},
+}
+
+// marshalJSON marshals obj into buf, indented if indent is set, and returns the bytes
+// written, as json.Marshal or json.MarshalIndent would return them.
+func marshalJSON(buf *bytes.Buffer, obj any, indent bool) ([]byte, error) {
+	pool := &jsonEncoders[0]
+	if indent {
+		pool = &jsonEncoders[1]
+	}
+	e := pool.Get().(*pooledJSONEncoder)
+	e.buf = buf
+	if err := e.enc.Encode(obj); err != nil {
+		// some encoders keep the error, so they are not reused
+		return nil, err
+	}
+	e.buf = nil
+	pool.Put(e)
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// maxPreallocatedLength is the size of the bodies whose Content-Length header values are
+// preallocated.
+const maxPreallocatedLength = 2048
+
+var (
+	contentLengths     [maxPreallocatedLength][]string
+	contentLengthsOnce sync.Once
+)
+
+// contentLength returns the Content-Length header value of a body of n bytes, preallocated
+// for the small bodies.
+func contentLength(n int) []string {
+	if n >= maxPreallocatedLength {
+		return []string{strconv.Itoa(n)}
+	}
+	contentLengthsOnce.Do(func() {
+		for i := range contentLengths {
+			contentLengths[i] = []string{strconv.Itoa(i)}
+		}
+	})
+	return contentLengths[n]
+}
+
+// Render (SizedJSON) marshals the given interface object and writes it with custom
+// ContentType and its Content-Length, in a single Write, so that the server sends the
+// headers and the body of the small responses together, without chunking the body.
+func (r SizedJSON) Render(w http.ResponseWriter) error {
+	r.WriteContentType(w)
+	buf := bufpool.Get(0)
+	defer bufpool.Put(buf)
+	jsonBytes, err := marshalJSON(buf, r.Data, false)
 	if err != nil {
 		return err
 	}
+	header := w.Header()
+	if _, ok := header["Content-Length"]; !ok {
+		header["Content-Length"] = contentLength(len(jsonBytes))
+	}
 	_, err = w.Write(jsonBytes)
 	return err
 }
 
+// WriteContentType (SizedJSON) writes JSON ContentType.
+func (r SizedJSON) WriteContentType(w http.ResponseWriter) {
+	writeContentType(w, jsonContentType)
+}
+
 // Render (IndentedJSON) marshals the given interface object and writes it with custom ContentType.
 func (r IndentedJSON) Render(w http.ResponseWriter) error {
 	r.WriteContentType(w)
-	jsonBytes, err := json.MarshalIndent(r.Data, "", "    ")
+	buf := bufpool.Get(0)
+	defer bufpool.Put(buf)
+	jsonBytes, err := marshalJSON(buf, r.Data, true)
 	if err != nil {
 		return err
 	}
@@ -95,7 +204,9 @@ func (r IndentedJSON) WriteContentType(w http.ResponseWriter) {
 // Render (SecureJSON) marshals the given interface object and writes it with custom ContentType.
 func (r SecureJSON) Render(w http.ResponseWriter) error {
 	r.WriteContentType(w)
-	jsonBytes, err := json.Marshal(r.Data)
+	buf := bufpool.Get(0)
+	defer bufpool.Put(buf)
+	jsonBytes, err := marshalJSON(buf, r.Data, false)
 	if err != nil {
 		return err
 	}
@@ -118,7 +229,9 @@ func (r SecureJSON) WriteContentType(w http.ResponseWriter) {
 // Render (JsonpJSON) marshals the given interface object and writes it and its callback with custom ContentType.
 func (r JsonpJSON) Render(w http.ResponseWriter) (err error) {
 	r.WriteContentType(w)
-	ret, err := json.Marshal(r.Data)
+	buf := bufpool.Get(0)
+	defer bufpool.Put(buf)
+	ret, err := marshalJSON(buf, r.Data, false)
 	if err != nil {
 		return err
 	}
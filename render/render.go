@@ -16,6 +16,7 @@ type Render interface {
 
 var (
 	_ Render     = JSON{}
+	_ Render     = SizedJSON{}
 	_ Render     = IndentedJSON{}
 	_ Render     = SecureJSON{}
 	_ Render     = JsonpJSON{}
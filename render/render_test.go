@@ -70,6 +70,36 @@ func TestRenderIndentedJSONPanics(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestRenderSizedJSON(t *testing.T) {
+	w := httptest.NewRecorder()
+	assert.NoError(t, (SizedJSON{map[string]any{"foo": "bar"}}).Render(w))
+	assert.Equal(t, `{"foo":"bar"}`, w.Body.String())
+	assert.Equal(t, "application/json; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "13", w.Header().Get("Content-Length"))
+
+	w = httptest.NewRecorder()
+	assert.NoError(t, (SizedJSON{strings.Repeat("a", 5000)}).Render(w))
+	assert.Equal(t, "5002", w.Header().Get("Content-Length"))
+
+	w = httptest.NewRecorder()
+	w.Header().Set("Content-Length", "42")
+	assert.NoError(t, (SizedJSON{"a"}).Render(w))
+	assert.Equal(t, "42", w.Header().Get("Content-Length"))
+
+	assert.Error(t, (SizedJSON{make(chan int)}).Render(httptest.NewRecorder()))
+}
+
+func TestRenderJSONAfterError(t *testing.T) {
+	for i := 0; i < 3; i++ {
+		assert.Error(t, (IndentedJSON{make(chan int)}).Render(httptest.NewRecorder()))
+		assert.Error(t, WriteJSON(httptest.NewRecorder(), make(chan int)))
+
+		w := httptest.NewRecorder()
+		assert.NoError(t, WriteJSON(w, map[string]any{"foo": "bar"}))
+		assert.Equal(t, `{"foo":"bar"}`, w.Body.String())
+	}
+}
+
 func TestRenderSecureJSON(t *testing.T) {
 	w1 := httptest.NewRecorder()
 	data := map[string]any{
@@ -401,6 +431,21 @@ func TestRenderHTMLTemplate(t *testing.T) {
 	assert.Equal(t, "text/html; charset=utf-8", w.Header().Get("Content-Type"))
 }
 
+func TestRenderHTMLTemplateError(t *testing.T) {
+	w := httptest.NewRecorder()
+	templ := template.Must(template.New("t").Parse(`Hello {{.name.first}}`))
+
+	htmlRender := HTMLProduction{Template: templ}
+	instance := htmlRender.Instance("t", map[string]any{
+		"name": "alexandernyquist",
+	})
+
+	err := instance.Render(w)
+
+	assert.Error(t, err)
+	assert.Empty(t, w.Body.String(), "nothing is written when the template fails")
+}
+
 func TestRenderHTMLTemplateEmptyName(t *testing.T) {
 	w := httptest.NewRecorder()
 	templ := template.Must(template.New("").Parse(`Hello {{.name}}`))
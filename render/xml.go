@@ -7,6 +7,8 @@ package render
 import (
 	"encoding/xml"
 	"net/http"
+
+	"github.com/gin-gonic/gin/internal/bufpool"
 )
 
 // XML contains the given interface object.
@@ -19,7 +21,13 @@ var xmlContentType = []string{"application/xml; charset=utf-8"}
 // Render (XML) encodes the given interface object and writes data with custom ContentType.
 func (r XML) Render(w http.ResponseWriter) error {
 	r.WriteContentType(w)
-	return xml.NewEncoder(w).Encode(r.Data)
+	buf := bufpool.Get(0)
+	defer bufpool.Put(buf)
+	if err := xml.NewEncoder(buf).Encode(r.Data); err != nil {
+		return err
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
 }
 
 // WriteContentType (XML) writes XML ContentType for response.
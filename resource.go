@@ -0,0 +1,109 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+)
+
+// ResourceStatusError is the error passed to the close function of WithResource when the
+// request failed with an error status and without error attached to the context.
+type ResourceStatusError struct {
+	Status int
+}
+
+func (e ResourceStatusError) Error() string {
+	return fmt.Sprintf("gin: request failed with status %d %s", e.Status, http.StatusText(e.Status))
+}
+
+// ResourcePanicError is the error passed to the close function of WithResource when a
+// handler panicked. The panic goes on once the resource is closed.
+type ResourcePanicError struct {
+	Value any
+}
+
+func (e ResourcePanicError) Error() string {
+	return fmt.Sprintf("gin: handler panicked: %v", e.Value)
+}
+
+// resourceKey returns the key of the resources of type T in the keys of the context.
+func resourceKey[T any]() string {
+	return "_gin-gonic/gin/resource/" + reflect.TypeOf((*T)(nil)).Elem().String()
+}
+
+// WithResource returns a middleware opening a resource for the request, e.g. a database
+// transaction, available to the handlers with Resource, and closing it once they return.
+// If open fails, the request is aborted with a 500 and the error. close gets a nil error
+// if the request succeeded, else the last error attached to the context, a
+// ResourceStatusError if the status is 400 or above, or a ResourcePanicError, so that it
+// commits or rolls back. Since the response may be written before close is called, the
+// handlers should not write it before the resource can not fail anymore.
+//
+//	router.Use(gin.WithResource(func(c *gin.Context) (*sql.Tx, error) {
+//		return db.BeginTx(c, nil)
+//	}, func(tx *sql.Tx, err error) {
+//		if err != nil {
+//			tx.Rollback()
+//		} else {
+//			tx.Commit()
+//		}
+//	}))
+func WithResource[T any](open func(c *Context) (T, error), close func(resource T, err error)) HandlerFunc {
+	assert1(open != nil && close != nil, "resource open and close functions can not be nil")
+	key := resourceKey[T]()
+	return func(c *Context) {
+		resource, err := open(c)
+		if err != nil {
+			c.AbortWithError(http.StatusInternalServerError, err) // nolint: errcheck
+			return
+		}
+		c.Set(key, resource)
+
+		panicked := true
+		defer func() {
+			if panicked {
+				value := recover()
+				close(resource, ResourcePanicError{Value: value})
+				if value != nil {
+					panic(value)
+				}
+			}
+		}()
+		c.Next()
+		panicked = false
+
+		switch {
+		case len(c.Errors) > 0:
+			err = c.Errors.Last()
+		case c.Writer.Status() >= http.StatusBadRequest:
+			err = ResourceStatusError{Status: c.Writer.Status()}
+		}
+		close(resource, err)
+	}
+}
+
+// Resource returns the resource of type T opened by the WithResource middleware, and
+// whether there is one.
+func Resource[T any](c *Context) (T, bool) {
+	resource, ok := c.Get(resourceKey[T]())
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	typed, ok := resource.(T)
+	return typed, ok
+}
+
+// MustResource returns the resource of type T opened by the WithResource middleware, and
+// panics if there is none.
+func MustResource[T any](c *Context) T {
+	resource, ok := Resource[T](c)
+	if !ok {
+		panic("gin: no resource of type " + reflect.TypeOf((*T)(nil)).Elem().String())
+	}
+	return resource
+}
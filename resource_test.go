@@ -0,0 +1,90 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeTx struct {
+	id     int
+	result string
+}
+
+func TestWithResource(t *testing.T) {
+	var txs []*fakeTx
+	r := New()
+	r.Use(WithResource(func(c *Context) (*fakeTx, error) {
+		if c.Query("fail") != "" {
+			return nil, errors.New("no connection")
+		}
+		tx := &fakeTx{id: len(txs) + 1}
+		txs = append(txs, tx)
+		return tx, nil
+	}, func(tx *fakeTx, err error) {
+		if err != nil {
+			tx.result = "rollback: " + err.Error()
+		} else {
+			tx.result = "commit"
+		}
+	}))
+	r.GET("/ok", func(c *Context) {
+		tx := MustResource[*fakeTx](c)
+		c.String(http.StatusOK, "%d", tx.id)
+	})
+	r.GET("/error", func(c *Context) {
+		c.Error(errors.New("invalid order")) // nolint: errcheck
+	})
+	r.GET("/status", func(c *Context) {
+		c.Status(http.StatusConflict)
+	})
+	r.GET("/panic", func(c *Context) {
+		panic("boom")
+	})
+
+	w := PerformRequest(r, http.MethodGet, "/ok")
+	assert.Equal(t, "1", w.Body.String())
+	assert.Equal(t, "commit", txs[0].result)
+
+	PerformRequest(r, http.MethodGet, "/error")
+	assert.Equal(t, "rollback: invalid order", txs[1].result)
+
+	PerformRequest(r, http.MethodGet, "/status")
+	assert.Equal(t, "rollback: gin: request failed with status 409 Conflict", txs[2].result)
+
+	assert.PanicsWithValue(t, "boom", func() {
+		PerformRequest(r, http.MethodGet, "/panic")
+	})
+	assert.Equal(t, "rollback: gin: handler panicked: boom", txs[3].result)
+
+	w = PerformRequest(r, http.MethodGet, "/ok?fail=1")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Len(t, txs, 4)
+}
+
+func TestResourceMissing(t *testing.T) {
+	c, _ := CreateTestContext(nil)
+	_, ok := Resource[*fakeTx](c)
+	assert.False(t, ok)
+	assert.PanicsWithValue(t, "gin: no resource of type *gin.fakeTx", func() {
+		MustResource[*fakeTx](c)
+	})
+
+	// resources are keyed by type
+	c.Set(resourceKey[*fakeTx](), &fakeTx{id: 1})
+	_, ok = Resource[fakeTx](c)
+	assert.False(t, ok)
+	tx, ok := Resource[*fakeTx](c)
+	assert.True(t, ok)
+	assert.Equal(t, 1, tx.id)
+
+	assert.Panics(t, func() {
+		WithResource[*fakeTx](nil, nil)
+	})
+}
@@ -43,10 +43,24 @@ type ResponseWriter interface {
 	Pusher() http.Pusher
 }
 
+// hijackTracker is implemented by the writers recording whether the connection was
+// hijacked, the status and size being then meaningless since the response is written
+// directly on the connection.
+type hijackTracker interface {
+	Hijacked() bool
+}
+
+// hijacked returns true if the connection of w is known to be hijacked.
+func hijacked(w ResponseWriter) bool {
+	t, ok := w.(hijackTracker)
+	return ok && t.Hijacked()
+}
+
 type responseWriter struct {
 	http.ResponseWriter
-	size   int
-	status int
+	size     int
+	status   int
+	hijacked bool
 }
 
 var _ ResponseWriter = &responseWriter{}
@@ -55,12 +69,15 @@ func (w *responseWriter) reset(writer http.ResponseWriter) {
 	w.ResponseWriter = writer
 	w.size = noWritten
 	w.status = defaultStatus
+	w.hijacked = false
 }
 
 func (w *responseWriter) WriteHeader(code int) {
 	if code > 0 && w.status != code {
 		if w.Written() {
-			debugPrint("[WARNING] Headers were already written. Wanted to override status code %d with %d", w.status, code)
+			if IsDebugging() {
+				debugPrint("[WARNING] Headers were already written. Wanted to override status code %d with %d", w.status, code)
+			}
 		}
 		w.status = code
 	}
@@ -74,6 +91,9 @@ func (w *responseWriter) WriteHeaderNow() {
 }
 
 func (w *responseWriter) Write(data []byte) (n int, err error) {
+	if w.hijacked {
+		return 0, http.ErrHijacked
+	}
 	w.WriteHeaderNow()
 	n, err = w.ResponseWriter.Write(data)
 	w.size += n
@@ -81,6 +101,9 @@ func (w *responseWriter) Write(data []byte) (n int, err error) {
 }
 
 func (w *responseWriter) WriteString(s string) (n int, err error) {
+	if w.hijacked {
+		return 0, http.ErrHijacked
+	}
 	w.WriteHeaderNow()
 	n, err = io.WriteString(w.ResponseWriter, s)
 	w.size += n
@@ -104,7 +127,16 @@ func (w *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	if w.size < 0 {
 		w.size = 0
 	}
-	return w.ResponseWriter.(http.Hijacker).Hijack()
+	conn, rw, err := w.ResponseWriter.(http.Hijacker).Hijack()
+	if err == nil {
+		w.hijacked = true
+	}
+	return conn, rw, err
+}
+
+// Hijacked returns true if the connection was hijacked.
+func (w *responseWriter) Hijacked() bool {
+	return w.hijacked
 }
 
 // CloseNotify implements the http.CloseNotifier interface.
@@ -114,6 +146,9 @@ func (w *responseWriter) CloseNotify() <-chan bool {
 
 // Flush implements the http.Flusher interface.
 func (w *responseWriter) Flush() {
+	if w.hijacked {
+		return
+	}
 	w.WriteHeaderNow()
 	w.ResponseWriter.(http.Flusher).Flush()
 }
@@ -5,6 +5,8 @@
 package gin
 
 import (
+	"bufio"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -132,3 +134,37 @@ func TestResponseWriterFlush(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
 }
+
+func TestResponseWriterHijacked(t *testing.T) {
+	testWriter := &hijackableRecorder{httptest.NewRecorder()}
+	writer := &responseWriter{}
+	writer.reset(testWriter)
+	w := ResponseWriter(writer)
+
+	assert.False(t, hijacked(w))
+	_, _, err := w.Hijack()
+	assert.NoError(t, err)
+	assert.True(t, hijacked(w))
+
+	n, err := w.Write([]byte("hola"))
+	assert.Equal(t, 0, n)
+	assert.Equal(t, http.ErrHijacked, err)
+	n, err = w.WriteString("hola")
+	assert.Equal(t, 0, n)
+	assert.Equal(t, http.ErrHijacked, err)
+	w.Flush()
+	w.WriteHeaderNow()
+	assert.Equal(t, 0, testWriter.Body.Len())
+	assert.False(t, testWriter.Flushed)
+
+	writer.reset(testWriter)
+	assert.False(t, hijacked(w))
+}
+
+type hijackableRecorder struct {
+	*httptest.ResponseRecorder
+}
+
+func (r *hijackableRecorder) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, nil
+}
@@ -0,0 +1,187 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// routeIdentifierInitialisms are the words written in upper case in the identifiers of
+// the route constants.
+var routeIdentifierInitialisms = map[string]bool{"api": true, "id": true, "ids": true, "url": true, "uuid": true}
+
+// ExportRouteConstants returns the source of a Go file of package pkgName declaring, for
+// each registered path, a constant holding it and, if it has params, a function building
+// it from their values, which are escaped. The identifiers derive from the route name, see
+// RouterGroup.Named, or else from the path, e.g. for "/users/:id":
+//
+//	// RouteUsersByID is the path of the routes GET and DELETE /users/:id.
+//	const RouteUsersByID = "/users/:id"
+//
+//	// PathUsersByID returns the path of the routes GET and DELETE /users/:id.
+//	func PathUsersByID(id string) string
+//
+// Clients and tests using the generated file, e.g. through go generate, fail to compile
+// when a path they use changes. It fails if two paths get the same identifier.
+func (engine *Engine) ExportRouteConstants(pkgName string) ([]byte, error) {
+	if !token.IsIdentifier(pkgName) {
+		return nil, fmt.Errorf("gin: invalid package name %q", pkgName)
+	}
+
+	methods := make(map[string][]string)
+	names := make(map[string]string)
+	for _, route := range engine.Routes() {
+		methods[route.Path] = append(methods[route.Path], route.Method)
+		if name, ok := route.Meta[RouteNameMetaKey].(string); ok && (names[route.Path] == "" || name < names[route.Path]) {
+			names[route.Path] = name
+		}
+	}
+	paths := make([]string, 0, len(methods))
+	for path := range methods {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	var body bytes.Buffer
+	imports := make(map[string]bool)
+	identifiers := make(map[string]string, len(paths))
+	for _, path := range paths {
+		var ident string
+		if name := names[path]; name != "" {
+			ident = routeIdentifier(strings.FieldsFunc(name, isNotAlphanumeric))
+		} else {
+			ident = routePathIdentifier(path)
+		}
+		if other, ok := identifiers[ident]; ok {
+			return nil, fmt.Errorf("gin: paths %q and %q have the same identifier %s", other, path, ident)
+		}
+		identifiers[ident] = path
+
+		sort.Strings(methods[path])
+		routes := fmt.Sprintf("the routes %s %s", joinWords(methods[path]), path)
+		if len(methods[path]) == 1 {
+			routes = fmt.Sprintf("the route %s %s", methods[path][0], path)
+		}
+		fmt.Fprintf(&body, "// Route%s is the path of %s.\nconst Route%s = %s\n\n", ident, routes, ident, strconv.Quote(path))
+
+		params := routeParams(path)
+		if len(params) == 0 {
+			continue
+		}
+		args := make([]string, len(params))
+		for i, param := range params {
+			args[i] = routeArgName(param.Name)
+		}
+		fmt.Fprintf(&body, "// Path%s returns the path of %s.\nfunc Path%s(%s string) string {\n\treturn ", ident, routes, ident, strings.Join(args, ", "))
+		rest := path
+		for i, param := range params {
+			wildcard, j, _ := findWildcard(rest)
+			if j > 0 {
+				fmt.Fprintf(&body, "%s + ", strconv.Quote(rest[:j]))
+			}
+			if param.CatchAll {
+				fmt.Fprintf(&body, "(&url.URL{Path: strings.TrimPrefix(%s, \"/\")}).EscapedPath()", args[i])
+				imports["strings"] = true
+			} else {
+				fmt.Fprintf(&body, "url.PathEscape(%s)", args[i])
+			}
+			imports["net/url"] = true
+			rest = rest[j+len(wildcard):]
+			if rest != "" {
+				body.WriteString(" + ")
+			}
+		}
+		if rest != "" {
+			body.WriteString(strconv.Quote(rest))
+		}
+		body.WriteString("\n}\n\n")
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gin.Engine.ExportRouteConstants. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package %s\n\n", pkgName)
+	if len(imports) > 0 {
+		buf.WriteString("import (\n")
+		for _, pkg := range []string{"net/url", "strings"} {
+			if imports[pkg] {
+				fmt.Fprintf(&buf, "\t%s\n", strconv.Quote(pkg))
+			}
+		}
+		buf.WriteString(")\n\n")
+	}
+	buf.Write(body.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+// routePathIdentifier returns the identifier of the constants of path: its words in camel
+// case, the params being introduced by "By", or "Root" for the root path.
+func routePathIdentifier(path string) string {
+	var words []string
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			continue
+		}
+		if segment[0] == ':' || segment[0] == '*' {
+			words = append(words, "by")
+			segment = segment[1:]
+		}
+		words = append(words, strings.FieldsFunc(segment, isNotAlphanumeric)...)
+	}
+	if len(words) == 0 {
+		return "Root"
+	}
+	return routeIdentifier(words)
+}
+
+// routeIdentifier returns words in upper camel case.
+func routeIdentifier(words []string) string {
+	var sb strings.Builder
+	for _, word := range words {
+		if routeIdentifierInitialisms[strings.ToLower(word)] {
+			sb.WriteString(strings.ToUpper(word))
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		sb.WriteString(string(runes))
+	}
+	return sb.String()
+}
+
+// routeArgName returns the name of the builder argument of the param name, in lower camel
+// case and not shadowing a keyword or the imported packages.
+func routeArgName(name string) string {
+	words := strings.FieldsFunc(name, isNotAlphanumeric)
+	if len(words) == 0 {
+		return "param"
+	}
+	arg := strings.ToLower(words[0]) + routeIdentifier(words[1:])
+	if unicode.IsDigit([]rune(arg)[0]) {
+		arg = "p" + arg
+	}
+	if token.IsKeyword(arg) || arg == "url" || arg == "strings" {
+		arg += "Param"
+	}
+	return arg
+}
+
+func isNotAlphanumeric(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// joinWords joins words with commas, the last one with "and".
+func joinWords(words []string) string {
+	if len(words) == 1 {
+		return words[0]
+	}
+	return strings.Join(words[:len(words)-1], ", ") + " and " + words[len(words)-1]
+}
@@ -0,0 +1,79 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngineExportRouteConstants(t *testing.T) {
+	router := New()
+	router.GET("/", func(c *Context) {})
+	router.GET("/users/:id", func(c *Context) {})
+	router.DELETE("/users/:id", func(c *Context) {})
+	router.Named("shelf.books").GET("/shelves/:shelf_id/books/:type", func(c *Context) {})
+	router.GET("/static/*filepath", func(c *Context) {})
+	router.GET("/api/health-check", func(c *Context) {})
+
+	src, err := router.ExportRouteConstants("routes")
+	assert.NoError(t, err)
+	assert.Equal(t, `// Code generated by gin.Engine.ExportRouteConstants. DO NOT EDIT.
+
+package routes
+
+import (
+	"net/url"
+	"strings"
+)
+
+// RouteRoot is the path of the route GET /.
+const RouteRoot = "/"
+
+// RouteAPIHealthCheck is the path of the route GET /api/health-check.
+const RouteAPIHealthCheck = "/api/health-check"
+
+// RouteShelfBooks is the path of the route GET /shelves/:shelf_id/books/:type.
+const RouteShelfBooks = "/shelves/:shelf_id/books/:type"
+
+// PathShelfBooks returns the path of the route GET /shelves/:shelf_id/books/:type.
+func PathShelfBooks(shelfID, typeParam string) string {
+	return "/shelves/" + url.PathEscape(shelfID) + "/books/" + url.PathEscape(typeParam)
+}
+
+// RouteStaticByFilepath is the path of the route GET /static/*filepath.
+const RouteStaticByFilepath = "/static/*filepath"
+
+// PathStaticByFilepath returns the path of the route GET /static/*filepath.
+func PathStaticByFilepath(filepath string) string {
+	return "/static/" + (&url.URL{Path: strings.TrimPrefix(filepath, "/")}).EscapedPath()
+}
+
+// RouteUsersByID is the path of the routes DELETE and GET /users/:id.
+const RouteUsersByID = "/users/:id"
+
+// PathUsersByID returns the path of the routes DELETE and GET /users/:id.
+func PathUsersByID(id string) string {
+	return "/users/" + url.PathEscape(id)
+}
+`, string(src))
+}
+
+func TestEngineExportRouteConstantsErrors(t *testing.T) {
+	router := New()
+	_, err := router.ExportRouteConstants("not a package")
+	assert.Error(t, err)
+
+	router.GET("/user-list", func(c *Context) {})
+	router.GET("/user_list", func(c *Context) {})
+	_, err = router.ExportRouteConstants("routes")
+	assert.EqualError(t, err, `gin: paths "/user-list" and "/user_list" have the same identifier UserList`)
+
+	router = New()
+	src, err := router.ExportRouteConstants("routes")
+	assert.NoError(t, err)
+	assert.Equal(t, "// Code generated by gin.Engine.ExportRouteConstants. DO NOT EDIT.\n\npackage routes\n", string(src))
+}
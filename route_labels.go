@@ -0,0 +1,44 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import "reflect"
+
+// HandlerName returns the name of handler, e.g. "main.getUser", as Context.HandlerName.
+// The names are cached by the engine, resolving them from the symbol table only once.
+func (engine *Engine) HandlerName(handler HandlerFunc) string {
+	pc := reflect.ValueOf(handler).Pointer()
+	if name, ok := engine.handlerNames.Load(pc); ok {
+		return name.(string)
+	}
+	name := nameOfFunction(handler)
+	engine.handlerNames.Store(pc, name)
+	return name
+}
+
+// RouteLabels returns the path template of the matched route, e.g. "/users/:id", and the
+// name of its handler, as reported by the Logger, the Metrics and the ProfileLabels
+// middleware, or "unmatched" and an empty name if no route matched. The templates are
+// shared with the tree and the names are cached by the engine, so the labels cost no
+// allocation.
+func (c *Context) RouteLabels() (route, handler string) {
+	if c.fullPath == "" {
+		return unmatchedRoute, ""
+	}
+	return c.fullPath, c.HandlerName()
+}
+
+// internRoute returns the string of the route template path already registered, if
+// any, so that the routes of a template registered for several methods share it.
+func (engine *Engine) internRoute(path string) string {
+	if interned, ok := engine.routeTemplates[path]; ok {
+		return interned
+	}
+	if engine.routeTemplates == nil {
+		engine.routeTemplates = make(map[string]string)
+	}
+	engine.routeTemplates[path] = path
+	return path
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteLabels(t *testing.T) {
+	router := New()
+	var route, handler string
+	router.GET("/users/:id", func(c *Context) {
+		route, handler = c.RouteLabels()
+	})
+	PerformRequest(router, http.MethodGet, "/users/42")
+	assert.Equal(t, "/users/:id", route)
+	assert.Equal(t, "github.com/gin-gonic/gin.TestRouteLabels.func1", handler)
+
+	router.Use(func(c *Context) {
+		c.Next()
+		route, handler = c.RouteLabels()
+	})
+	PerformRequest(router, http.MethodGet, "/missing")
+	assert.Equal(t, unmatchedRoute, route)
+	assert.Empty(t, handler)
+
+	c, _ := CreateTestContext(httptest.NewRecorder())
+	c.handlers = HandlersChain{handlerNameTest}
+	c.fullPath = "/"
+	c.engine = nil
+	route, handler = c.RouteLabels()
+	assert.Equal(t, "/", route)
+	assert.Equal(t, "github.com/gin-gonic/gin.handlerNameTest", handler)
+}
+
+func TestEngineHandlerName(t *testing.T) {
+	router := New()
+	name := router.HandlerName(handlerNameTest)
+	assert.Equal(t, "github.com/gin-gonic/gin.handlerNameTest", name)
+	assert.Equal(t, name, router.HandlerName(handlerNameTest))
+	assert.Empty(t, router.HandlerName(nil))
+
+	cached, ok := router.handlerNames.Load(reflect.ValueOf(handlerNameTest).Pointer())
+	assert.True(t, ok)
+	assert.Equal(t, name, cached)
+	assert.Zero(t, testing.AllocsPerRun(10, func() {
+		router.HandlerName(handlerNameTest)
+	}))
+}
+
+func TestInternRoute(t *testing.T) {
+	router := New()
+	router.GET(string([]byte("/users/:id")), func(*Context) {})
+	router.POST(string([]byte("/users/:id")), func(*Context) {})
+	router.PUT("/users", func(*Context) {})
+
+	routes := router.Routes()
+	get, _, _ := router.Lookup(http.MethodGet, "/users/1")
+	post, _, _ := router.Lookup(http.MethodPost, "/users/1")
+	assert.Len(t, routes, 3)
+	assert.Equal(t, stringData(get.Path), stringData(post.Path), "the templates are shared")
+}
+
+func stringData(s string) uintptr {
+	return (*reflect.StringHeader)(unsafe.Pointer(&s)).Data // nolint: staticcheck
+}
+
+func TestLoggerRouteLabels(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	router := New()
+	router.Use(LoggerWithConfig(LoggerConfig{Output: buffer, Formatter: JSONLogFormatter}))
+	router.GET("/users/:id", handlerNameTest)
+
+	PerformRequest(router, http.MethodGet, "/users/42")
+	assert.Contains(t, buffer.String(), `"route":"/users/:id","handler":"github.com/gin-gonic/gin.handlerNameTest"`)
+
+	buffer.Reset()
+	PerformRequest(router, http.MethodGet, "/missing")
+	assert.Contains(t, buffer.String(), `"route":"unmatched"`)
+	assert.NotContains(t, buffer.String(), `"handler"`)
+}
+
+func BenchmarkRouteLabels(b *testing.B) {
+	router := New()
+	router.GET("/users/:id", handlerNameTest)
+	c, _ := CreateTestContext(httptest.NewRecorder())
+	c.engine = router
+	c.fullPath = "/users/:id"
+	c.handlers = HandlersChain{handlerNameTest}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		c.RouteLabels()
+	}
+}
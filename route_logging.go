@@ -0,0 +1,98 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"io"
+)
+
+// RouteLogMetaKey is the route metadata key holding the RouteLogConfig of the routes, see
+// RouterGroup.WithLogging.
+const RouteLogMetaKey = "_gin-gonic/gin/log"
+
+// defaultLogBodySize is the default maximum number of bytes of the logged bodies.
+const defaultLogBodySize = 4096
+
+// RouteLogConfig overrides the configuration of the Logger middleware for routes.
+type RouteLogConfig struct {
+	// Skip disables the logging of the requests, e.g. of the health checks.
+	Skip bool
+
+	// Always logs all the requests, even if their path is one of the SkipPaths of the
+	// Logger, SampleRate being ignored.
+	Always bool
+
+	// SampleRate is the fraction of the requests logged, e.g. 0.1 to log one request
+	// out of ten. Optional. Default value logs them all.
+	SampleRate float64
+
+	// Bodies logs the bodies of the requests, as read by the handlers, and of the
+	// responses, see LogFormatterParams.RequestBody.
+	Bodies bool
+
+	// MaxBodySize is the maximum number of bytes logged of each body. Optional. Default
+	// value is 4096.
+	MaxBodySize int
+}
+
+// WithLogging returns a group, with the same path and middleware, whose routes override
+// the configuration of the Logger middleware with conf. The Logger reads it once the route
+// is matched, so it still runs as a global middleware:
+//
+//	router.WithLogging(gin.RouteLogConfig{Skip: true}).GET("/healthz", health)
+//	router.WithLogging(gin.RouteLogConfig{Always: true, Bodies: true}).POST("/payments", pay)
+func (group *RouterGroup) WithLogging(conf RouteLogConfig) *RouterGroup {
+	assert1(conf.SampleRate >= 0 && conf.SampleRate <= 1, "log sample rate must be between 0 and 1")
+	if conf.MaxBodySize <= 0 {
+		conf.MaxBodySize = defaultLogBodySize
+	}
+	return group.WithMeta(RouteLogMetaKey, conf)
+}
+
+// logBuffer keeps the first max bytes written to it.
+type logBuffer struct {
+	data []byte
+	max  int
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	if n := b.max - len(b.data); n > 0 {
+		if len(p) < n {
+			n = len(p)
+		}
+		b.data = append(b.data, p[:n]...)
+	}
+	return len(p), nil
+}
+
+// logBodyReader copies the request body read by the handlers to a logBuffer.
+type logBodyReader struct {
+	io.ReadCloser
+	buf *logBuffer
+}
+
+func (r logBodyReader) Read(p []byte) (int, error) {
+	n, err := r.ReadCloser.Read(p)
+	r.buf.Write(p[:n]) // nolint: errcheck
+	return n, err
+}
+
+// logBodyWriter copies the response body to a logBuffer.
+type logBodyWriter struct {
+	ResponseWriter
+	buf *logBuffer
+}
+
+func (w *logBodyWriter) Write(data []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(data)
+	w.buf.Write(data[:n]) // nolint: errcheck
+	return n, err
+}
+
+func (w *logBodyWriter) WriteString(s string) (int, error) {
+	n, err := w.ResponseWriter.WriteString(s)
+	w.buf.Write([]byte(s[:n])) // nolint: errcheck
+	return n, err
+}
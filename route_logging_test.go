@@ -0,0 +1,116 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteLoggingSkipAndAlways(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	router := New()
+	router.Use(LoggerWithConfig(LoggerConfig{Output: buffer, SkipPaths: []string{"/payments"}}))
+	router.WithLogging(RouteLogConfig{Skip: true}).GET("/healthz", func(c *Context) {})
+	router.WithLogging(RouteLogConfig{Always: true, SampleRate: 0.01}).POST("/payments", func(c *Context) {})
+	router.GET("/users", func(c *Context) {})
+
+	PerformRequest(router, http.MethodGet, "/healthz")
+	assert.Empty(t, buffer.String())
+
+	PerformRequest(router, http.MethodPost, "/payments")
+	assert.Contains(t, buffer.String(), "/payments")
+
+	buffer.Reset()
+	PerformRequest(router, http.MethodGet, "/users")
+	assert.Contains(t, buffer.String(), "/users")
+}
+
+func TestRouteLoggingSampleRate(t *testing.T) {
+	lines := 0
+	router := New()
+	router.Use(LoggerWithConfig(LoggerConfig{
+		Output: io.Discard,
+		Formatter: func(param LogFormatterParams) string {
+			lines++
+			return ""
+		},
+	}))
+	router.WithLogging(RouteLogConfig{SampleRate: 0.5}).GET("/sampled", func(c *Context) {})
+	router.WithLogging(RouteLogConfig{SampleRate: 1}).GET("/all", func(c *Context) {})
+
+	for i := 0; i < 1000; i++ {
+		PerformRequest(router, http.MethodGet, "/sampled")
+	}
+	assert.Greater(t, lines, 300)
+	assert.Less(t, lines, 700)
+
+	lines = 0
+	for i := 0; i < 100; i++ {
+		PerformRequest(router, http.MethodGet, "/all")
+	}
+	assert.Equal(t, 100, lines)
+}
+
+func TestRouteLoggingBodies(t *testing.T) {
+	var params []LogFormatterParams
+	router := New()
+	router.Use(LoggerWithConfig(LoggerConfig{
+		Output: io.Discard,
+		Formatter: func(param LogFormatterParams) string {
+			params = append(params, param)
+			return ""
+		},
+	}))
+	payments := router.Group("/payments").WithLogging(RouteLogConfig{Bodies: true, MaxBodySize: 8})
+	payments.POST("", func(c *Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusCreated, "created %s", body)
+	})
+	payments.GET("/:id", func(c *Context) {
+		c.Status(http.StatusNoContent)
+	})
+	router.POST("/users", func(c *Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusOK, string(body))
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/payments", strings.NewReader("amount=12")))
+	assert.Equal(t, "created amount=12", w.Body.String())
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/payments/1", nil))
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader("name=gin")))
+
+	assert.Len(t, params, 3)
+	assert.Equal(t, []byte("amount=1"), params[0].RequestBody)
+	assert.Equal(t, []byte("created "), params[0].ResponseBody)
+	assert.NotNil(t, params[1].RequestBody)
+	assert.Empty(t, params[1].RequestBody)
+	assert.Empty(t, params[1].ResponseBody)
+	assert.Nil(t, params[2].RequestBody)
+	assert.Nil(t, params[2].ResponseBody)
+
+	assert.Contains(t, defaultLogFormatter(params[0]), "  request body: \"amount=1\"\n  response body: \"created \"\n")
+	assert.NotContains(t, defaultLogFormatter(params[2]), "request body")
+	assert.Contains(t, JSONLogFormatter(params[0]), `"request_body":"amount=1","response_body":"created "`)
+	assert.NotContains(t, JSONLogFormatter(params[2]), "request_body")
+}
+
+func TestRouteLoggingInvalidSampleRate(t *testing.T) {
+	router := New()
+	assert.PanicsWithValue(t, "log sample rate must be between 0 and 1", func() {
+		router.WithLogging(RouteLogConfig{SampleRate: 1.5})
+	})
+}
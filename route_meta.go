@@ -0,0 +1,47 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+// WithMeta returns a group, with the same path and middleware, whose routes carry the
+// metadata key set to value, on top of the metadata of group. Metadata describe routes,
+// e.g. the permissions they require, for the middleware and tools reading them through
+// Context.RouteMeta and Engine.Routes.
+//
+//	admin := router.Group("/admin").WithMeta("audit", true)
+//	admin.GET("/users", listUsers)
+func (group *RouterGroup) WithMeta(key string, value any) *RouterGroup {
+	child := group.derive("", nil)
+	child.meta = make(map[string]any, len(group.meta)+1)
+	for k, v := range group.meta {
+		child.meta[k] = v
+	}
+	child.meta[key] = value
+	return child
+}
+
+func routeKey(method, path string) string {
+	return method + " " + path
+}
+
+func (engine *Engine) setRouteMeta(method, path string, meta map[string]any) {
+	if engine.routeMeta == nil {
+		engine.routeMeta = make(map[string]map[string]any)
+	}
+	engine.routeMeta[routeKey(method, path)] = meta
+	engine.indexRouteName(path, meta)
+}
+
+// RouteMeta returns the metadata key of the matched route, see RouterGroup.WithMeta.
+func (c *Context) RouteMeta(key string) (any, bool) {
+	if c.constrained != nil {
+		value, ok := c.constrained.meta[key]
+		return value, ok
+	}
+	if c.fullPath == "" || len(c.engine.routeMeta) == 0 {
+		return nil, false
+	}
+	value, ok := c.engine.routeMeta[routeKey(c.routeMethod(), c.fullPath)][key]
+	return value, ok
+}
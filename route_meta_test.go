@@ -0,0 +1,48 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRouteMeta(t *testing.T) {
+	router := New()
+	admin := router.Group("/admin").WithMeta("audit", true)
+	users := admin.Group("/users").WithMeta("resource", "users")
+	users.GET("/:id", func(c *Context) {
+		audit, _ := c.RouteMeta("audit")
+		resource, _ := c.RouteMeta("resource")
+		_, ok := c.RouteMeta("missing")
+		c.String(http.StatusOK, "%v %v %v", audit, resource, ok)
+	})
+	admin.GET("/stats", func(c *Context) {
+		_, ok := c.RouteMeta("resource")
+		c.String(http.StatusOK, "%v", ok)
+	})
+	router.GET("/", func(c *Context) {
+		_, ok := c.RouteMeta("audit")
+		c.String(http.StatusOK, "%v", ok)
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/admin/users/1")
+	assert.Equal(t, "true users false", w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/admin/stats")
+	assert.Equal(t, "false", w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, "false", w.Body.String())
+
+	for _, route := range router.Routes() {
+		switch route.Path {
+		case "/admin/users/:id":
+			assert.Equal(t, map[string]any{"audit": true, "resource": "users"}, route.Meta)
+		case "/":
+			assert.Nil(t, route.Meta)
+		}
+	}
+}
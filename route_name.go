@@ -0,0 +1,85 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// RouteNameMetaKey is the route metadata key holding the route name, see RouterGroup.Named.
+const RouteNameMetaKey = "_gin-gonic/gin/name"
+
+// Named returns a group, with the same path and middleware, naming the routes it registers
+// name, so URLs to them are built from their name with Engine.URLFor. A name is meant for
+// a single path, possibly registered for several methods:
+//
+//	router.Named("user.show").GET("/users/:id", showUser)
+func (group *RouterGroup) Named(name string) *RouterGroup {
+	return group.WithMeta(RouteNameMetaKey, name)
+}
+
+// indexRouteName records the path of the route named by meta, if any.
+func (engine *Engine) indexRouteName(path string, meta map[string]any) {
+	name, ok := meta[RouteNameMetaKey].(string)
+	if !ok {
+		return
+	}
+	if engine.routeNames == nil {
+		engine.routeNames = make(map[string]string)
+	}
+	if existing, ok := engine.routeNames[name]; ok && existing != path {
+		panic(fmt.Sprintf("route name '%s' is already used by path '%s'", name, existing))
+	}
+	engine.routeNames[name] = path
+}
+
+// URLFor returns the path of the route name, its params being replaced by the values of
+// params, which are escaped. It fails if the route does not exist or a param is missing.
+func (engine *Engine) URLFor(name string, params map[string]string) (string, error) {
+	path, ok := engine.routeNames[name]
+	if !ok {
+		return "", fmt.Errorf("gin: no route named %q", name)
+	}
+	url, missing := fillPath(path, params)
+	if missing != "" {
+		return "", fmt.Errorf("gin: missing param %q for route %q", missing, name)
+	}
+	return url, nil
+}
+
+// RedirectOption changes how the redirect helpers build the location.
+type RedirectOption uint8
+
+const (
+	// KeepQuery appends the query string of the request to the location.
+	KeepQuery RedirectOption = iota + 1
+)
+
+// redirectCode returns 302 Found for GET and HEAD requests, 303 See Other otherwise so the
+// client follows the redirect with a GET.
+func (c *Context) redirectCode() int {
+	if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+		return http.StatusFound
+	}
+	return http.StatusSeeOther
+}
+
+// RedirectToRoute redirects to the route name, see Engine.URLFor. It panics if the URL
+// can not be built.
+//
+//	c.RedirectToRoute("user.show", map[string]string{"id": id}, gin.KeepQuery)
+func (c *Context) RedirectToRoute(name string, params map[string]string, options ...RedirectOption) {
+	location, err := c.engine.URLFor(name, params)
+	if err != nil {
+		panic(err)
+	}
+	for _, option := range options {
+		if option == KeepQuery && c.Request.URL.RawQuery != "" {
+			location += "?" + c.Request.URL.RawQuery
+		}
+	}
+	c.Redirect(c.redirectCode(), location)
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngineURLFor(t *testing.T) {
+	router := New()
+	show := router.Group("/users").Named("user.show")
+	show.GET("/:id", func(c *Context) {})
+	show.PUT("/:id", func(c *Context) {})
+	router.Named("file").GET("/files/:owner/*path", func(c *Context) {})
+	router.Named("home").GET("/", func(c *Context) {})
+
+	url, err := router.URLFor("user.show", map[string]string{"id": "a b"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/users/a%20b", url)
+
+	url, err = router.URLFor("file", map[string]string{"owner": "me", "path": "/docs/read me.txt"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/files/me/docs/read%20me.txt", url)
+
+	url, err = router.URLFor("home", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "/", url)
+
+	_, err = router.URLFor("file", map[string]string{"owner": "me"})
+	assert.EqualError(t, err, `gin: missing param "path" for route "file"`)
+	_, err = router.URLFor("missing", nil)
+	assert.EqualError(t, err, `gin: no route named "missing"`)
+
+	assert.PanicsWithValue(t, "route name 'home' is already used by path '/'", func() {
+		router.Named("home").GET("/home", func(c *Context) {})
+	})
+}
+
+func TestContextRedirectToRoute(t *testing.T) {
+	router := New()
+	router.Named("user.show").GET("/users/:id", func(c *Context) {})
+	router.Any("/profile", func(c *Context) {
+		c.RedirectToRoute("user.show", map[string]string{"id": "42"}, KeepQuery)
+	})
+	router.GET("/broken", func(c *Context) {
+		c.RedirectToRoute("user.show", nil)
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/profile?tab=posts")
+	assert.Equal(t, http.StatusFound, w.Code)
+	assert.Equal(t, "/users/42?tab=posts", w.Header().Get("Location"))
+
+	w = PerformRequest(router, http.MethodPost, "/profile")
+	assert.Equal(t, http.StatusSeeOther, w.Code)
+	assert.Equal(t, "/users/42", w.Header().Get("Location"))
+
+	assert.Panics(t, func() {
+		PerformRequest(router, http.MethodGet, "/broken")
+	})
+}
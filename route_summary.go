@@ -0,0 +1,172 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+)
+
+// RouteSummaryFormat is the format of the route summary, see Engine.WriteRouteSummary.
+type RouteSummaryFormat uint8
+
+const (
+	// RouteSummaryText writes a table of the routes, grouped by the first segment of their
+	// path, with aligned columns.
+	RouteSummaryText RouteSummaryFormat = iota
+	// RouteSummaryJSON writes a JSON object per route and line, for log processors.
+	RouteSummaryJSON
+)
+
+// DebugRouteSummaryFormat is the format of the route summary written to DefaultWriter when
+// the engine starts serving in debug mode, from a Run method.
+var DebugRouteSummaryFormat = RouteSummaryText
+
+// RouteSummary describes a registered route.
+type RouteSummary struct {
+	Method string `json:"method"`
+	// Host is the host pattern of the route, for the routes registered with Engine.Host.
+	Host    string `json:"host,omitempty"`
+	Path    string `json:"path"`
+	Handler string `json:"handler"`
+	// Middleware is the number of handlers running before the handler of the route.
+	Middleware int `json:"middleware"`
+	// Group is the first segment of the path, e.g. "/users" for "/users/:id".
+	Group string `json:"group"`
+}
+
+// RouteSummaries returns the summaries of the registered routes, see Engine.Routes, sorted
+// by path, method and host. The alternatives of a route with constraints are listed in
+// their registration order.
+func (engine *Engine) RouteSummaries() []RouteSummary {
+	routes := engine.Routes()
+	summaries := make([]RouteSummary, 0, len(routes))
+	for _, route := range routes {
+		summaries = append(summaries, RouteSummary{
+			Method:     route.Method,
+			Host:       route.Host,
+			Path:       route.Path,
+			Handler:    engine.HandlerName(route.HandlerFunc),
+			Middleware: route.middleware,
+			Group:      routeGroupPrefix(route.Path),
+		})
+	}
+	sort.SliceStable(summaries, func(i, j int) bool {
+		if summaries[i].Path != summaries[j].Path {
+			return summaries[i].Path < summaries[j].Path
+		}
+		if summaries[i].Method != summaries[j].Method {
+			return summaries[i].Method < summaries[j].Method
+		}
+		return summaries[i].Host < summaries[j].Host
+	})
+	return summaries
+}
+
+// routeGroupPrefix returns the first segment of path.
+func routeGroupPrefix(path string) string {
+	if i := strings.IndexByte(path[1:], '/'); i >= 0 {
+		return path[:i+1]
+	}
+	return path
+}
+
+// WriteRouteSummary writes the summary of the registered routes to w, in format. The
+// methods of the text table are colored if w is a terminal, unless the console colors are
+// disabled, see DisableConsoleColor. The paths of the routes registered with Engine.Host
+// are prefixed with their host pattern:
+//
+//	3 routes:
+//	/users
+//	  GET     /users      --> main.listUsers   (2 middleware)
+//	  POST    /users      --> main.createUser  (2 middleware)
+//	  DELETE  /users/:id  --> main.deleteUser  (3 middleware)
+func (engine *Engine) WriteRouteSummary(w io.Writer, format RouteSummaryFormat) error {
+	summaries := engine.RouteSummaries()
+	if format == RouteSummaryJSON {
+		enc := json.NewEncoder(w)
+		for _, summary := range summaries {
+			if err := enc.Encode(summary); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return writeRouteTable(w, summaries, "", isColorOutput(w))
+}
+
+// writeRouteTable writes the text table of summaries, each line starting with prefix.
+func writeRouteTable(w io.Writer, summaries []RouteSummary, prefix string, color bool) error {
+	methodWidth, pathWidth, handlerWidth := 0, 0, 0
+	for _, s := range summaries {
+		if len(s.Method) > methodWidth {
+			methodWidth = len(s.Method)
+		}
+		if len(s.Host)+len(s.Path) > pathWidth {
+			pathWidth = len(s.Host) + len(s.Path)
+		}
+		if len(s.Handler) > handlerWidth {
+			handlerWidth = len(s.Handler)
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s%d routes:\n", prefix, len(summaries))
+	group := ""
+	for i, s := range summaries {
+		if i == 0 || s.Group != group {
+			group = s.Group
+			fmt.Fprintf(&sb, "%s%s\n", prefix, group)
+		}
+		method := fmt.Sprintf("%-*s", methodWidth, s.Method)
+		if color {
+			method = (&LogFormatterParams{Method: s.Method}).MethodColor() + method + reset
+		}
+		fmt.Fprintf(&sb, "%s  %s  %-*s  --> %-*s  (%d middleware)\n", prefix, method, pathWidth, s.Host+s.Path, handlerWidth, s.Handler, s.Middleware)
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// isColorOutput reports whether the colors are written to w, see LogFormatterParams.IsOutputColor.
+func isColorOutput(w io.Writer) bool {
+	if consoleColorMode == forceColor {
+		return true
+	}
+	if consoleColorMode == disableColor {
+		return false
+	}
+	f, ok := w.(*os.File)
+	return ok && os.Getenv("TERM") != "dumb" && (isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd()))
+}
+
+// printRouteSummaryOnce writes the route summary, once, when the engine starts serving
+// from a Run method.
+func (engine *Engine) printRouteSummaryOnce() {
+	engine.summaryOnce.Do(engine.debugPrintRouteSummary)
+}
+
+// debugPrintRouteSummary writes the route summary to DefaultWriter in debug mode, see
+// DebugRouteSummaryFormat.
+func (engine *Engine) debugPrintRouteSummary() {
+	if !IsDebuggingCategory(DebugRouter) || len(engine.trees) == 0 {
+		return
+	}
+	var err error
+	if DebugRouteSummaryFormat == RouteSummaryJSON {
+		err = engine.WriteRouteSummary(DefaultWriter, RouteSummaryJSON)
+	} else {
+		err = writeRouteTable(DefaultWriter, engine.RouteSummaries(), "[GIN-debug] ", isColorOutput(DefaultWriter))
+	}
+	if err != nil {
+		debugPrintError(err)
+	}
+}
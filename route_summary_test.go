@@ -0,0 +1,116 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRouteSummaryRouter() *Engine {
+	router := New()
+	router.Use(func(c *Context) {})
+	router.GET("/", handlerNameTest)
+	users := router.Group("/users", func(c *Context) {})
+	users.GET("", handlerNameTest)
+	users.DELETE("/:id", handlerNameTest2)
+	router.POST("/login", handlerNameTest)
+	return router
+}
+
+func TestEngineRouteSummaries(t *testing.T) {
+	summaries := newRouteSummaryRouter().RouteSummaries()
+	assert.Len(t, summaries, 4)
+	assert.Equal(t, RouteSummary{Method: http.MethodGet, Path: "/", Handler: "github.com/gin-gonic/gin.handlerNameTest", Middleware: 1, Group: "/"}, summaries[0])
+	assert.Equal(t, RouteSummary{Method: http.MethodPost, Path: "/login", Handler: "github.com/gin-gonic/gin.handlerNameTest", Middleware: 1, Group: "/login"}, summaries[1])
+	assert.Equal(t, RouteSummary{Method: http.MethodGet, Path: "/users", Handler: "github.com/gin-gonic/gin.handlerNameTest", Middleware: 2, Group: "/users"}, summaries[2])
+	assert.Equal(t, RouteSummary{Method: http.MethodDelete, Path: "/users/:id", Handler: "github.com/gin-gonic/gin.handlerNameTest2", Middleware: 2, Group: "/users"}, summaries[3])
+}
+
+func TestEngineWriteRouteSummary(t *testing.T) {
+	router := newRouteSummaryRouter()
+
+	var buf bytes.Buffer
+	assert.NoError(t, router.WriteRouteSummary(&buf, RouteSummaryText))
+	assert.Equal(t, `4 routes:
+/
+  GET     /           --> github.com/gin-gonic/gin.handlerNameTest   (1 middleware)
+/login
+  POST    /login      --> github.com/gin-gonic/gin.handlerNameTest   (1 middleware)
+/users
+  GET     /users      --> github.com/gin-gonic/gin.handlerNameTest   (2 middleware)
+  DELETE  /users/:id  --> github.com/gin-gonic/gin.handlerNameTest2  (2 middleware)
+`, buf.String())
+
+	buf.Reset()
+	assert.NoError(t, router.WriteRouteSummary(&buf, RouteSummaryJSON))
+	assert.Equal(t, `{"method":"GET","path":"/","handler":"github.com/gin-gonic/gin.handlerNameTest","middleware":1,"group":"/"}
+{"method":"POST","path":"/login","handler":"github.com/gin-gonic/gin.handlerNameTest","middleware":1,"group":"/login"}
+{"method":"GET","path":"/users","handler":"github.com/gin-gonic/gin.handlerNameTest","middleware":2,"group":"/users"}
+{"method":"DELETE","path":"/users/:id","handler":"github.com/gin-gonic/gin.handlerNameTest2","middleware":2,"group":"/users"}
+`, buf.String())
+}
+
+func TestEngineWriteRouteSummaryColor(t *testing.T) {
+	ForceConsoleColor()
+	defer func() { consoleColorMode = autoColor }()
+
+	router := New()
+	router.GET("/", handlerNameTest)
+	router.Handle("PURGE", "/cache", handlerNameTest)
+
+	var buf bytes.Buffer
+	assert.NoError(t, router.WriteRouteSummary(&buf, RouteSummaryText))
+	assert.Contains(t, buf.String(), "  "+blue+"GET  "+reset+"  /       -->")
+	assert.Contains(t, buf.String(), "  "+reset+"PURGE"+reset+"  /cache  -->")
+}
+
+func TestDebugPrintRouteSummary(t *testing.T) {
+	router := newRouteSummaryRouter()
+	re := captureOutput(t, func() {
+		SetMode(DebugMode)
+		router.debugPrintRouteSummary()
+		SetMode(TestMode)
+	})
+	assert.Contains(t, re, "[GIN-debug] 4 routes:\n[GIN-debug] /\n[GIN-debug]   GET     /           --> ")
+
+	re = captureOutput(t, func() {
+		router.debugPrintRouteSummary()
+	})
+	assert.Empty(t, re)
+
+	DebugRouteSummaryFormat = RouteSummaryJSON
+	defer func() { DebugRouteSummaryFormat = RouteSummaryText }()
+	re = captureOutput(t, func() {
+		SetMode(DebugMode)
+		router.debugPrintRouteSummary()
+		SetMode(TestMode)
+	})
+	assert.Contains(t, re, `{"method":"GET","path":"/","handler":`)
+	assert.NotContains(t, re, "[GIN-debug]")
+}
+
+func TestEngineRouteSummariesHostsAndConstraints(t *testing.T) {
+	router := New()
+	router.GET(`/users/:id(\d+)`, handlerNameTest)
+	router.GET("/users/:id", func(c *Context) {}, handlerNameTest2)
+	router.Host("api.example.com").GET("/users", handlerNameTest)
+	router.GET("/users", handlerNameTest2)
+
+	summaries := router.RouteSummaries()
+	assert.Equal(t, []RouteSummary{
+		{Method: http.MethodGet, Path: "/users", Handler: "github.com/gin-gonic/gin.handlerNameTest2", Group: "/users"},
+		{Method: http.MethodGet, Host: "api.example.com", Path: "/users", Handler: "github.com/gin-gonic/gin.handlerNameTest", Group: "/users"},
+		{Method: http.MethodGet, Path: "/users/:id", Handler: "github.com/gin-gonic/gin.handlerNameTest", Group: "/users"},
+		{Method: http.MethodGet, Path: "/users/:id", Handler: "github.com/gin-gonic/gin.handlerNameTest2", Middleware: 1, Group: "/users"},
+	}, summaries)
+
+	var buf bytes.Buffer
+	assert.NoError(t, router.WriteRouteSummary(&buf, RouteSummaryText))
+	assert.Contains(t, buf.String(), "  GET  api.example.com/users  --> github.com/gin-gonic/gin.handlerNameTest ")
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// HandlerRegistry maps the names used by route tables to handlers and middleware.
+type HandlerRegistry map[string]HandlerFunc
+
+// RouteDefinition declares a route of a RouteTable.
+type RouteDefinition struct {
+	// Method is the HTTP method of the route, e.g. GET.
+	Method string `yaml:"method"`
+
+	// Path is the path of the route, relative to the group the table is loaded in.
+	Path string `yaml:"path"`
+
+	// Handler is the registry name of the route handler.
+	Handler string `yaml:"handler"`
+
+	// Middleware are the registry names of the middleware running before the handler.
+	Middleware []string `yaml:"middleware,omitempty"`
+
+	// Meta is the metadata of the route, see RouterGroup.WithMeta.
+	Meta map[string]any `yaml:"meta,omitempty"`
+}
+
+// RouteTable is a declarative routing table, mapping methods and paths to handler names,
+// for applications whose routes change more often than their code:
+//
+//	routes:
+//	  - method: GET
+//	    path: /users/:id
+//	    handler: getUser
+//	    middleware: [auth]
+type RouteTable struct {
+	Routes []RouteDefinition `yaml:"routes"`
+}
+
+// RouteTableError is returned when a route table is invalid, listing all its problems.
+type RouteTableError struct {
+	Problems []string
+}
+
+func (e *RouteTableError) Error() string {
+	return "gin: invalid route table:\n\t" + strings.Join(e.Problems, "\n\t")
+}
+
+// ParseRouteTable parses a YAML route table.
+func ParseRouteTable(data []byte) (*RouteTable, error) {
+	var table RouteTable
+	if err := yaml.UnmarshalStrict(data, &table); err != nil {
+		return nil, err
+	}
+	return &table, nil
+}
+
+// LoadRouteTable reads and parses the YAML route table filename.
+func LoadRouteTable(filename string) (*RouteTable, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRouteTable(data)
+}
+
+// LoadRoutes validates the route table and registers its routes in the group, resolving
+// the handler and middleware names with registry. If the table is invalid, e.g. it uses
+// unknown names or its routes conflict with each other or with registered routes, no route
+// is registered and a *RouteTableError is returned.
+func (group *RouterGroup) LoadRoutes(table *RouteTable, registry HandlerRegistry) error {
+	var problems []string
+	routes := make(RoutesInfo, 0, len(table.Routes))
+	chains := make([]HandlersChain, 0, len(table.Routes))
+	for i, def := range table.Routes {
+		problem := func(format string, values ...any) {
+			problems = append(problems, fmt.Sprintf("route %d (%s %s): ", i, def.Method, def.Path)+fmt.Sprintf(format, values...))
+		}
+		if !regEnLetter.MatchString(def.Method) {
+			problem("invalid method")
+		}
+		if !strings.HasPrefix(def.Path, "/") {
+			problem("path must begin with '/'")
+		}
+		handlers := make(HandlersChain, 0, len(def.Middleware)+1)
+		for _, name := range append(def.Middleware, def.Handler) {
+			handler, ok := registry[name]
+			if !ok {
+				problem("unknown handler %q", name)
+				continue
+			}
+			handlers = append(handlers, handler)
+		}
+		routes = append(routes, RouteInfo{
+			Method:      def.Method,
+			Path:        group.calculateAbsolutePath(def.Path),
+			HandlerFunc: handlers.Last(),
+		})
+		chains = append(chains, handlers)
+	}
+	if len(problems) == 0 {
+		problems = group.engine.routeConflicts(routes)
+	}
+	if len(problems) > 0 {
+		return &RouteTableError{Problems: problems}
+	}
+
+	for i, def := range table.Routes {
+		group.handle(def.Method, def.Path, chains[i])
+		if len(def.Meta) > 0 {
+			group.engine.setRouteMeta(def.Method, routes[i].Path, mergeMeta(group.meta, def.Meta))
+		}
+	}
+	return nil
+}
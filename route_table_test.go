@@ -0,0 +1,98 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func routeTableRegistry() HandlerRegistry {
+	return HandlerRegistry{
+		"auth": func(c *Context) {
+			if c.GetHeader("Authorization") == "" {
+				c.AbortWithStatus(http.StatusUnauthorized)
+			}
+		},
+		"getUser": func(c *Context) {
+			c.String(http.StatusOK, "%s", "user "+c.Param("id"))
+		},
+		"deleteUser": func(c *Context) {
+			c.Status(http.StatusNoContent)
+		},
+	}
+}
+
+func TestLoadRouteTable(t *testing.T) {
+	table, err := LoadRouteTable("./testdata/routes/table.yaml")
+	assert.NoError(t, err)
+	assert.Len(t, table.Routes, 2)
+
+	router := New()
+	assert.NoError(t, router.Group("/api").LoadRoutes(table, routeTableRegistry()))
+
+	w := PerformRequest(router, http.MethodGet, "/api/users/42", header{"Authorization", "token"})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "user 42", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/api/users/42")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	route, _, _ := router.Lookup(http.MethodDelete, "/api/users/42")
+	if assert.NotNil(t, route) {
+		assert.Equal(t, true, route.Meta["audit"])
+	}
+
+	_, err = LoadRouteTable("./testdata/routes/missing.yaml")
+	assert.Error(t, err)
+}
+
+func TestParseRouteTableStrict(t *testing.T) {
+	_, err := ParseRouteTable([]byte("routes:\n  - method: GET\n    pth: /\n"))
+	assert.Error(t, err)
+}
+
+func TestLoadRoutesInvalid(t *testing.T) {
+	router := New()
+	router.GET("/users/:name", func(c *Context) {})
+
+	table, err := ParseRouteTable([]byte(`
+routes:
+  - {method: get, path: /a, handler: getUser}
+  - {method: GET, path: b, handler: getUser}
+  - {method: GET, path: /c, handler: missing, middleware: [auth, unknown]}
+`))
+	assert.NoError(t, err)
+
+	err = router.LoadRoutes(table, routeTableRegistry())
+	var tableErr *RouteTableError
+	if assert.True(t, errors.As(err, &tableErr)) {
+		assert.Equal(t, []string{
+			"route 0 (get /a): invalid method",
+			"route 1 (GET b): path must begin with '/'",
+			`route 2 (GET /c): unknown handler "unknown"`,
+			`route 2 (GET /c): unknown handler "missing"`,
+		}, tableErr.Problems)
+	}
+
+	table, err = ParseRouteTable([]byte(`
+routes:
+  - {method: GET, path: /users/:id, handler: getUser}
+  - {method: GET, path: /posts, handler: getUser}
+  - {method: GET, path: /posts, handler: getUser}
+`))
+	assert.NoError(t, err)
+	err = router.LoadRoutes(table, routeTableRegistry())
+	if assert.True(t, errors.As(err, &tableErr)) {
+		assert.Len(t, tableErr.Problems, 2)
+		assert.Contains(t, tableErr.Problems[0], "GET /users/:id")
+		assert.Contains(t, tableErr.Problems[1], "handlers are already registered for path '/posts'")
+	}
+	assert.Contains(t, err.Error(), "invalid route table")
+	assert.Len(t, router.Routes(), 1)
+}
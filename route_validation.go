@@ -0,0 +1,280 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// RouteIssueKind classifies a problem reported by Engine.ValidateRoutes.
+type RouteIssueKind string
+
+const (
+	// RouteIssueParamNames is reported when the same path shape uses different
+	// parameter names across methods, e.g. GET /users/:id and DELETE /users/:uid.
+	RouteIssueParamNames RouteIssueKind = "param-names"
+	// RouteIssueShadowed is reported when a route can not be reached because
+	// another route (usually a catch-all) matches its path first.
+	RouteIssueShadowed RouteIssueKind = "shadowed"
+	// RouteIssueEmptyGroup is reported for router groups without any route.
+	RouteIssueEmptyGroup RouteIssueKind = "empty-group"
+	// RouteIssueDuplicateHandler is reported when the same handler appears more
+	// than once in the handlers chain of a route.
+	RouteIssueDuplicateHandler RouteIssueKind = "duplicate-handler"
+	// RouteIssueNoRoute is reported when route groups exist but no NoRoute
+	// handler has been configured.
+	RouteIssueNoRoute RouteIssueKind = "no-route"
+)
+
+// RouteIssue describes a single suspicious registration.
+type RouteIssue struct {
+	Kind    RouteIssueKind
+	Method  string
+	Path    string
+	Message string
+}
+
+// String returns a human readable representation of the issue.
+func (issue RouteIssue) String() string {
+	if issue.Method == "" {
+		return fmt.Sprintf("[%s] %s: %s", issue.Kind, issue.Path, issue.Message)
+	}
+	return fmt.Sprintf("[%s] %s %s: %s", issue.Kind, issue.Method, issue.Path, issue.Message)
+}
+
+// RouteReport is the list of issues returned by Engine.ValidateRoutes.
+type RouteReport []RouteIssue
+
+// OK returns true if no issue was found.
+func (report RouteReport) OK() bool {
+	return len(report) == 0
+}
+
+// ByKind returns the issues of the given kind.
+func (report RouteReport) ByKind(kind RouteIssueKind) RouteReport {
+	var issues RouteReport
+	for _, issue := range report {
+		if issue.Kind == kind {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
+
+// String returns one issue per line.
+func (report RouteReport) String() string {
+	var buf strings.Builder
+	for _, issue := range report {
+		buf.WriteString(issue.String())
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+// ValidateRoutes checks the registered routes for suspicious registrations and
+// returns a report instead of letting the issues surface in production:
+// parameter name collisions across methods, routes shadowed by other routes,
+// groups with no routes, handlers registered twice in the same chain and a
+// missing NoRoute handler.
+// It should be called once all routes are registered, e.g. right before Run.
+func (engine *Engine) ValidateRoutes() RouteReport {
+	var report RouteReport
+
+	shapes := make(map[string][]RouteInfo)
+	var shapeKeys []string
+
+	for _, tree := range engine.trees {
+		walkProbes(tree.root, nil, "", func(n *node, probe string) {
+			route := RouteInfo{Method: tree.method, Path: n.fullPath}
+
+			shape := routeShape(n.fullPath)
+			if _, ok := shapes[shape]; !ok {
+				shapeKeys = append(shapeKeys, shape)
+			}
+			shapes[shape] = append(shapes[shape], route)
+
+			if other := engine.shadowingRoute(tree.root, n, probe); other != "" {
+				report = append(report, RouteIssue{
+					Kind:    RouteIssueShadowed,
+					Method:  route.Method,
+					Path:    route.Path,
+					Message: "unreachable, requests are matched by '" + other + "'",
+				})
+			}
+
+			for _, name := range duplicateHandlers(n.handlers) {
+				report = append(report, RouteIssue{
+					Kind:    RouteIssueDuplicateHandler,
+					Method:  route.Method,
+					Path:    route.Path,
+					Message: "handler " + name + " is registered more than once",
+				})
+			}
+		})
+	}
+
+	for _, shape := range shapeKeys {
+		routes := shapes[shape]
+		first := wildcardNames(routes[0].Path)
+		for _, route := range routes[1:] {
+			if names := wildcardNames(route.Path); names != first {
+				report = append(report, RouteIssue{
+					Kind:    RouteIssueParamNames,
+					Method:  route.Method,
+					Path:    route.Path,
+					Message: "parameter names differ from " + routes[0].Method + " " + routes[0].Path,
+				})
+			}
+		}
+	}
+
+	hasRoutes := false
+	for _, group := range engine.groups {
+		if !group.hasRoutes {
+			report = append(report, RouteIssue{
+				Kind:    RouteIssueEmptyGroup,
+				Path:    group.basePath,
+				Message: "group has no routes",
+			})
+			continue
+		}
+		hasRoutes = true
+	}
+
+	if hasRoutes && len(engine.noRoute) == 0 {
+		report = append(report, RouteIssue{
+			Kind:    RouteIssueNoRoute,
+			Path:    "/",
+			Message: "no NoRoute handler is configured, unmatched requests get the plain text 404 body",
+		})
+	}
+
+	return report
+}
+
+// ValidatePath returns the error registering a route on path panics with on its own, such
+// as a wildcard without a name or a catch-all not at the end of the path, so that paths
+// read from a config can be checked safely. Conflicts with the other routes are not
+// reported, see RouterGroup.TryHandle.
+func ValidatePath(path string) (err error) {
+	if path == "" || path[0] != '/' {
+		return errors.New("path must begin with '/'")
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	path, _ = parsePathConstraints(path)
+	new(node).addRoute(path, HandlersChain{func(*Context) {}})
+	return nil
+}
+
+// walkNodes calls fn for every node of the tree holding handlers.
+func walkNodes(n *node, fn func(*node)) {
+	if len(n.handlers) > 0 {
+		fn(n)
+	}
+	for _, child := range n.children {
+		walkNodes(child, fn)
+	}
+}
+
+// routeShape replaces every wildcard name of the path by its prefix char,
+// so /users/:id and /users/:uid share the /users/: shape.
+func routeShape(path string) string {
+	var buf strings.Builder
+	for len(path) > 0 {
+		wildcard, i, _ := findWildcard(path)
+		if i < 0 {
+			buf.WriteString(path)
+			break
+		}
+		buf.WriteString(path[:i+1])
+		path = path[i+len(wildcard):]
+	}
+	return buf.String()
+}
+
+// wildcardNames returns the wildcard names of the path joined by a comma.
+func wildcardNames(path string) string {
+	var names []string
+	for len(path) > 0 {
+		wildcard, i, _ := findWildcard(path)
+		if i < 0 {
+			break
+		}
+		names = append(names, wildcard[1:])
+		path = path[i+len(wildcard):]
+	}
+	return strings.Join(names, ",")
+}
+
+// walkProbes calls fn for every node of the tree holding handlers, with a path the node
+// matches, its wildcards being replaced by values the static nodes next to them don't.
+func walkProbes(n, parent *node, prefix string, fn func(n *node, probe string)) {
+	switch {
+	case n.nType == param:
+		prefix += probeValue(parent)
+	case n.nType == catchAll && n.path != "":
+		prefix += "/" + probeValue(parent)
+		if n.extensions != nil && len(n.extensions.Allowed) > 0 {
+			prefix += n.extensions.Allowed[0]
+		}
+	default:
+		prefix += n.path
+	}
+	if len(n.handlers) > 0 {
+		fn(n, prefix)
+	}
+	for _, child := range n.children {
+		walkProbes(child, n, prefix, fn)
+	}
+}
+
+// probeValue returns a wildcard value starting with none of the static children of parent.
+func probeValue(parent *node) string {
+	first := "_~-0"
+	for i := 0; i < len(first); i++ {
+		if parent == nil || strings.IndexByte(parent.indices, first[i]) < 0 {
+			return first[i:i+1] + "gin_validate"
+		}
+	}
+	return "_gin_validate"
+}
+
+// shadowingRoute looks up the probe path of the node n and returns the full path of the
+// route actually matched, if it is held by another node.
+func (engine *Engine) shadowingRoute(root, n *node, probe string) string {
+	params := make(Params, 0, engine.maxParams)
+	skippedNodes := make([]skippedNode, 0, engine.maxSections)
+	value := root.getValue(probe, &params, &skippedNodes, false)
+	if len(value.handlers) == 0 || &value.handlers[0] == &n.handlers[0] {
+		return ""
+	}
+	return value.fullPath
+}
+
+// duplicateHandlers returns the names of the handlers present more than once in the chain.
+func duplicateHandlers(handlers HandlersChain) []string {
+	seen := make(map[uintptr]int, len(handlers))
+	for _, handler := range handlers {
+		seen[reflect.ValueOf(handler).Pointer()]++
+	}
+	var names []string
+	for _, handler := range handlers {
+		ptr := reflect.ValueOf(handler).Pointer()
+		if seen[ptr] > 1 {
+			names = append(names, nameOfFunction(handler))
+			seen[ptr] = 0
+		}
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateRoutesOK(t *testing.T) {
+	router := New()
+	router.NoRoute(func(c *Context) {})
+	api := router.Group("/api")
+	api.GET("/users/:id", func(c *Context) {})
+	api.DELETE("/users/:id", func(c *Context) {})
+	api.GET("/users/new", func(c *Context) {})
+	router.GET("/static/*filepath", func(c *Context) {})
+
+	report := router.ValidateRoutes()
+	assert.True(t, report.OK(), report.String())
+}
+
+func TestValidateRoutesNotShadowed(t *testing.T) {
+	router := New()
+	router.NoRoute(func(c *Context) {})
+	router.GET("/users/:id", func(c *Context) {})
+	router.GET("/users/_gin_validate_", func(c *Context) {})
+	router.GET("/files/*path", func(c *Context) {})
+	router.WithExtensions(CatchAllExtensions{Allowed: []string{".js"}}).GET("/assets/*path", func(c *Context) {})
+
+	report := router.ValidateRoutes()
+	assert.True(t, report.OK(), report.String())
+}
+
+func TestValidateRoutesParamNames(t *testing.T) {
+	router := New()
+	router.GET("/users/:id", func(c *Context) {})
+	router.DELETE("/users/:uid", func(c *Context) {})
+
+	issues := router.ValidateRoutes().ByKind(RouteIssueParamNames)
+	assert.Len(t, issues, 1)
+	assert.Equal(t, "DELETE", issues[0].Method)
+	assert.Equal(t, "/users/:uid", issues[0].Path)
+}
+
+func TestValidateRoutesEmptyGroupAndNoRoute(t *testing.T) {
+	router := New()
+	router.Group("/empty")
+	parent := router.Group("/parent")
+	parent.Group("/child").GET("/ping", func(c *Context) {})
+	router.Named("unused")
+	router.WithMeta("audit", true).GET("/audited", func(c *Context) {})
+	router.Host("api.example.com").WithPriority(PriorityHigh)
+
+	report := router.ValidateRoutes()
+	issues := report.ByKind(RouteIssueEmptyGroup)
+	assert.Len(t, issues, 1)
+	assert.Equal(t, "/empty", issues[0].Path)
+	assert.Len(t, report.ByKind(RouteIssueNoRoute), 1)
+
+	router.NoRoute(func(c *Context) {})
+	assert.Empty(t, router.ValidateRoutes().ByKind(RouteIssueNoRoute))
+}
+
+func TestValidateRoutesDuplicateHandler(t *testing.T) {
+	router := New()
+	mw := func(c *Context) {}
+	router.Use(mw)
+	router.Group("/v1", mw).GET("/ping", func(c *Context) {})
+
+	issues := router.ValidateRoutes().ByKind(RouteIssueDuplicateHandler)
+	assert.Len(t, issues, 1)
+	assert.Equal(t, "/v1/ping", issues[0].Path)
+	assert.Contains(t, issues[0].String(), "[duplicate-handler] GET /v1/ping")
+}
+
+func TestRouteShape(t *testing.T) {
+	assert.Equal(t, "/users/:/books/*", routeShape("/users/:id/books/*rest"))
+	assert.Equal(t, "id,rest", wildcardNames("/users/:id/books/*rest"))
+	assert.Equal(t, "/static", routeShape("/static"))
+}
+
+func TestValidatePath(t *testing.T) {
+	for path, message := range map[string]string{
+		"/users/:id":             "",
+		"/static/*filepath":      "",
+		"/a/:b/c/*d":             "",
+		"":                       "path must begin with '/'",
+		"users":                  "path must begin with '/'",
+		"/users/:":               "wildcards must be named with a non-empty name in path '/users/:'",
+		"/users/:id:name":        "only one wildcard per path segment is allowed, has: ':id:name' in path '/users/:id:name'",
+		"/static/*filepath/more": "catch-all routes are only allowed at the end of the path in path '/static/*filepath/more'",
+		"/static*filepath":       "no / before catch-all in path '/static*filepath'",
+	} {
+		err := ValidatePath(path)
+		if message == "" {
+			assert.NoError(t, err, path)
+			continue
+		}
+		if assert.Error(t, err, path) && path != "" {
+			assert.Equal(t, message, err.Error())
+			assert.PanicsWithValue(t, message, func() {
+				New().addRoute(http.MethodGet, path, HandlersChain{func(*Context) {}})
+			})
+		}
+	}
+}
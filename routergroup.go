@@ -5,6 +5,7 @@
 package gin
 
 import (
+	"io/fs"
 	"net/http"
 	"path"
 	"regexp"
@@ -52,14 +53,30 @@ type IRoutes interface {
 // RouterGroup is used internally to configure router, a RouterGroup is associated with
 // a prefix and an array of handlers (middleware).
 type RouterGroup struct {
-	Handlers HandlersChain
-	basePath string
-	engine   *Engine
-	root     bool
+	Handlers   HandlersChain
+	basePath   string
+	engine     *Engine
+	root       bool
+	parent     *RouterGroup
+	hasRoutes  bool
+	named      []string
+	namedFrom  int
+	namedChain HandlersChain
+	namedAt    []namedHandlers
+	meta       map[string]any
+	extensions *CatchAllExtensions
+	host       *hostRoutes
 }
 
 var _ IRouter = &RouterGroup{}
 
+// namedHandlers are the named middleware of a parent group, run after the first at
+// unnamed handlers of a child group, the ones inherited from that parent.
+type namedHandlers struct {
+	at       int
+	handlers HandlersChain
+}
+
 // Use adds middleware to the group, see example code in GitHub.
 func (group *RouterGroup) Use(middleware ...HandlerFunc) IRoutes {
 	group.Handlers = append(group.Handlers, middleware...)
@@ -69,10 +86,26 @@ func (group *RouterGroup) Use(middleware ...HandlerFunc) IRoutes {
 // Group creates a new router group. You should add all the routes that have common middlewares or the same path prefix.
 // For example, all the routes that use a common middleware for authorization could be grouped.
 func (group *RouterGroup) Group(relativePath string, handlers ...HandlerFunc) *RouterGroup {
+	child := group.derive(relativePath, handlers)
+	group.engine.groups = append(group.engine.groups, child)
+	return child
+}
+
+// derive returns a child group without recording it in the groups of the engine, for the
+// groups derived internally, e.g. by WithMeta or Engine.Host, which are not reported as
+// empty by Engine.ValidateRoutes.
+func (group *RouterGroup) derive(relativePath string, handlers HandlersChain) *RouterGroup {
 	return &RouterGroup{
-		Handlers: group.combineHandlers(handlers),
-		basePath: group.calculateAbsolutePath(relativePath),
-		engine:   group.engine,
+		Handlers:   group.mergeHandlers(handlers),
+		basePath:   group.calculateAbsolutePath(relativePath),
+		engine:     group.engine,
+		parent:     group,
+		named:      append([]string(nil), group.named...),
+		namedFrom:  len(group.named),
+		namedAt:    group.inheritedNamed(),
+		meta:       group.meta,
+		extensions: group.extensions,
+		host:       group.host,
 	}
 }
 
@@ -83,9 +116,30 @@ func (group *RouterGroup) BasePath() string {
 }
 
 func (group *RouterGroup) handle(httpMethod, relativePath string, handlers HandlersChain) IRoutes {
-	absolutePath := group.calculateAbsolutePath(relativePath)
+	return group.handleWithConstraints(httpMethod, relativePath, nil, handlers)
+}
+
+func (group *RouterGroup) handleWithConstraints(httpMethod, relativePath string, constraints map[string]ParamConstraint, handlers HandlersChain) IRoutes {
+	// the constraints are stripped before the path is cleaned, which would alter them
+	relativePath, pathConstraints := parsePathConstraints(relativePath)
+	absolutePath, baseConstraints := parsePathConstraints(group.calculateAbsolutePath(relativePath))
+	for _, m := range []map[string]ParamConstraint{baseConstraints, constraints} {
+		for name, constraint := range m {
+			if pathConstraints == nil {
+				pathConstraints = make(map[string]ParamConstraint, len(m))
+			}
+			pathConstraints[name] = constraint
+		}
+	}
 	handlers = group.combineHandlers(handlers)
-	group.engine.addRoute(httpMethod, absolutePath, handlers)
+	method := group.host.qualify(httpMethod)
+	group.engine.addConstrainedRoute(method, absolutePath, pathConstraints, handlers, group.meta)
+	if group.extensions != nil {
+		group.engine.setCatchAllExtensions(method, absolutePath, group.extensions)
+	}
+	for g := group; g != nil && !g.hasRoutes; g = g.parent {
+		g.hasRoutes = true
+	}
 	return group.returnObj()
 }
 
@@ -141,10 +195,56 @@ func (group *RouterGroup) HEAD(relativePath string, handlers ...HandlerFunc) IRo
 	return group.handle(http.MethodHead, relativePath, handlers)
 }
 
+// CONNECT is a shortcut for router.Handle("CONNECT", path, handle).
+// CONNECT requests in authority-form (i.e. proxy requests) are routed as "/".
+func (group *RouterGroup) CONNECT(relativePath string, handlers ...HandlerFunc) IRoutes {
+	return group.handle(http.MethodConnect, relativePath, handlers)
+}
+
+// TRACE is a shortcut for router.Handle("TRACE", path, handle).
+func (group *RouterGroup) TRACE(relativePath string, handlers ...HandlerFunc) IRoutes {
+	return group.handle(http.MethodTrace, relativePath, handlers)
+}
+
 // Any registers a route that matches all the HTTP methods.
-// GET, POST, PUT, PATCH, HEAD, OPTIONS, DELETE, CONNECT, TRACE.
+// GET, POST, PUT, PATCH, HEAD, OPTIONS, DELETE, CONNECT, TRACE by default,
+// see Engine.SetAnyMethods to customize them.
 func (group *RouterGroup) Any(relativePath string, handlers ...HandlerFunc) IRoutes {
-	for _, method := range anyMethods {
+	for _, method := range group.engine.anyMethods() {
+		group.handle(method, relativePath, handlers)
+	}
+
+	return group.returnObj()
+}
+
+// AnyExcept registers a route that matches all the HTTP methods matched by Any,
+// except the given ones.
+//
+//	router.AnyExcept([]string{http.MethodConnect, http.MethodTrace}, "/proxy", handler)
+func (group *RouterGroup) AnyExcept(methods []string, relativePath string, handlers ...HandlerFunc) IRoutes {
+	excluded := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		excluded[strings.ToUpper(method)] = struct{}{}
+	}
+	for _, method := range group.engine.anyMethods() {
+		if _, ok := excluded[method]; !ok {
+			group.handle(method, relativePath, handlers)
+		}
+	}
+
+	return group.returnObj()
+}
+
+// Match registers a route that matches the given HTTP methods only.
+//
+//	router.Match([]string{http.MethodGet, http.MethodPost}, "/login", handler)
+func (group *RouterGroup) Match(methods []string, relativePath string, handlers ...HandlerFunc) IRoutes {
+	for _, method := range methods {
+		if matched := regEnLetter.MatchString(method); !matched {
+			panic("http method " + method + " is not valid")
+		}
+	}
+	for _, method := range methods {
 		group.handle(method, relativePath, handlers)
 	}
 
@@ -202,6 +302,12 @@ func (group *RouterGroup) StaticFS(relativePath string, fs http.FileSystem) IRou
 	return group.returnObj()
 }
 
+// StaticOverlay serves the files of several fs.FS layers composed with OverlayFS, the first
+// layer holding a file wins. Like Static, directory listing is disabled.
+func (group *RouterGroup) StaticOverlay(relativePath string, layers ...fs.FS) IRoutes {
+	return group.StaticFS(relativePath, &onlyFilesFS{http.FS(OverlayFS(layers...))})
+}
+
 func (group *RouterGroup) createStaticHandler(relativePath string, fs http.FileSystem) HandlerFunc {
 	absolutePath := group.calculateAbsolutePath(relativePath)
 	fileServer := http.StripPrefix(absolutePath, http.FileServer(fs))
@@ -228,6 +334,42 @@ func (group *RouterGroup) createStaticHandler(relativePath string, fs http.FileS
 }
 
 func (group *RouterGroup) combineHandlers(handlers HandlersChain) HandlersChain {
+	finalSize := len(group.Handlers) + len(group.namedChain) + len(handlers)
+	for _, named := range group.namedAt {
+		finalSize += len(named.handlers)
+	}
+	assert1(finalSize < int(abortIndex), "too many handlers")
+	mergedHandlers := make(HandlersChain, 0, finalSize)
+	prev := 0
+	for _, named := range group.namedAt {
+		at := named.at
+		if at > len(group.Handlers) {
+			at = len(group.Handlers)
+		}
+		if at > prev {
+			mergedHandlers = append(mergedHandlers, group.Handlers[prev:at]...)
+			prev = at
+		}
+		mergedHandlers = append(mergedHandlers, named.handlers...)
+	}
+	mergedHandlers = append(mergedHandlers, group.Handlers[prev:]...)
+	mergedHandlers = append(mergedHandlers, group.namedChain...)
+	return append(mergedHandlers, handlers...)
+}
+
+// inheritedNamed returns the named middleware of the group and its parents, for a child
+// group, each running after the unnamed handlers of its group.
+func (group *RouterGroup) inheritedNamed() []namedHandlers {
+	if len(group.namedChain) == 0 {
+		return group.namedAt
+	}
+	inherited := make([]namedHandlers, len(group.namedAt), len(group.namedAt)+1)
+	copy(inherited, group.namedAt)
+	return append(inherited, namedHandlers{at: len(group.Handlers), handlers: group.namedChain})
+}
+
+// mergeHandlers appends handlers to the unnamed middleware of the group.
+func (group *RouterGroup) mergeHandlers(handlers HandlersChain) HandlersChain {
 	finalSize := len(group.Handlers) + len(handlers)
 	assert1(finalSize < int(abortIndex), "too many handlers")
 	mergedHandlers := make(HandlersChain, finalSize)
@@ -6,6 +6,7 @@ package gin
 
 import (
 	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -192,3 +193,75 @@ func testRoutesInterface(t *testing.T, r IRoutes) {
 	assert.Equal(t, r, r.Static("/static", "."))
 	assert.Equal(t, r, r.StaticFS("/static2", Dir(".", false)))
 }
+
+func TestRouterGroupConnectTrace(t *testing.T) {
+	router := New()
+	router.CONNECT("/", func(c *Context) {
+		c.String(http.StatusOK, "connect %s", c.Request.Host)
+	})
+	router.Group("/v1").TRACE("/debug", func(c *Context) {
+		c.String(http.StatusOK, "trace")
+	})
+
+	req := httptest.NewRequest(http.MethodConnect, "/", nil)
+	req.URL.Path = ""
+	req.Host = "example.com:443"
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "connect example.com:443", w.Body.String())
+
+	w = PerformRequest(router, http.MethodTrace, "/v1/debug")
+	assert.Equal(t, "trace", w.Body.String())
+}
+
+func TestRouterGroupAnyExcept(t *testing.T) {
+	router := New()
+	router.AnyExcept([]string{http.MethodConnect, "trace"}, "/any", func(c *Context) {})
+
+	for _, method := range anyMethods {
+		w := PerformRequest(router, method, "/any")
+		if method == http.MethodConnect || method == http.MethodTrace {
+			assert.Equal(t, http.StatusNotFound, w.Code, method)
+			continue
+		}
+		assert.Equal(t, http.StatusOK, w.Code, method)
+	}
+}
+
+func TestEngineSetAnyMethods(t *testing.T) {
+	router := New()
+	router.SetAnyMethods(http.MethodGet, http.MethodPost, "PURGE")
+	router.Any("/any", func(c *Context) {})
+	router.AnyExcept([]string{http.MethodPost}, "/except", func(c *Context) {})
+
+	assert.Equal(t, http.StatusOK, PerformRequest(router, "PURGE", "/any").Code)
+	assert.Equal(t, http.StatusOK, PerformRequest(router, http.MethodPost, "/any").Code)
+	assert.Equal(t, http.StatusNotFound, PerformRequest(router, http.MethodPut, "/any").Code)
+	assert.Equal(t, http.StatusOK, PerformRequest(router, "PURGE", "/except").Code)
+	assert.Equal(t, http.StatusNotFound, PerformRequest(router, http.MethodPost, "/except").Code)
+
+	router.SetAnyMethods()
+	router.Any("/default", func(c *Context) {})
+	assert.Equal(t, http.StatusOK, PerformRequest(router, http.MethodPut, "/default").Code)
+
+	assert.Panics(t, func() {
+		router.SetAnyMethods("get")
+	})
+}
+
+func TestRouterGroupMatch(t *testing.T) {
+	router := New()
+	router.Group("/v1").Match([]string{http.MethodGet, http.MethodPost}, "/login", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.Request.Method)
+	})
+
+	assert.Equal(t, "GET", PerformRequest(router, http.MethodGet, "/v1/login").Body.String())
+	assert.Equal(t, "POST", PerformRequest(router, http.MethodPost, "/v1/login").Body.String())
+	assert.Equal(t, http.StatusNotFound, PerformRequest(router, http.MethodPut, "/v1/login").Code)
+
+	assert.Panics(t, func() {
+		router.Match([]string{http.MethodGet, "post"}, "/bad", func(c *Context) {})
+	})
+	assert.Equal(t, http.StatusNotFound, PerformRequest(router, http.MethodGet, "/bad").Code)
+}
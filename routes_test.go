@@ -671,3 +671,65 @@ func TestRouteContextHoldsFullPath(t *testing.T) {
 	w := PerformRequest(router, http.MethodGet, "/not-found")
 	assert.Equal(t, http.StatusNotFound, w.Code)
 }
+
+func TestRouteFallbackHandler(t *testing.T) {
+	legacy := http.NewServeMux()
+	legacy.HandleFunc("/legacy", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("legacy " + r.Method))
+	})
+
+	middleware := 0
+	router := New()
+	router.Use(func(c *Context) { middleware++ })
+	router.HandleMethodNotAllowed = true
+	router.SetFallbackHandler(legacy)
+	router.GET("/users", func(c *Context) { c.String(http.StatusOK, "gin") })
+
+	w := PerformRequest(router, http.MethodGet, "/users")
+	assert.Equal(t, "gin", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/legacy")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "legacy GET", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/unknown")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, "404 page not found\n", w.Body.String())
+
+	// no NoMethod handler: the 405 is delegated too
+	w = PerformRequest(router, http.MethodPost, "/users")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, 4, middleware)
+
+	// a NoRoute handler takes precedence over the fallback
+	router.NoRoute(func(c *Context) { c.String(http.StatusNotFound, "gin 404") })
+	w = PerformRequest(router, http.MethodGet, "/legacy")
+	assert.Equal(t, "gin 404", w.Body.String())
+
+	router.SetFallbackHandler(nil)
+	router.NoMethod(func(c *Context) {})
+	w = PerformRequest(router, http.MethodPost, "/users")
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestRouteParamsMapAndRequestContext(t *testing.T) {
+	router := New()
+	router.GET("/users/:id/posts/:post", func(c *Context) {
+		assert.Equal(t, map[string]string{"id": "john", "post": "1"}, c.ParamsMap())
+		assert.Nil(t, ParamsFromContext(c.Request.Context()))
+	})
+	PerformRequest(router, http.MethodGet, "/users/john/posts/1")
+
+	router = New()
+	router.ParamsInRequestContext = true
+	router.GET("/users/:id", WrapF(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(ParamsFromContext(req.Context()).ByName("id")))
+	}))
+	router.GET("/static", func(c *Context) {
+		assert.Nil(t, ParamsFromContext(c.Request.Context()))
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/users/john")
+	assert.Equal(t, "john", w.Body.String())
+	PerformRequest(router, http.MethodGet, "/static")
+}
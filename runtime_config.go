@@ -0,0 +1,204 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"math"
+	"os"
+	"reflect"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/gin-gonic/gin/internal/bufpool"
+	"github.com/gin-gonic/gin/render"
+)
+
+// RuntimeConfig tunes the Go runtime for the server, see Engine.ConfigureRuntime.
+type RuntimeConfig struct {
+	// GCPercent is the garbage collection target percentage, as set by the GOGC
+	// environment variable, which takes precedence. A negative value disables the
+	// garbage collection. Optional. Zero leaves the runtime default.
+	GCPercent int
+
+	// MemoryLimit is the soft memory limit in bytes, as set by the GOMEMLIMIT environment
+	// variable, which takes precedence. It is ignored by the binaries built with Go 1.18.
+	// Optional. Zero leaves the runtime default.
+	MemoryLimit int64
+
+	// Ballast is the size in bytes of a never used allocation raising the heap target, so
+	// that the small heaps of the high-throughput servers are not collected too often. It
+	// is ignored when a memory limit is in effect, which is the better alternative.
+	// Optional.
+	Ballast int
+
+	// AllocationStats counts the renders and the bindings, and the bytes they write and
+	// read, by type, see Engine.AllocationStats. Optional.
+	AllocationStats bool
+}
+
+// runtimeState is the RuntimeConfig of an Engine and its ballast.
+type runtimeState struct {
+	config  RuntimeConfig
+	once    sync.Once
+	ballast []byte
+}
+
+// ConfigureRuntime sets the runtime configuration applied by the Run methods, before
+// serving, or right away by ApplyRuntimeConfig. It must be called before serving.
+//
+//	router.ConfigureRuntime(gin.RuntimeConfig{GCPercent: 200, MemoryLimit: 3 << 30})
+func (engine *Engine) ConfigureRuntime(conf RuntimeConfig) {
+	assert1(conf.Ballast >= 0 && conf.MemoryLimit >= 0, "runtime ballast and memory limit can not be negative")
+	engine.runtime = &runtimeState{config: conf}
+	if conf.AllocationStats {
+		engine.allocStats = &allocationCounters{}
+	} else {
+		engine.allocStats = nil
+	}
+}
+
+// ApplyRuntimeConfig applies the runtime configuration set with ConfigureRuntime, once,
+// for the servers not started by the Run methods.
+func (engine *Engine) ApplyRuntimeConfig() {
+	state := engine.runtime
+	if state == nil {
+		return
+	}
+	state.once.Do(func() {
+		conf := state.config
+		if conf.GCPercent != 0 && os.Getenv("GOGC") == "" {
+			debug.SetGCPercent(conf.GCPercent)
+			debugPrint("GC target percentage set to %d\n", conf.GCPercent)
+		}
+		if conf.MemoryLimit > 0 && os.Getenv("GOMEMLIMIT") == "" {
+			if setMemoryLimit(conf.MemoryLimit) {
+				debugPrint("Soft memory limit set to %d bytes\n", conf.MemoryLimit)
+			} else {
+				debugPrint("[WARNING] The soft memory limit requires Go 1.19+.\n")
+			}
+		}
+		if conf.Ballast > 0 {
+			if memoryLimit() != math.MaxInt64 {
+				debugPrint("[WARNING] Memory ballast ignored, a memory limit is in effect.\n")
+				return
+			}
+			state.ballast = make([]byte, conf.Ballast)
+			debugPrint("Memory ballast of %d bytes allocated\n", conf.Ballast)
+		}
+	})
+}
+
+// LayerStats are the allocation counters of a render or binding type.
+type LayerStats struct {
+	// Calls is the number of renders or bindings.
+	Calls uint64
+
+	// Bytes is the number of bytes written by the renders, or the size of the request
+	// bodies bound, when known.
+	Bytes uint64
+}
+
+// AllocationStats report where the allocations of the requests come from, to tune the
+// high-throughput deployments, see RuntimeConfig.AllocationStats.
+type AllocationStats struct {
+	// Contexts are the statistics of the pool of contexts.
+	Contexts PoolStats
+
+	// Render are the statistics of the renders, by render type, e.g. "JSON".
+	Render map[string]LayerStats
+
+	// Binding are the statistics of the bindings, by binding name, e.g. "json".
+	Binding map[string]LayerStats
+
+	// Buffers are the statistics of the buffers shared by the renders and the bindings,
+	// by size class, from the smallest. They are collected for all the engines.
+	Buffers []BufferClassStats
+}
+
+// BufferClassStats are the statistics of a size class of the buffers shared by the
+// renders and the bindings.
+type BufferClassStats struct {
+	// Size is the minimum capacity of the buffers of the class.
+	Size int
+
+	// Gets is the number of buffers taken from the class.
+	Gets uint64
+
+	// News is the number of buffers allocated because none could be reused.
+	News uint64
+
+	// Puts is the number of buffers returned for reuse.
+	Puts uint64
+
+	// Dropped is the number of buffers not reused because they grew too large.
+	Dropped uint64
+}
+
+type layerCounters struct {
+	calls uint64
+	bytes uint64
+}
+
+type allocationCounters struct {
+	render  sync.Map
+	binding sync.Map
+}
+
+func (a *allocationCounters) add(m *sync.Map, name string, bytes int64) {
+	v, ok := m.Load(name)
+	if !ok {
+		v, _ = m.LoadOrStore(name, &layerCounters{})
+	}
+	counters := v.(*layerCounters)
+	atomic.AddUint64(&counters.calls, 1)
+	if bytes > 0 {
+		atomic.AddUint64(&counters.bytes, uint64(bytes))
+	}
+}
+
+func (a *allocationCounters) addRender(r render.Render, bytes int) {
+	t := reflect.TypeOf(r)
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	a.add(&a.render, t.Name(), int64(bytes))
+}
+
+func (a *allocationCounters) addBinding(b binding.Binding, bytes int64) {
+	a.add(&a.binding, b.Name(), bytes)
+}
+
+func snapshotLayerStats(m *sync.Map) map[string]LayerStats {
+	stats := make(map[string]LayerStats)
+	m.Range(func(key, value any) bool {
+		counters := value.(*layerCounters)
+		stats[key.(string)] = LayerStats{
+			Calls: atomic.LoadUint64(&counters.calls),
+			Bytes: atomic.LoadUint64(&counters.bytes),
+		}
+		return true
+	})
+	return stats
+}
+
+// AllocationStats returns the allocation statistics of the engine. The render and binding
+// statistics are only collected with RuntimeConfig.AllocationStats.
+//
+//	router.GET("/debug/allocs", func(c *gin.Context) {
+//		c.JSON(http.StatusOK, router.AllocationStats())
+//	})
+func (engine *Engine) AllocationStats() AllocationStats {
+	stats := AllocationStats{Contexts: engine.PoolStats()}
+	for _, class := range bufpool.Stats() {
+		stats.Buffers = append(stats.Buffers, BufferClassStats(class))
+	}
+	if a := engine.allocStats; a != nil {
+		stats.Render = snapshotLayerStats(&a.render)
+		stats.Binding = snapshotLayerStats(&a.binding)
+	}
+	return stats
+}
@@ -0,0 +1,104 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"runtime/debug"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin/binding"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConfigureRuntime(t *testing.T) {
+	t.Setenv("GOGC", "")
+	t.Setenv("GOMEMLIMIT", "")
+	defer debug.SetGCPercent(debug.SetGCPercent(100))
+	defer setMemoryLimit(memoryLimit())
+
+	router := New()
+	router.ApplyRuntimeConfig()
+	router.ConfigureRuntime(RuntimeConfig{GCPercent: 250, MemoryLimit: 1 << 40, Ballast: 1 << 20})
+	router.newServer("")
+	assert.Equal(t, 250, debug.SetGCPercent(250))
+	if setMemoryLimit(1 << 40) {
+		assert.Equal(t, int64(1<<40), memoryLimit())
+		assert.Nil(t, router.runtime.ballast, "ballast ignored with a memory limit")
+	}
+
+	debug.SetGCPercent(100)
+	router.ApplyRuntimeConfig()
+	assert.Equal(t, 100, debug.SetGCPercent(100), "applied once")
+
+	assert.PanicsWithValue(t, "runtime ballast and memory limit can not be negative", func() {
+		router.ConfigureRuntime(RuntimeConfig{Ballast: -1})
+	})
+}
+
+func TestConfigureRuntimeBallast(t *testing.T) {
+	t.Setenv("GOGC", "50")
+	defer debug.SetGCPercent(debug.SetGCPercent(100))
+	defer setMemoryLimit(memoryLimit())
+	setMemoryLimit(math.MaxInt64)
+
+	router := New()
+	router.ConfigureRuntime(RuntimeConfig{GCPercent: 300, Ballast: 1 << 20})
+	router.ApplyRuntimeConfig()
+	assert.Equal(t, 100, debug.SetGCPercent(100), "GOGC takes precedence")
+	assert.Len(t, router.runtime.ballast, 1<<20)
+}
+
+func TestAllocationStats(t *testing.T) {
+	router := New()
+	router.GET("/json", func(c *Context) {
+		c.JSON(http.StatusOK, H{"ok": true})
+	})
+	router.POST("/bind", func(c *Context) {
+		var obj struct {
+			Name string `json:"name"`
+		}
+		if err := c.ShouldBindWith(&obj, binding.JSON); err != nil {
+			c.AbortWithStatus(http.StatusBadRequest)
+			return
+		}
+		c.String(http.StatusOK, obj.Name)
+	})
+	router.POST("/bind-body", func(c *Context) {
+		var obj struct {
+			Name string `json:"name"`
+		}
+		c.ShouldBindBodyWith(&obj, binding.JSON) // nolint: errcheck
+		c.Status(http.StatusNoContent)
+	})
+
+	PerformRequest(router, http.MethodGet, "/json")
+	stats := router.AllocationStats()
+	assert.Equal(t, uint64(1), stats.Contexts.Gets)
+	assert.Nil(t, stats.Render)
+	assert.Nil(t, stats.Binding)
+
+	router.ConfigureRuntime(RuntimeConfig{AllocationStats: true})
+	PerformRequest(router, http.MethodGet, "/json")
+	PerformRequest(router, http.MethodGet, "/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/bind", strings.NewReader(`{"name":"gin"}`)))
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/bind-body", strings.NewReader(`{"name":"gopher"}`)))
+
+	stats = router.AllocationStats()
+	assert.Equal(t, uint64(5), stats.Contexts.Gets)
+	assert.Equal(t, map[string]LayerStats{
+		"JSON":   {Calls: 2, Bytes: 2 * uint64(len(`{"ok":true}`))},
+		"String": {Calls: 1, Bytes: 3},
+	}, stats.Render)
+	assert.Equal(t, map[string]LayerStats{"json": {Calls: 2, Bytes: 14 + 17}}, stats.Binding)
+	assert.Len(t, stats.Buffers, 4)
+	assert.Equal(t, 1024, stats.Buffers[0].Size)
+	assert.NotZero(t, stats.Buffers[0].Gets)
+}
@@ -0,0 +1,20 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+//go:build go1.19
+// +build go1.19
+
+package gin
+
+import "runtime/debug"
+
+func setMemoryLimit(limit int64) bool {
+	debug.SetMemoryLimit(limit)
+	return true
+}
+
+// memoryLimit returns the soft memory limit, math.MaxInt64 if there is none.
+func memoryLimit() int64 {
+	return debug.SetMemoryLimit(-1)
+}
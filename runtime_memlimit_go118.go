@@ -0,0 +1,19 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+//go:build !go1.19
+// +build !go1.19
+
+package gin
+
+import "math"
+
+func setMemoryLimit(limit int64) bool {
+	return false
+}
+
+// memoryLimit returns the soft memory limit, math.MaxInt64 as there is none before Go 1.19.
+func memoryLimit() int64 {
+	return math.MaxInt64
+}
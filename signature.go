@@ -0,0 +1,350 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SignatureAlgorithm is the scheme of the Authorization header of the signed requests.
+const SignatureAlgorithm = "GIN-HMAC-SHA256"
+
+// SignatureKeyIDKey is the key under which VerifySignature stores the id of the key a
+// request was signed with.
+const SignatureKeyIDKey = "_gin-gonic/gin/signaturekeyid"
+
+const (
+	signatureDateHeader  = "X-Date"
+	signatureNonceHeader = "X-Nonce"
+	signatureDateFormat  = "20060102T150405Z"
+	defaultSignatureSkew = 5 * time.Minute
+	defaultSignatureBody = 10 << 20 // 10 MB
+)
+
+var (
+	// ErrSignatureMissing is reported when a request is not signed.
+	ErrSignatureMissing = errors.New("gin: request signature is missing")
+	// ErrSignatureInvalid is reported when a request signature is malformed or does not match.
+	ErrSignatureInvalid = errors.New("gin: request signature is invalid")
+	// ErrSignatureExpired is reported when a request date is out of the allowed skew window.
+	ErrSignatureExpired = errors.New("gin: request signature is expired")
+	// ErrSignatureReplayed is reported when a request nonce was already used.
+	ErrSignatureReplayed = errors.New("gin: request signature was already used")
+	// ErrSignatureBodyTooLarge is reported when a request body exceeds the size allowed to
+	// verify its signature.
+	ErrSignatureBodyTooLarge = errors.New("gin: signed request body is too large")
+)
+
+// NonceCache records the nonces of the signed requests to detect replays.
+type NonceCache interface {
+	// Use records nonce until expiry and reports whether it was not recorded yet.
+	Use(nonce string, expiry time.Time) bool
+}
+
+// SignatureConfig defines the config for VerifySignature middleware.
+type SignatureConfig struct {
+	// KeyLookup returns the secret of the key id a request is signed with.
+	// An error rejects the request.
+	KeyLookup func(keyID string) ([]byte, error)
+
+	// MaxSkew is the maximum difference between the request date and the server clock.
+	// Optional. Default value is 5 minutes.
+	MaxSkew time.Duration
+
+	// NonceCache detects replayed requests. Optional. Default value is an in-memory cache,
+	// use a shared one when running several instances.
+	NonceCache NonceCache
+
+	// Now returns the current time. Optional. Default value is time.Now.
+	Now func() time.Time
+
+	// MaxBodySize is the maximum size of the bodies read to verify the signatures, the
+	// larger requests being answered with a 413 Request Entity Too Large. Optional.
+	// Default value is 10 MB.
+	MaxBodySize int64
+}
+
+// SignRequest signs req with the secret of keyID, for the VerifySignature middleware. It sets
+// the X-Date and X-Nonce headers, unless already set, and the Authorization header. The
+// method, path, query, body, the Host, X-Date and X-Nonce headers, plus signedHeaders,
+// are covered by the signature. The body is read and restored.
+func SignRequest(req *http.Request, keyID string, secret []byte, signedHeaders ...string) error {
+	if req.Header.Get(signatureDateHeader) == "" {
+		req.Header.Set(signatureDateHeader, time.Now().UTC().Format(signatureDateFormat))
+	}
+	if req.Header.Get(signatureNonceHeader) == "" {
+		req.Header.Set(signatureNonceHeader, randomNonce())
+	}
+	signed := canonicalHeaderNames(append([]string{"host", signatureDateHeader, signatureNonceHeader}, signedHeaders...))
+	canonical, err := CanonicalRequest(req, signed)
+	if err != nil {
+		return err
+	}
+	signature := signString(secret, req.Header.Get(signatureDateHeader), canonical)
+	req.Header.Set("Authorization", SignatureAlgorithm+" KeyId="+keyID+
+		", SignedHeaders="+strings.Join(signed, ";")+", Signature="+signature)
+	return nil
+}
+
+// CanonicalRequest returns the canonical form of req that is signed: the method, the
+// escaped path, the sorted query, the signed headers with their values, the list of signed
+// headers and the SHA-256 of the body, separated by new lines. The body is read and restored.
+func CanonicalRequest(req *http.Request, signedHeaders []string) (string, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		if body, err = io.ReadAll(req.Body); err != nil {
+			return "", err
+		}
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	bodyHash := sha256.Sum256(body)
+
+	var buf strings.Builder
+	buf.WriteString(req.Method)
+	buf.WriteByte('\n')
+	buf.WriteString(req.URL.EscapedPath())
+	buf.WriteByte('\n')
+	buf.WriteString(canonicalQuery(req.URL.Query()))
+	buf.WriteByte('\n')
+	for _, name := range signedHeaders {
+		buf.WriteString(name)
+		buf.WriteByte(':')
+		if name == "host" {
+			buf.WriteString(req.Host)
+		} else {
+			buf.WriteString(strings.Join(req.Header.Values(name), ","))
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteString(strings.Join(signedHeaders, ";"))
+	buf.WriteByte('\n')
+	buf.WriteString(hex.EncodeToString(bodyHash[:]))
+	return buf.String(), nil
+}
+
+func canonicalQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var parts []string
+	for _, key := range keys {
+		values := append([]string(nil), query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			parts = append(parts, url.QueryEscape(key)+"="+url.QueryEscape(value))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// canonicalHeaderNames lowercases, sorts and dedupes header names.
+func canonicalHeaderNames(names []string) []string {
+	seen := make(map[string]struct{}, len(names))
+	canonical := make([]string, 0, len(names))
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := seen[name]; ok || name == "" {
+			continue
+		}
+		seen[name] = struct{}{}
+		canonical = append(canonical, name)
+	}
+	sort.Strings(canonical)
+	return canonical
+}
+
+func randomNonce() string {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(nonce)
+}
+
+func signString(secret []byte, date, canonical string) string {
+	canonicalHash := sha256.Sum256([]byte(canonical))
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(SignatureAlgorithm + "\n" + date + "\n" + hex.EncodeToString(canonicalHash[:]))) // nolint: errcheck
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// parseSignatureAuthorization parses the KeyId, SignedHeaders and Signature parameters
+// of the Authorization header.
+func parseSignatureAuthorization(authorization string) (keyID string, signed []string, signature string, ok bool) {
+	if !strings.HasPrefix(authorization, SignatureAlgorithm+" ") {
+		return "", nil, "", false
+	}
+	params := strings.TrimPrefix(authorization, SignatureAlgorithm+" ")
+	for _, param := range strings.Split(params, ",") {
+		name, value, found := strings.Cut(strings.TrimSpace(param), "=")
+		if !found {
+			return "", nil, "", false
+		}
+		switch name {
+		case "KeyId":
+			keyID = value
+		case "SignedHeaders":
+			signed = strings.Split(value, ";")
+		case "Signature":
+			signature = value
+		}
+	}
+	return keyID, signed, signature, keyID != "" && len(signed) > 0 && signature != ""
+}
+
+// VerifySignature returns a middleware that verifies the requests signed with SignRequest,
+// e.g. for server-to-server APIs and webhook receivers. The request date must be within the
+// allowed skew, the Host, X-Date and X-Nonce headers must be signed and the nonce must not
+// have been used before. Rejected requests are answered with a 401 Unauthorized, or a 413
+// Request Entity Too Large for the bodies over MaxBodySize, and the reason is attached to
+// the context as a private error. The key id is stored under SignatureKeyIDKey.
+func VerifySignature(conf SignatureConfig) HandlerFunc {
+	assert1(conf.KeyLookup != nil, "key lookup can not be nil")
+	if conf.MaxSkew <= 0 {
+		conf.MaxSkew = defaultSignatureSkew
+	}
+	if conf.NonceCache == nil {
+		conf.NonceCache = newMemoryNonceCache()
+	}
+	if conf.Now == nil {
+		conf.Now = time.Now
+	}
+	if conf.MaxBodySize <= 0 {
+		conf.MaxBodySize = defaultSignatureBody
+	}
+	reject := func(c *Context, err error) {
+		code := http.StatusUnauthorized
+		if err == ErrSignatureBodyTooLarge {
+			code = http.StatusRequestEntityTooLarge
+		}
+		c.AbortWithStatus(code)
+		c.Error(err) // nolint: errcheck
+	}
+
+	return func(c *Context) {
+		authorization := c.requestHeader("Authorization")
+		if authorization == "" {
+			reject(c, ErrSignatureMissing)
+			return
+		}
+		keyID, signed, signature, ok := parseSignatureAuthorization(authorization)
+		if !ok {
+			reject(c, ErrSignatureInvalid)
+			return
+		}
+		signed = canonicalHeaderNames(signed)
+		for _, required := range []string{"host", "x-date", "x-nonce"} {
+			if !containsString(signed, required) {
+				reject(c, ErrSignatureInvalid)
+				return
+			}
+		}
+
+		dateHeader := c.requestHeader(signatureDateHeader)
+		date, err := time.Parse(signatureDateFormat, dateHeader)
+		if err != nil {
+			reject(c, ErrSignatureInvalid)
+			return
+		}
+		if skew := conf.Now().Sub(date); skew > conf.MaxSkew || skew < -conf.MaxSkew {
+			reject(c, ErrSignatureExpired)
+			return
+		}
+
+		secret, err := conf.KeyLookup(keyID)
+		if err != nil {
+			reject(c, ErrSignatureInvalid)
+			return
+		}
+		if c.Request.ContentLength > conf.MaxBodySize {
+			reject(c, ErrSignatureBodyTooLarge)
+			return
+		}
+		var body *countingBody
+		if c.Request.Body != nil && c.Request.Body != http.NoBody {
+			body = &countingBody{ReadCloser: c.Request.Body}
+			c.Request.Body = http.MaxBytesReader(c.Writer, body, conf.MaxBodySize)
+		}
+		canonical, err := CanonicalRequest(c.Request, signed)
+		if err != nil {
+			if body != nil && body.n > conf.MaxBodySize {
+				err = ErrSignatureBodyTooLarge
+			}
+			reject(c, err)
+			return
+		}
+		expected := signString(secret, dateHeader, canonical)
+		if !hmac.Equal([]byte(expected), []byte(signature)) {
+			reject(c, ErrSignatureInvalid)
+			return
+		}
+
+		nonce := c.requestHeader(signatureNonceHeader)
+		if nonce == "" || !conf.NonceCache.Use(keyID+":"+nonce, date.Add(conf.MaxSkew)) {
+			reject(c, ErrSignatureReplayed)
+			return
+		}
+		c.Set(SignatureKeyIDKey, keyID)
+	}
+}
+
+// countingBody counts the bytes read from a body, to tell the bodies over the limit of
+// http.MaxBytesReader apart from the failed reads.
+type countingBody struct {
+	io.ReadCloser
+	n int64
+}
+
+func (b *countingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.n += int64(n)
+	return n, err
+}
+
+// memoryNonceCache is the default in-memory NonceCache.
+type memoryNonceCache struct {
+	mu        sync.Mutex
+	nonces    map[string]time.Time
+	lastSweep time.Time
+}
+
+func newMemoryNonceCache() *memoryNonceCache {
+	return &memoryNonceCache{nonces: make(map[string]time.Time), lastSweep: time.Now()}
+}
+
+func (m *memoryNonceCache) Use(nonce string, expiry time.Time) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	now := time.Now()
+	if now.Sub(m.lastSweep) > time.Minute {
+		for n, e := range m.nonces {
+			if e.Before(now) {
+				delete(m.nonces, n)
+			}
+		}
+		m.lastSweep = now
+	}
+	if _, ok := m.nonces[nonce]; ok {
+		return false
+	}
+	m.nonces[nonce] = expiry
+	return true
+}
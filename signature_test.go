@@ -0,0 +1,136 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func signatureRouter() (*Engine, *error) {
+	var lastErr error
+	router := New()
+	router.Use(func(c *Context) {
+		c.Next()
+		lastErr = nil
+		if len(c.Errors) > 0 {
+			lastErr = c.Errors.Last().Err
+		}
+	})
+	router.Use(VerifySignature(SignatureConfig{
+		KeyLookup: func(keyID string) ([]byte, error) {
+			if keyID == "partner" {
+				return []byte("secret"), nil
+			}
+			return nil, errors.New("unknown key")
+		},
+	}))
+	router.POST("/hooks", func(c *Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusOK, "%s", c.GetString(SignatureKeyIDKey)+" "+string(body))
+	})
+	return router, &lastErr
+}
+
+func newSignedRequest(t *testing.T, keyID string, secret []byte, body string) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/hooks?b=2&a=1", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	assert.NoError(t, SignRequest(req, keyID, secret, "Content-Type"))
+	return req
+}
+
+func TestVerifySignature(t *testing.T) {
+	router, lastErr := signatureRouter()
+	serve := func(req *http.Request) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	req := newSignedRequest(t, "partner", []byte("secret"), `{"event":"paid"}`)
+	assert.Contains(t, req.Header.Get("Authorization"), "SignedHeaders=content-type;host;x-date;x-nonce")
+	w := serve(req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `partner {"event":"paid"}`, w.Body.String())
+
+	// replayed
+	req.Body = io.NopCloser(strings.NewReader(`{"event":"paid"}`))
+	w = serve(req)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+	assert.Equal(t, ErrSignatureReplayed, *lastErr)
+
+	// tampered body
+	req = newSignedRequest(t, "partner", []byte("secret"), `{"event":"paid"}`)
+	req.Body = io.NopCloser(strings.NewReader(`{"event":"refunded"}`))
+	serve(req)
+	assert.Equal(t, ErrSignatureInvalid, *lastErr)
+
+	// wrong secret and unknown key
+	serve(newSignedRequest(t, "partner", []byte("guess"), ""))
+	assert.Equal(t, ErrSignatureInvalid, *lastErr)
+	serve(newSignedRequest(t, "other", []byte("secret"), ""))
+	assert.Equal(t, ErrSignatureInvalid, *lastErr)
+
+	// expired
+	req = httptest.NewRequest(http.MethodPost, "/hooks", nil)
+	req.Header.Set("X-Date", time.Now().Add(-time.Hour).UTC().Format("20060102T150405Z"))
+	assert.NoError(t, SignRequest(req, "partner", []byte("secret")))
+	serve(req)
+	assert.Equal(t, ErrSignatureExpired, *lastErr)
+
+	// missing or malformed
+	serve(httptest.NewRequest(http.MethodPost, "/hooks", nil))
+	assert.Equal(t, ErrSignatureMissing, *lastErr)
+	req = httptest.NewRequest(http.MethodPost, "/hooks", nil)
+	req.Header.Set("Authorization", "GIN-HMAC-SHA256 KeyId=partner, SignedHeaders=host, Signature=00")
+	serve(req)
+	assert.Equal(t, ErrSignatureInvalid, *lastErr)
+
+	assert.Panics(t, func() { VerifySignature(SignatureConfig{}) })
+}
+
+func TestVerifySignatureMaxBodySize(t *testing.T) {
+	var lastErr error
+	router := New()
+	router.Use(func(c *Context) {
+		c.Next()
+		lastErr = c.Errors.Last().Err
+	})
+	router.Use(VerifySignature(SignatureConfig{
+		KeyLookup:   func(string) ([]byte, error) { return []byte("secret"), nil },
+		MaxBodySize: 8,
+	}))
+	router.POST("/hooks", func(c *Context) {})
+
+	req := newSignedRequest(t, "partner", []byte("secret"), "0123456789")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal(t, ErrSignatureBodyTooLarge, lastErr)
+
+	// without a content length, the body is cut at the limit
+	req = newSignedRequest(t, "partner", []byte("secret"), "0123456789")
+	req.ContentLength = -1
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal(t, ErrSignatureBodyTooLarge, lastErr)
+}
+
+func TestCanonicalRequest(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/a%20b?z=1&a=2&a=1", nil)
+	req.Header.Set("X-Date", "20260101T000000Z")
+	canonical, err := CanonicalRequest(req, []string{"host", "x-date"})
+	assert.NoError(t, err)
+	assert.Equal(t, "GET\n/a%20b\na=1&a=2&z=1\nhost:example.com\nx-date:20260101T000000Z\nhost;x-date\n"+
+		"e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", canonical)
+}
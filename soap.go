@@ -0,0 +1,253 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+const (
+	soap11Namespace = "http://schemas.xmlsoap.org/soap/envelope/"
+	soap12Namespace = "http://www.w3.org/2003/05/soap-envelope"
+
+	// MIMESOAP12 is the content type of the SOAP 1.2 messages, the SOAP 1.1 ones being
+	// sent as MIMEXML2.
+	MIMESOAP12 = "application/soap+xml"
+)
+
+// SOAP fault codes, in their SOAP 1.1 form: they are sent as Sender and Receiver to the
+// SOAP 1.2 clients.
+const (
+	SOAPFaultClient = "Client"
+	SOAPFaultServer = "Server"
+)
+
+// ErrSOAPEnvelope is returned when a request is not a SOAP envelope with a body.
+var ErrSOAPEnvelope = errors.New("gin: invalid SOAP envelope")
+
+// SOAPFault is a SOAP fault, see Context.SOAPFault.
+type SOAPFault struct {
+	// Code is the fault code, SOAPFaultClient when the request is wrong, SOAPFaultServer
+	// otherwise. Default value is SOAPFaultServer.
+	Code string
+	// String is the description of the fault.
+	String string
+	// Actor is the URI of the node at fault, for SOAP 1.1. Optional.
+	Actor string
+	// Detail is marshaled as XML in the detail element. Optional.
+	Detail any
+}
+
+// isSOAP12 reports whether the request is a SOAP 1.2 message, from its content type.
+func (c *Context) isSOAP12() bool {
+	return c.ContentType() == MIMESOAP12
+}
+
+// SOAPAction returns the action of the SOAP request, from the SOAPAction header for SOAP
+// 1.1, or the action parameter of the content type for SOAP 1.2.
+func (c *Context) SOAPAction() string {
+	if action := c.requestHeader("SOAPAction"); action != "" {
+		return strings.Trim(action, `"`)
+	}
+	if _, params, err := mime.ParseMediaType(c.requestHeader("Content-Type")); err == nil {
+		return params["action"]
+	}
+	return ""
+}
+
+// ShouldBindSOAP decodes the SOAP envelope of the request body, SOAP 1.1 or 1.2: the
+// Header element into header, if not nil, and the first element of the Body into body,
+// with encoding/xml. It returns ErrSOAPEnvelope if the request is not a SOAP envelope.
+//
+//	var req struct {
+//		XMLName xml.Name `xml:"http://example.com/stock GetPrice"`
+//		Symbol  string   `xml:"Symbol"`
+//	}
+//	if err := c.ShouldBindSOAP(nil, &req); err != nil {
+//		c.SOAPFault(gin.SOAPFault{Code: gin.SOAPFaultClient, String: err.Error()})
+//		return
+//	}
+func (c *Context) ShouldBindSOAP(header, body any) error {
+	if c.Request.Body == nil {
+		return ErrSOAPEnvelope
+	}
+	dec := xml.NewDecoder(c.Request.Body)
+	envelope, err := nextStartElement(dec)
+	if err != nil {
+		return soapError(err)
+	}
+	ns := envelope.Name.Space
+	if envelope.Name.Local != "Envelope" || (ns != soap11Namespace && ns != soap12Namespace) {
+		return ErrSOAPEnvelope
+	}
+
+	for {
+		elem, err := nextStartElement(dec)
+		if err != nil {
+			return soapError(err)
+		}
+		switch {
+		case elem.Name.Space == ns && elem.Name.Local == "Header":
+			if header == nil {
+				err = dec.Skip()
+			} else {
+				err = dec.DecodeElement(header, &elem)
+			}
+			if err != nil {
+				return err
+			}
+		case elem.Name.Space == ns && elem.Name.Local == "Body":
+			content, err := nextStartElement(dec)
+			if err != nil {
+				return soapError(err)
+			}
+			return dec.DecodeElement(body, &content)
+		default:
+			if err := dec.Skip(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// nextStartElement returns the next start element of dec at the current level, or
+// ErrSOAPEnvelope if the current element ends first.
+func nextStartElement(dec *xml.Decoder) (xml.StartElement, error) {
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return xml.StartElement{}, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			return tok, nil
+		case xml.EndElement:
+			return xml.StartElement{}, ErrSOAPEnvelope
+		}
+	}
+}
+
+func soapError(err error) error {
+	if err == io.EOF {
+		return ErrSOAPEnvelope
+	}
+	return err
+}
+
+// writeSOAP writes the SOAP envelope whose body is the XML content, in the version of the
+// request.
+func (c *Context) writeSOAP(code int, content func(buf *bytes.Buffer) error) {
+	ns, contentType := soap11Namespace, MIMEXML2
+	if c.isSOAP12() {
+		ns, contentType = soap12Namespace, MIMESOAP12
+	}
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<soap:Envelope xmlns:soap="` + ns + `"><soap:Body>`)
+	if err := content(&buf); err != nil {
+		c.AbortWithError(http.StatusInternalServerError, err) // nolint: errcheck
+		return
+	}
+	buf.WriteString(`</soap:Body></soap:Envelope>`)
+	c.Data(code, contentType+"; charset=utf-8", buf.Bytes())
+}
+
+// SOAP writes body, marshaled with encoding/xml, in a SOAP envelope of the version of the
+// request, 1.2 if its content type is MIMESOAP12, 1.1 otherwise.
+func (c *Context) SOAP(code int, body any) {
+	c.writeSOAP(code, func(buf *bytes.Buffer) error {
+		return xml.NewEncoder(buf).Encode(body)
+	})
+}
+
+// SOAPFault writes fault in a SOAP envelope of the version of the request, see SOAP, and
+// aborts the request. The status code is 500, or 400 for the client faults of SOAP 1.2.
+func (c *Context) SOAPFault(fault SOAPFault) {
+	if fault.Code == "" {
+		fault.Code = SOAPFaultServer
+	}
+	soap12 := c.isSOAP12()
+	code := http.StatusInternalServerError
+	if soap12 && fault.Code == SOAPFaultClient {
+		code = http.StatusBadRequest
+	}
+
+	c.Abort()
+	c.writeSOAP(code, func(buf *bytes.Buffer) error {
+		escape := func(s string) {
+			xml.EscapeText(buf, []byte(s)) // nolint: errcheck
+		}
+		detail := func(open, close string) error {
+			if fault.Detail == nil {
+				return nil
+			}
+			buf.WriteString(open)
+			if err := xml.NewEncoder(buf).Encode(fault.Detail); err != nil {
+				return err
+			}
+			buf.WriteString(close)
+			return nil
+		}
+
+		if !soap12 {
+			buf.WriteString(`<soap:Fault><faultcode>soap:`)
+			escape(fault.Code)
+			buf.WriteString(`</faultcode><faultstring>`)
+			escape(fault.String)
+			buf.WriteString(`</faultstring>`)
+			if fault.Actor != "" {
+				buf.WriteString(`<faultactor>`)
+				escape(fault.Actor)
+				buf.WriteString(`</faultactor>`)
+			}
+			if err := detail(`<detail>`, `</detail>`); err != nil {
+				return err
+			}
+			buf.WriteString(`</soap:Fault>`)
+			return nil
+		}
+
+		value := fault.Code
+		switch value {
+		case SOAPFaultClient:
+			value = "Sender"
+		case SOAPFaultServer:
+			value = "Receiver"
+		}
+		buf.WriteString(`<soap:Fault><soap:Code><soap:Value>soap:`)
+		escape(value)
+		buf.WriteString(`</soap:Value></soap:Code><soap:Reason><soap:Text xml:lang="en">`)
+		escape(fault.String)
+		buf.WriteString(`</soap:Text></soap:Reason>`)
+		if err := detail(`<soap:Detail>`, `</soap:Detail>`); err != nil {
+			return err
+		}
+		buf.WriteString(`</soap:Fault>`)
+		return nil
+	})
+}
+
+// SOAPService registers a SOAP endpoint at relativePath: the POST requests are served by
+// handlers, and the GET requests, conventionally sent with a "wsdl" query, get the WSDL
+// document describing the service, if any.
+//
+//	//go:embed stock.wsdl
+//	var stockWSDL []byte
+//
+//	router.SOAPService("/stock", stockWSDL, getPrice)
+func (group *RouterGroup) SOAPService(relativePath string, wsdl []byte, handlers ...HandlerFunc) IRoutes {
+	if wsdl != nil {
+		group.GET(relativePath, func(c *Context) {
+			c.Data(http.StatusOK, MIMEXML+"; charset=utf-8", wsdl)
+		})
+	}
+	return group.POST(relativePath, handlers...)
+}
@@ -0,0 +1,169 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type soapGetPrice struct {
+	XMLName xml.Name `xml:"http://example.com/stock GetPrice"`
+	Symbol  string   `xml:"Symbol"`
+}
+
+type soapGetPriceResponse struct {
+	XMLName xml.Name `xml:"http://example.com/stock GetPriceResponse"`
+	Price   float64  `xml:"Price"`
+}
+
+type soapAuthHeader struct {
+	Token string `xml:"Auth>Token"`
+}
+
+const soapRequest11 = `<?xml version="1.0"?>
+<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/" xmlns:m="http://example.com/stock">
+  <soap:Header><m:Auth><m:Token>secret</m:Token></m:Auth></soap:Header>
+  <soap:Body><m:GetPrice><m:Symbol>GIN</m:Symbol></m:GetPrice></soap:Body>
+</soap:Envelope>`
+
+const soapRequest12 = `<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope">
+  <env:Body><GetPrice xmlns="http://example.com/stock"><Symbol>GIN</Symbol></GetPrice></env:Body>
+</env:Envelope>`
+
+func newSOAPContext(body, contentType string) (*Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodPost, "/stock", strings.NewReader(body))
+	c.Request.Header.Set("Content-Type", contentType)
+	return c, w
+}
+
+func TestShouldBindSOAP(t *testing.T) {
+	c, _ := newSOAPContext(soapRequest11, MIMEXML)
+	var header soapAuthHeader
+	var req soapGetPrice
+	assert.NoError(t, c.ShouldBindSOAP(&header, &req))
+	assert.Equal(t, "secret", header.Token)
+	assert.Equal(t, "GIN", req.Symbol)
+
+	c, _ = newSOAPContext(soapRequest12, MIMESOAP12)
+	req = soapGetPrice{}
+	assert.NoError(t, c.ShouldBindSOAP(nil, &req))
+	assert.Equal(t, "GIN", req.Symbol)
+}
+
+func TestShouldBindSOAPInvalid(t *testing.T) {
+	for _, body := range []string{
+		``,
+		`<GetPrice/>`,
+		`<soap:Envelope xmlns:soap="http://example.com/other"><soap:Body><GetPrice/></soap:Body></soap:Envelope>`,
+		`<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"></soap:Envelope>`,
+		`<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body></soap:Body></soap:Envelope>`,
+	} {
+		c, _ := newSOAPContext(body, MIMEXML)
+		var req soapGetPrice
+		assert.ErrorIs(t, c.ShouldBindSOAP(nil, &req), ErrSOAPEnvelope, body)
+	}
+
+	c, _ := newSOAPContext(`<soap:Envelope xmlns:soap="http://schemas.xmlsoap.org/soap/envelope/"><soap:Body><Other/></soap:Body></soap:Envelope>`, MIMEXML)
+	var req soapGetPrice
+	err := c.ShouldBindSOAP(nil, &req)
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrSOAPEnvelope))
+}
+
+func TestContextSOAPAction(t *testing.T) {
+	c, _ := newSOAPContext("", MIMEXML)
+	c.Request.Header.Set("SOAPAction", `"http://example.com/stock/GetPrice"`)
+	assert.Equal(t, "http://example.com/stock/GetPrice", c.SOAPAction())
+
+	c, _ = newSOAPContext("", `application/soap+xml; charset=utf-8; action="urn:GetPrice"`)
+	assert.Equal(t, "urn:GetPrice", c.SOAPAction())
+
+	c, _ = newSOAPContext("", MIMEXML)
+	assert.Empty(t, c.SOAPAction())
+}
+
+func TestContextSOAP(t *testing.T) {
+	c, w := newSOAPContext(soapRequest11, MIMEXML)
+	c.SOAP(http.StatusOK, soapGetPriceResponse{Price: 1.5})
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/xml; charset=utf-8", w.Header().Get("Content-Type"))
+
+	var resp soapGetPriceResponse
+	c, _ = newSOAPContext(w.Body.String(), MIMEXML)
+	assert.NoError(t, c.ShouldBindSOAP(nil, &resp))
+	assert.Equal(t, 1.5, resp.Price)
+
+	c, w = newSOAPContext(soapRequest12, MIMESOAP12)
+	c.SOAP(http.StatusOK, soapGetPriceResponse{Price: 2})
+	assert.Equal(t, "application/soap+xml; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Contains(t, w.Body.String(), `xmlns:soap="http://www.w3.org/2003/05/soap-envelope"`)
+}
+
+func TestContextSOAPFault(t *testing.T) {
+	type detail struct {
+		XMLName xml.Name `xml:"StockFault"`
+		Reason  string   `xml:"Reason"`
+	}
+
+	c, w := newSOAPContext(soapRequest11, MIMEXML)
+	c.SOAPFault(SOAPFault{Code: SOAPFaultClient, String: "unknown <symbol>", Actor: "urn:stock", Detail: detail{Reason: "delisted"}})
+	assert.True(t, c.IsAborted())
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	body := w.Body.String()
+	assert.Contains(t, body, `<soap:Fault><faultcode>soap:Client</faultcode><faultstring>unknown &lt;symbol&gt;</faultstring>`)
+	assert.Contains(t, body, `<faultactor>urn:stock</faultactor>`)
+	assert.Contains(t, body, `<detail><StockFault><Reason>delisted</Reason></StockFault></detail>`)
+
+	c, w = newSOAPContext(soapRequest12, MIMESOAP12)
+	c.SOAPFault(SOAPFault{Code: SOAPFaultClient, String: "unknown symbol"})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	body = w.Body.String()
+	assert.Contains(t, body, `<soap:Code><soap:Value>soap:Sender</soap:Value></soap:Code>`)
+	assert.Contains(t, body, `<soap:Text xml:lang="en">unknown symbol</soap:Text>`)
+	assert.NotContains(t, body, `Detail`)
+
+	c, w = newSOAPContext(soapRequest12, MIMESOAP12)
+	c.SOAPFault(SOAPFault{String: "down"})
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), `soap:Receiver`)
+}
+
+func TestRouterGroupSOAPService(t *testing.T) {
+	wsdl := []byte(`<definitions name="Stock"/>`)
+	router := New()
+	router.SOAPService("/stock", wsdl, func(c *Context) {
+		var req soapGetPrice
+		if err := c.ShouldBindSOAP(nil, &req); err != nil {
+			c.SOAPFault(SOAPFault{Code: SOAPFaultClient, String: err.Error()})
+			return
+		}
+		c.SOAP(http.StatusOK, soapGetPriceResponse{Price: float64(len(req.Symbol))})
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/stock?wsdl")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, string(wsdl), w.Body.String())
+
+	w = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/stock", strings.NewReader(soapRequest11))
+	req.Header.Set("Content-Type", MIMEXML)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), `<Price>3</Price>`)
+
+	router = New()
+	router.SOAPService("/stock", nil, func(c *Context) {})
+	w = PerformRequest(router, http.MethodGet, "/stock?wsdl")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,119 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"hash/fnv"
+	"math/rand"
+)
+
+// SplitVariantKey is the key under which Split stores the name of the selected variant.
+const SplitVariantKey = "_gin-gonic/gin/splitvariant"
+
+const defaultSplitCookie = "gin_variant"
+
+// SplitVariant is one of the handler chains Split distributes the requests between.
+type SplitVariant struct {
+	// Name identifies the variant in the stickiness cookie. It must be unique.
+	Name string
+
+	// Weight is the share of the requests the variant receives, relative to the sum of the
+	// weights of all the variants. A variant with a zero weight only receives the requests
+	// already bound to it.
+	Weight int
+
+	// Handlers run when the variant is selected.
+	Handlers HandlersChain
+
+	// Flag names a feature flag selecting the variant, regardless of weights and stickiness,
+	// for the requests it is enabled for, see Context.FeatureEnabled. Optional.
+	Flag string
+}
+
+// SplitConfig defines the config for Split middleware.
+type SplitConfig struct {
+	// Variants are the handler chains the requests are distributed between.
+	Variants []SplitVariant
+
+	// Cookie is the name of the cookie binding a client to the variant it got first.
+	// Optional. Default value is "gin_variant".
+	Cookie string
+
+	// Header names a request header, such as X-User-ID, whose value picks the variant
+	// deterministically, so that a client is bound to a variant even without cookies.
+	// It takes precedence over the cookie when present. Optional.
+	Header string
+}
+
+// Split returns a middleware that distributes the requests between several handler chains
+// according to their weight, e.g. to canary a new implementation behind the same path.
+// Requests stick to the variant they got first through a cookie, or through the value of
+// the configured header. The handlers of the selected variant run next, before the remaining
+// handlers of the route, and its name is stored under SplitVariantKey.
+//
+//	router.GET("/checkout", gin.Split(gin.SplitConfig{Variants: []gin.SplitVariant{
+//	    {Name: "blue", Weight: 90, Handlers: gin.HandlersChain{checkout}},
+//	    {Name: "green", Weight: 10, Handlers: gin.HandlersChain{newCheckout}},
+//	}}))
+func Split(conf SplitConfig) HandlerFunc {
+	assert1(len(conf.Variants) > 0, "there must be at least one variant")
+	variants := make(map[string]*SplitVariant, len(conf.Variants))
+	total := 0
+	for i := range conf.Variants {
+		v := &conf.Variants[i]
+		assert1(v.Name != "", "variant name can not be empty")
+		assert1(v.Weight >= 0, "variant weight can not be negative")
+		assert1(len(v.Handlers) > 0, "there must be at least one handler")
+		if _, ok := variants[v.Name]; ok {
+			panic("variant '" + v.Name + "' is declared twice")
+		}
+		variants[v.Name] = v
+		total += v.Weight
+	}
+	assert1(total > 0, "the sum of the variant weights must be positive")
+
+	cookie := conf.Cookie
+	if cookie == "" {
+		cookie = defaultSplitCookie
+	}
+
+	pick := func(n int) *SplitVariant {
+		for i := range conf.Variants {
+			if n < conf.Variants[i].Weight {
+				return &conf.Variants[i]
+			}
+			n -= conf.Variants[i].Weight
+		}
+		return &conf.Variants[len(conf.Variants)-1]
+	}
+
+	return func(c *Context) {
+		variant := flaggedVariant(c, conf.Variants)
+		if variant == nil {
+			if key := c.GetHeader(conf.Header); conf.Header != "" && key != "" {
+				h := fnv.New32a()
+				h.Write([]byte(key)) // nolint: errcheck
+				variant = pick(int(h.Sum32() % uint32(total)))
+			} else if name, err := c.Cookie(cookie); err == nil && variants[name] != nil {
+				variant = variants[name]
+			} else {
+				variant = pick(rand.Intn(total)) // nolint: gosec
+				c.SetCookie(cookie, variant.Name, 0, "/", "", false, true)
+			}
+		}
+		c.Set(SplitVariantKey, variant.Name)
+		c.InsertNext(variant.Handlers...)
+	}
+}
+
+// flaggedVariant returns the first variant whose feature flag is enabled for the request.
+func flaggedVariant(c *Context, variants []SplitVariant) *SplitVariant {
+	for i := range variants {
+		if flag := variants[i].Flag; flag != "" && c.FeatureEnabled(flag) {
+			return &variants[i]
+		}
+	}
+	return nil
+}
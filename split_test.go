@@ -0,0 +1,80 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplit(t *testing.T) {
+	variant := func(name string) HandlerFunc {
+		return func(c *Context) {
+			c.String(http.StatusOK, "%s", name+":"+c.GetString(SplitVariantKey))
+		}
+	}
+
+	router := New()
+	router.GET("/checkout", Split(SplitConfig{
+		Header: "X-User-ID",
+		Variants: []SplitVariant{
+			{Name: "blue", Weight: 1, Handlers: HandlersChain{variant("old")}},
+			{Name: "green", Weight: 1, Handlers: HandlersChain{variant("new")}},
+			{Name: "dark", Weight: 0, Handlers: HandlersChain{variant("dark")}},
+		},
+	}))
+
+	counts := map[string]int{}
+	for i := 0; i < 200; i++ {
+		w := PerformRequest(router, http.MethodGet, "/checkout")
+		counts[w.Body.String()]++
+		assert.True(t, strings.HasPrefix(w.Header().Get("Set-Cookie"), "gin_variant="))
+	}
+	assert.Greater(t, counts["old:blue"], 0)
+	assert.Greater(t, counts["new:green"], 0)
+	assert.Equal(t, 200, counts["old:blue"]+counts["new:green"])
+
+	// sticky by cookie, even with a zero weight
+	w := PerformRequest(router, http.MethodGet, "/checkout", header{"Cookie", "gin_variant=dark"})
+	assert.Equal(t, "dark:dark", w.Body.String())
+	assert.Empty(t, w.Header().Get("Set-Cookie"))
+
+	// sticky by header
+	first := PerformRequest(router, http.MethodGet, "/checkout", header{"X-User-ID", "42"}).Body.String()
+	for i := 0; i < 10; i++ {
+		w = PerformRequest(router, http.MethodGet, "/checkout", header{"X-User-ID", "42"})
+		assert.Equal(t, first, w.Body.String())
+	}
+}
+
+func TestSplitFeatureFlag(t *testing.T) {
+	router := New()
+	router.SetFeatureFlagProvider(FeatureFlagFunc(func(flag string, attrs FlagAttributes) bool {
+		return flag == "new-checkout" && attrs.Header.Get("X-Beta") == "1"
+	}))
+	router.GET("/checkout", Split(SplitConfig{Variants: []SplitVariant{
+		{Name: "blue", Weight: 1, Handlers: HandlersChain{func(c *Context) { c.String(http.StatusOK, "%s", "old") }}},
+		{Name: "green", Flag: "new-checkout", Handlers: HandlersChain{func(c *Context) { c.String(http.StatusOK, "%s", "new") }}},
+	}}))
+
+	w := PerformRequest(router, http.MethodGet, "/checkout")
+	assert.Equal(t, "old", w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/checkout", header{"X-Beta", "1"}, header{"Cookie", "gin_variant=blue"})
+	assert.Equal(t, "new", w.Body.String())
+}
+
+func TestSplitInvalid(t *testing.T) {
+	h := HandlersChain{func(c *Context) {}}
+	assert.Panics(t, func() { Split(SplitConfig{}) })
+	assert.Panics(t, func() { Split(SplitConfig{Variants: []SplitVariant{{Name: "a", Handlers: h}}}) })
+	assert.Panics(t, func() { Split(SplitConfig{Variants: []SplitVariant{{Name: "a", Weight: -1, Handlers: h}}}) })
+	assert.Panics(t, func() { Split(SplitConfig{Variants: []SplitVariant{{Name: "a", Weight: 1}}}) })
+	assert.Panics(t, func() {
+		Split(SplitConfig{Variants: []SplitVariant{{Name: "a", Weight: 1, Handlers: h}, {Name: "a", Weight: 1, Handlers: h}}})
+	})
+}
@@ -0,0 +1,56 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import "strings"
+
+// staticRoute is the entry of a fully static route in the fast path index.
+type staticRoute struct {
+	handlers HandlersChain
+	fullPath string
+}
+
+// isStaticPath returns true if path has neither params nor wildcards.
+func isStaticPath(path string) bool {
+	return !strings.ContainsAny(path, ":*")
+}
+
+// indexStaticRoute keeps the fast path index of the static routes in sync with the trees.
+// The index is always maintained so EnableStaticFastPath can be switched at any time.
+func (engine *Engine) indexStaticRoute(method, path string, handlers HandlersChain) {
+	if !isStaticPath(path) {
+		return
+	}
+	if engine.staticRoutes == nil {
+		engine.staticRoutes = make(map[string]map[string]staticRoute)
+	}
+	routes := engine.staticRoutes[method]
+	if routes == nil {
+		routes = make(map[string]staticRoute)
+		engine.staticRoutes[method] = routes
+	}
+	routes[path] = staticRoute{handlers: handlers, fullPath: path}
+}
+
+// serveStaticRoute serves the request from the static route index, returning false if
+// path is not a static route registered for method.
+func (engine *Engine) serveStaticRoute(c *Context, method, path string) bool {
+	route, ok := engine.staticRoutes[method][path]
+	if !ok {
+		return false
+	}
+	c.handlers = route.handlers
+	c.fullPath = route.fullPath
+	// static routes have no params of their own, only the inherited ones
+	if c.inheritedParams != nil {
+		c.inheritParams()
+	}
+	if engine.ParamsInRequestContext && len(c.Params) > 0 {
+		c.Request = requestWithParams(c.Request, c.Params)
+	}
+	c.Next()
+	c.writermem.WriteHeaderNow()
+	return true
+}
@@ -0,0 +1,72 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaticFastPath(t *testing.T) {
+	router := New()
+	router.EnableStaticFastPath = true
+	router.GET("/users/new", func(c *Context) {
+		c.String(http.StatusOK, "%s", "static "+c.FullPath())
+	})
+	router.GET("/users/:id", func(c *Context) {
+		c.String(http.StatusOK, "%s", "param "+c.Param("id"))
+	})
+	router.POST("/users", func(c *Context) {
+		c.String(http.StatusCreated, "%s", "created")
+	})
+
+	assert.Len(t, router.staticRoutes[http.MethodGet], 1)
+	assert.Len(t, router.staticRoutes[http.MethodPost], 1)
+
+	w := PerformRequest(router, http.MethodGet, "/users/new")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "static /users/new", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/users/42")
+	assert.Equal(t, "param 42", w.Body.String())
+
+	w = PerformRequest(router, http.MethodPost, "/users")
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/users")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestStaticFastPathAppendMiddleware(t *testing.T) {
+	router := New()
+	router.EnableStaticFastPath = true
+	router.GET("/ping", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.GetString("prefix")+"pong")
+	})
+	router.AppendMiddleware(http.MethodGet, "/ping", func(c *Context) {
+		c.Set("prefix", "appended ")
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/ping")
+	assert.Equal(t, "appended pong", w.Body.String())
+}
+
+func TestStaticFastPathInheritedParams(t *testing.T) {
+	router := New()
+	router.EnableStaticFastPath = true
+	router.ParamsInRequestContext = true
+	router.GET("/users/:id", func(c *Context) {
+		c.Request.URL.Path = "/profile"
+		assert.NoError(t, router.HandleContextWithOptions(c, ReentryOptions{KeepParams: true}))
+	})
+	router.GET("/profile", func(c *Context) {
+		c.String(http.StatusOK, "%s %s", c.Param("id"), ParamsFromContext(c.Request.Context()).ByName("id"))
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/users/42")
+	assert.Equal(t, "42 42", w.Body.String())
+}
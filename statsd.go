@@ -0,0 +1,300 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	defaultStatsDMaxPacketSize = 1432
+	defaultStatsDFlushInterval = 100 * time.Millisecond
+
+	// statsDMaxQueuedPackets is the maximum number of full packets waiting to be sent, the
+	// next ones being dropped, as packets are lost when the agent can't keep up.
+	statsDMaxQueuedPackets = 64
+)
+
+// StatsDConfig defines the config for NewStatsDExporter.
+type StatsDConfig struct {
+	// Address is the address of the StatsD or DogStatsD agent, e.g. "127.0.0.1:8125".
+	Address string
+
+	// Network is the network of Address. Optional. Default value is "udp".
+	Network string
+
+	// Plain sends the metrics to a plain StatsD server, which does not support the
+	// DogStatsD tags: the values of the tags are appended to the metric names instead,
+	// e.g. "gin.requests.GET.users_id.200". Optional.
+	Plain bool
+
+	// Tags are added to all the metrics, e.g. the service and environment tags.
+	// Optional. Ignored when Plain is set.
+	Tags []MetricTag
+
+	// MaxPacketSize is the maximum size of the packets, holding several metrics.
+	// Optional. Default value is 1432, fitting in an Ethernet frame.
+	MaxPacketSize int
+
+	// FlushInterval is the maximum time the metrics are buffered before being sent.
+	// Optional. Default value is 100ms.
+	FlushInterval time.Duration
+}
+
+// StatsDExporter is a MetricsExporter sending the metrics to a StatsD or DogStatsD agent.
+// The metrics are buffered and sent in packets by a goroutine, never on the request
+// paths, the write errors being ignored, as the agent is usually reached over UDP.
+type StatsDExporter struct {
+	config    StatsDConfig
+	conn      net.Conn
+	constTags string
+
+	// mu guards the buffers, and wmu the writes, which take the queue under mu first so
+	// that the packets are sent in order.
+	mu     sync.Mutex
+	buf    []byte
+	queue  [][]byte
+	free   [][]byte
+	closed bool
+	wmu    sync.Mutex
+	queued chan struct{}
+	done   chan struct{}
+	wg     sync.WaitGroup
+}
+
+var _ MetricsExporter = (*StatsDExporter)(nil)
+
+// NewStatsDExporter returns a new StatsDExporter connected to the agent of the config.
+// It must be closed to send the last metrics.
+func NewStatsDExporter(config StatsDConfig) (*StatsDExporter, error) {
+	if config.Network == "" {
+		config.Network = "udp"
+	}
+	if config.MaxPacketSize <= 0 {
+		config.MaxPacketSize = defaultStatsDMaxPacketSize
+	}
+	if config.FlushInterval <= 0 {
+		config.FlushInterval = defaultStatsDFlushInterval
+	}
+	conn, err := net.Dial(config.Network, config.Address)
+	if err != nil {
+		return nil, err
+	}
+	e := &StatsDExporter{
+		config: config,
+		conn:   conn,
+		buf:    make([]byte, 0, config.MaxPacketSize),
+		queued: make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	if !config.Plain {
+		e.constTags = string(appendStatsDTags(nil, config.Tags))
+	}
+	e.wg.Add(1)
+	go e.flushLoop()
+	return e, nil
+}
+
+// Count implements MetricsExporter.
+func (e *StatsDExporter) Count(name string, value int64, tags []MetricTag) {
+	e.send(name, strconv.FormatInt(value, 10), "c", tags)
+}
+
+// Gauge implements MetricsExporter.
+func (e *StatsDExporter) Gauge(name string, value float64, tags []MetricTag) {
+	e.send(name, strconv.FormatFloat(value, 'f', -1, 64), "g", tags)
+}
+
+// Histogram implements MetricsExporter. The values are sent as timers to the plain
+// StatsD servers, which have no histograms.
+func (e *StatsDExporter) Histogram(name string, value float64, tags []MetricTag) {
+	typ := "h"
+	if e.config.Plain {
+		typ = "ms"
+	}
+	e.send(name, strconv.FormatFloat(value, 'f', -1, 64), typ, tags)
+}
+
+// Timing implements MetricsExporter. The durations are sent in milliseconds.
+func (e *StatsDExporter) Timing(name string, d time.Duration, tags []MetricTag) {
+	e.send(name, strconv.FormatFloat(float64(d)/float64(time.Millisecond), 'f', -1, 64), "ms", tags)
+}
+
+// send buffers the metric, queuing the buffer to be sent first if the metric does not fit.
+func (e *StatsDExporter) send(name, value, typ string, tags []MetricTag) {
+	line := []byte(name)
+	if e.config.Plain {
+		for _, tag := range tags {
+			line = append(line, '.')
+			line = appendStatsDName(line, tag.Value)
+		}
+	}
+	line = append(line, ':')
+	line = append(line, value...)
+	line = append(line, '|')
+	line = append(line, typ...)
+	if !e.config.Plain && (len(tags) > 0 || e.constTags != "") {
+		line = append(line, "|#"...)
+		line = append(line, e.constTags...)
+		if len(tags) > 0 && e.constTags != "" {
+			line = append(line, ',')
+		}
+		line = appendStatsDTags(line, tags)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.closed {
+		return
+	}
+	if len(e.buf) > 0 && len(e.buf)+1+len(line) > e.config.MaxPacketSize {
+		e.queueLocked()
+	}
+	if len(e.buf) > 0 {
+		e.buf = append(e.buf, '\n')
+	}
+	e.buf = append(e.buf, line...)
+}
+
+// queueLocked queues the buffer to be sent by the flush goroutine, or drops it if too
+// many packets are waiting already.
+func (e *StatsDExporter) queueLocked() {
+	if len(e.queue) >= statsDMaxQueuedPackets {
+		e.buf = e.buf[:0]
+		return
+	}
+	e.queue = append(e.queue, e.buf)
+	e.buf = e.newBufLocked()
+	select {
+	case e.queued <- struct{}{}:
+	default:
+	}
+}
+
+// newBufLocked returns an empty buffer, reusing the ones of the packets already sent.
+func (e *StatsDExporter) newBufLocked() []byte {
+	if n := len(e.free); n > 0 {
+		buf := e.free[n-1]
+		e.free = e.free[:n-1]
+		return buf
+	}
+	return make([]byte, 0, e.config.MaxPacketSize)
+}
+
+func (e *StatsDExporter) flushLoop() {
+	defer e.wg.Done()
+	ticker := time.NewTicker(e.config.FlushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-e.queued:
+			e.write(false)
+		case <-ticker.C:
+			e.write(true)
+		case <-e.done:
+			return
+		}
+	}
+}
+
+// write sends the queued packets, and the buffered metrics if all is set.
+func (e *StatsDExporter) write(all bool) {
+	e.wmu.Lock()
+	defer e.wmu.Unlock()
+
+	e.mu.Lock()
+	packets := e.queue
+	e.queue = nil
+	if all && len(e.buf) > 0 {
+		packets = append(packets, e.buf)
+		e.buf = e.newBufLocked()
+	}
+	e.mu.Unlock()
+
+	for _, packet := range packets {
+		e.conn.Write(packet) // nolint: errcheck
+	}
+
+	e.mu.Lock()
+	for _, packet := range packets {
+		if len(e.free) < statsDMaxQueuedPackets {
+			e.free = append(e.free, packet[:0])
+		}
+	}
+	e.mu.Unlock()
+}
+
+// Flush sends the buffered metrics.
+func (e *StatsDExporter) Flush() {
+	e.write(true)
+}
+
+// Close sends the buffered metrics and closes the connection to the agent. The metrics
+// recorded afterwards are dropped.
+func (e *StatsDExporter) Close() error {
+	e.mu.Lock()
+	if e.closed {
+		e.mu.Unlock()
+		return nil
+	}
+	e.closed = true
+	e.mu.Unlock()
+
+	close(e.done)
+	e.wg.Wait()
+	e.write(true)
+	return e.conn.Close()
+}
+
+// appendStatsDTags appends the tags in the DogStatsD format, "name:value" separated by
+// commas.
+func appendStatsDTags(b []byte, tags []MetricTag) []byte {
+	for i, tag := range tags {
+		if i > 0 {
+			b = append(b, ',')
+		}
+		b = append(b, strings.Map(statsDTagRune, strings.ReplaceAll(tag.Name, ":", "_"))...)
+		if tag.Value != "" {
+			b = append(b, ':')
+			b = append(b, strings.Map(statsDTagRune, tag.Value)...)
+		}
+	}
+	return b
+}
+
+// statsDTagRune replaces the characters separating the tags and the metric fields.
+func statsDTagRune(r rune) rune {
+	switch r {
+	case ',', '|', '#', '\n':
+		return '_'
+	}
+	return r
+}
+
+// appendStatsDName appends s as a metric name segment, e.g. "users_id" for "/users/:id".
+func appendStatsDName(b []byte, s string) []byte {
+	s = strings.Trim(s, "/")
+	if s == "" {
+		return append(b, "root"...)
+	}
+	underscore := false
+	for i := 0; i < len(s); i++ {
+		ch := s[i]
+		if ch >= 'a' && ch <= 'z' || ch >= 'A' && ch <= 'Z' || ch >= '0' && ch <= '9' || ch == '-' {
+			b = append(b, ch)
+			underscore = false
+			continue
+		}
+		if !underscore {
+			b = append(b, '_')
+			underscore = true
+		}
+	}
+	return b
+}
@@ -0,0 +1,139 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func listenStatsD(t *testing.T) net.PacketConn {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Skip("udp is not available:", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return conn
+}
+
+func readStatsDPacket(t *testing.T, conn net.PacketConn) string {
+	buf := make([]byte, 65536)
+	assert.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	n, _, err := conn.ReadFrom(buf)
+	assert.NoError(t, err)
+	return string(buf[:n])
+}
+
+func TestStatsDExporterDogStatsD(t *testing.T) {
+	conn := listenStatsD(t)
+	exporter, err := NewStatsDExporter(StatsDConfig{
+		Address:       conn.LocalAddr().String(),
+		Tags:          []MetricTag{{Name: "service", Value: "api"}, {Name: "canary"}},
+		FlushInterval: time.Hour,
+	})
+	assert.NoError(t, err)
+
+	tags := []MetricTag{{Name: "route", Value: "/users/:id"}, {Name: "status", Value: "200"}}
+	exporter.Count("gin.requests", 1, tags)
+	exporter.Gauge("gin.requests.in_flight", 2, nil)
+	exporter.Histogram("gin.response.size", 512, tags)
+	exporter.Timing("gin.request.duration", 1500*time.Microsecond, []MetricTag{{Name: "a:b", Value: "x,y|z"}})
+	exporter.Flush()
+
+	assert.Equal(t, strings.Join([]string{
+		"gin.requests:1|c|#service:api,canary,route:/users/:id,status:200",
+		"gin.requests.in_flight:2|g|#service:api,canary",
+		"gin.response.size:512|h|#service:api,canary,route:/users/:id,status:200",
+		"gin.request.duration:1.5|ms|#service:api,canary,a_b:x_y_z",
+	}, "\n"), readStatsDPacket(t, conn))
+
+	exporter.Count("gin.requests", 3, nil)
+	assert.NoError(t, exporter.Close())
+	assert.Equal(t, "gin.requests:3|c|#service:api,canary", readStatsDPacket(t, conn))
+	assert.NoError(t, exporter.Close())
+	exporter.Count("gin.requests", 1, nil)
+}
+
+func TestStatsDExporterPlain(t *testing.T) {
+	conn := listenStatsD(t)
+	exporter, err := NewStatsDExporter(StatsDConfig{
+		Address: conn.LocalAddr().String(),
+		Plain:   true,
+		Tags:    []MetricTag{{Name: "service", Value: "api"}},
+	})
+	assert.NoError(t, err)
+	defer exporter.Close()
+
+	exporter.Histogram("gin.response.size", 10, []MetricTag{{Name: "method", Value: "GET"}, {Name: "route", Value: "/users/:id/*path"}})
+	exporter.Count("gin.requests", 1, []MetricTag{{Name: "route", Value: "/"}})
+	assert.Equal(t, "gin.response.size.GET.users_id_path:10|ms\ngin.requests.root:1|c", readStatsDPacket(t, conn))
+}
+
+func TestStatsDExporterMaxPacketSize(t *testing.T) {
+	conn := listenStatsD(t)
+	exporter, err := NewStatsDExporter(StatsDConfig{
+		Address:       conn.LocalAddr().String(),
+		MaxPacketSize: 32,
+		FlushInterval: time.Hour,
+	})
+	assert.NoError(t, err)
+	defer exporter.Close()
+
+	exporter.Count("gin.requests.first", 1, nil)
+	exporter.Count("gin.requests.second", 1, nil)
+	assert.Equal(t, "gin.requests.first:1|c", readStatsDPacket(t, conn))
+	exporter.Flush()
+	assert.Equal(t, "gin.requests.second:1|c", readStatsDPacket(t, conn))
+}
+
+func TestStatsDExporterMetrics(t *testing.T) {
+	conn := listenStatsD(t)
+	exporter, err := NewStatsDExporter(StatsDConfig{Address: conn.LocalAddr().String(), FlushInterval: time.Hour})
+	assert.NoError(t, err)
+	router := New()
+	router.Use(Metrics(exporter))
+	router.GET("/ping", func(c *Context) {
+		c.String(http.StatusOK, "pong")
+	})
+
+	PerformRequest(router, http.MethodGet, "/ping")
+	assert.NoError(t, exporter.Close())
+	packet := readStatsDPacket(t, conn)
+	assert.Contains(t, packet, "gin.requests:1|c|#method:GET,route:/ping,status:200\n")
+	assert.Contains(t, packet, "gin.response.size:4|h|#method:GET,route:/ping,status:200")
+
+	_, err = NewStatsDExporter(StatsDConfig{Address: "127.0.0.1:8125", Network: "unknown"})
+	assert.Error(t, err)
+}
+
+func TestStatsDExporterSendQueued(t *testing.T) {
+	conn := listenStatsD(t)
+	exporter, err := NewStatsDExporter(StatsDConfig{
+		Address:       conn.LocalAddr().String(),
+		MaxPacketSize: 32,
+		FlushInterval: time.Hour,
+	})
+	assert.NoError(t, err)
+	defer exporter.Close()
+
+	exporter.wmu.Lock()
+	exporter.Count("gin.requests.first", 1, nil)
+	exporter.Count("gin.requests.second", 1, nil)
+	for i := 0; i < statsDMaxQueuedPackets+10; i++ {
+		exporter.Count("gin.requests.more", 1, nil)
+	}
+	exporter.mu.Lock()
+	assert.Len(t, exporter.queue, statsDMaxQueuedPackets, "the request paths don't wait for the writes")
+	exporter.mu.Unlock()
+	exporter.wmu.Unlock()
+
+	assert.Equal(t, "gin.requests.first:1|c", readStatsDPacket(t, conn))
+	assert.Equal(t, "gin.requests.second:1|c", readStatsDPacket(t, conn))
+}
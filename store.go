@@ -0,0 +1,266 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrStoreMiss is returned by Store.Get when the key does not exist or expired.
+var ErrStoreMiss = errors.New("gin: store key not found")
+
+// Store is the key-value storage shared by the stateful middleware, e.g. rate limiting,
+// quotas, replay protection, idempotency, sessions and caching. Keys expire after their
+// TTL, a zero TTL meaning never. Implementations must be safe for concurrent use, and
+// their operations atomic, so that a store shared by several instances keeps them
+// consistent: see NewMemoryStore for a single instance, and the redisstore package for
+// a Redis-backed one.
+type Store interface {
+	// Get returns the value of key, or ErrStoreMiss.
+	Get(ctx context.Context, key string) ([]byte, error)
+
+	// Set sets the value of key, expiring after ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// SetNX sets the value of key, expiring after ttl, unless key exists. It reports
+	// whether key was set.
+	SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+
+	// Incr adds delta to the integer value of key and returns the result. A missing key
+	// counts as zero and is created expiring after ttl, the TTL of an existing key being
+	// left unchanged. The value is stored in decimal, as returned by Get.
+	Incr(ctx context.Context, key string, delta int64, ttl time.Duration) (int64, error)
+
+	// Delete removes key. Deleting a missing key is not an error.
+	Delete(ctx context.Context, key string) error
+}
+
+// memoryStoreEntry is an entry of a MemoryStore, the value of its LRU list elements.
+type memoryStoreEntry struct {
+	key    string
+	value  []byte
+	expiry time.Time
+}
+
+func (e *memoryStoreEntry) expired(now time.Time) bool {
+	return !e.expiry.IsZero() && !now.Before(e.expiry)
+}
+
+// MemoryStore is an in-memory Store, holding at most a given number of keys, the least
+// recently used being evicted first. It is not shared between instances.
+type MemoryStore struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	lru      *list.List
+
+	// now returns the current time, replaced by the tests.
+	now func() time.Time
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+// NewMemoryStore returns a MemoryStore holding at most capacity keys, or an unbounded
+// number of keys if capacity is not positive.
+func NewMemoryStore(capacity int) *MemoryStore {
+	return &MemoryStore{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		lru:      list.New(),
+		now:      time.Now,
+	}
+}
+
+// Len returns the number of keys held, including the expired keys not evicted yet.
+func (s *MemoryStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.lru.Len()
+}
+
+// lookup returns the live entry of key, marking it as recently used. It must be called
+// with s.mu held.
+func (s *MemoryStore) lookup(key string, now time.Time) *memoryStoreEntry {
+	elem, ok := s.entries[key]
+	if !ok {
+		return nil
+	}
+	entry := elem.Value.(*memoryStoreEntry)
+	if entry.expired(now) {
+		s.remove(elem)
+		return nil
+	}
+	s.lru.MoveToFront(elem)
+	return entry
+}
+
+// store sets the entry of key, evicting the least recently used keys above the capacity.
+// It must be called with s.mu held.
+func (s *MemoryStore) store(key string, value []byte, expiry time.Time) {
+	if elem, ok := s.entries[key]; ok {
+		entry := elem.Value.(*memoryStoreEntry)
+		entry.value, entry.expiry = value, expiry
+		s.lru.MoveToFront(elem)
+		return
+	}
+	s.entries[key] = s.lru.PushFront(&memoryStoreEntry{key: key, value: value, expiry: expiry})
+	for s.capacity > 0 && s.lru.Len() > s.capacity {
+		s.remove(s.lru.Back())
+	}
+}
+
+func (s *MemoryStore) remove(elem *list.Element) {
+	s.lru.Remove(elem)
+	delete(s.entries, elem.Value.(*memoryStoreEntry).key)
+}
+
+func storeExpiry(now time.Time, ttl time.Duration) time.Time {
+	if ttl <= 0 {
+		return time.Time{}
+	}
+	return now.Add(ttl)
+}
+
+// Get implements Store.
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	entry := s.lookup(key, s.now())
+	if entry == nil {
+		return nil, ErrStoreMiss
+	}
+	return append([]byte(nil), entry.value...), nil
+}
+
+// Set implements Store.
+func (s *MemoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	s.store(key, append([]byte(nil), value...), storeExpiry(now, ttl))
+	return nil
+}
+
+// SetNX implements Store.
+func (s *MemoryStore) SetNX(_ context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	if s.lookup(key, now) != nil {
+		return false, nil
+	}
+	s.store(key, append([]byte(nil), value...), storeExpiry(now, ttl))
+	return true, nil
+}
+
+// Incr implements Store.
+func (s *MemoryStore) Incr(_ context.Context, key string, delta int64, ttl time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := s.now()
+	var n int64
+	expiry := storeExpiry(now, ttl)
+	if entry := s.lookup(key, now); entry != nil {
+		var err error
+		if n, err = strconv.ParseInt(string(entry.value), 10, 64); err != nil {
+			return 0, errors.New("gin: store value of " + key + " is not an integer")
+		}
+		expiry = entry.expiry
+	}
+	n += delta
+	s.store(key, strconv.AppendInt(nil, n, 10), expiry)
+	return n, nil
+}
+
+// Delete implements Store.
+func (s *MemoryStore) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if elem, ok := s.entries[key]; ok {
+		s.remove(elem)
+	}
+	return nil
+}
+
+// storeNonceCache is a NonceCache backed by a Store.
+type storeNonceCache struct {
+	store  Store
+	prefix string
+}
+
+// StoreNonceCache returns a NonceCache, for SignatureConfig, recording the nonces in store
+// under prefix, so that the replays are detected across the instances sharing store.
+// Nonces are rejected if the store fails.
+func StoreNonceCache(store Store, prefix string) NonceCache {
+	return storeNonceCache{store: store, prefix: prefix}
+}
+
+func (n storeNonceCache) Use(nonce string, expiry time.Time) bool {
+	ttl := time.Until(expiry)
+	if ttl <= 0 {
+		ttl = time.Millisecond
+	}
+	ok, err := n.store.SetNX(context.Background(), n.prefix+nonce, []byte{1}, ttl)
+	return err == nil && ok
+}
+
+// storeQuotaStore is a QuotaStore backed by a Store.
+type storeQuotaStore struct {
+	store  Store
+	prefix string
+	ttl    time.Duration
+}
+
+// StoreQuotaStore returns a QuotaStore, for QuotaConfig, keeping the usage in store under
+// prefix. The usage of a period expires after ttl, which must exceed the quota period.
+func StoreQuotaStore(store Store, prefix string, ttl time.Duration) QuotaStore {
+	return storeQuotaStore{store: store, prefix: prefix, ttl: ttl}
+}
+
+func (q storeQuotaStore) key(period time.Time, key, counter string) string {
+	return q.prefix + strconv.FormatInt(period.Unix(), 10) + ":" + counter + ":" + key
+}
+
+func (q storeQuotaStore) Load(period time.Time, key string) (QuotaUsage, error) {
+	var usage QuotaUsage
+	for _, counter := range []struct {
+		name  string
+		value *int64
+	}{{"requests", &usage.Requests}, {"bytes", &usage.Bytes}} {
+		value, err := q.store.Get(context.Background(), q.key(period, key, counter.name))
+		if err == ErrStoreMiss {
+			continue
+		}
+		if err != nil {
+			return QuotaUsage{}, err
+		}
+		if *counter.value, err = strconv.ParseInt(string(value), 10, 64); err != nil {
+			return QuotaUsage{}, err
+		}
+	}
+	return usage, nil
+}
+
+func (q storeQuotaStore) Add(period time.Time, usage map[string]QuotaUsage) error {
+	ctx := context.Background()
+	for key, u := range usage {
+		if u.Requests != 0 {
+			if _, err := q.store.Incr(ctx, q.key(period, key, "requests"), u.Requests, q.ttl); err != nil {
+				return err
+			}
+		}
+		if u.Bytes != 0 {
+			if _, err := q.store.Incr(ctx, q.key(period, key, "bytes"), u.Bytes, q.ttl); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
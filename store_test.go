@@ -0,0 +1,123 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore(t *testing.T) {
+	s := NewMemoryStore(0)
+	ctx := context.Background()
+
+	_, err := s.Get(ctx, "missing")
+	assert.Equal(t, ErrStoreMiss, err)
+
+	value := []byte("hello")
+	assert.NoError(t, s.Set(ctx, "greeting", value, 0))
+	value[0] = 'j'
+	got, err := s.Get(ctx, "greeting")
+	assert.NoError(t, err)
+	assert.Equal(t, "hello", string(got))
+
+	ok, err := s.SetNX(ctx, "greeting", []byte("other"), 0)
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = s.SetNX(ctx, "lock", []byte("1"), 0)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	n, err := s.Incr(ctx, "counter", 2, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	n, err = s.Incr(ctx, "counter", -3, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(-1), n)
+	got, _ = s.Get(ctx, "counter")
+	assert.Equal(t, "-1", string(got))
+
+	_, err = s.Incr(ctx, "greeting", 1, 0)
+	assert.Error(t, err)
+
+	assert.NoError(t, s.Delete(ctx, "greeting"))
+	assert.NoError(t, s.Delete(ctx, "greeting"))
+	_, err = s.Get(ctx, "greeting")
+	assert.Equal(t, ErrStoreMiss, err)
+	assert.Equal(t, 2, s.Len())
+}
+
+func TestMemoryStoreExpiry(t *testing.T) {
+	s := NewMemoryStore(0)
+	now := time.Unix(1000, 0)
+	s.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	assert.NoError(t, s.Set(ctx, "key", []byte("value"), time.Second))
+	_, err := s.Incr(ctx, "counter", 1, time.Second)
+	assert.NoError(t, err)
+
+	now = now.Add(500 * time.Millisecond)
+	// the TTL of an existing key is left unchanged
+	_, err = s.Incr(ctx, "counter", 1, time.Second)
+	assert.NoError(t, err)
+	ok, _ := s.SetNX(ctx, "key", []byte("other"), 0)
+	assert.False(t, ok)
+
+	now = now.Add(500 * time.Millisecond)
+	_, err = s.Get(ctx, "key")
+	assert.Equal(t, ErrStoreMiss, err)
+	n, _ := s.Incr(ctx, "counter", 1, 0)
+	assert.Equal(t, int64(1), n)
+	ok, _ = s.SetNX(ctx, "key", []byte("other"), 0)
+	assert.True(t, ok)
+}
+
+func TestMemoryStoreEviction(t *testing.T) {
+	s := NewMemoryStore(2)
+	ctx := context.Background()
+
+	assert.NoError(t, s.Set(ctx, "a", []byte("1"), 0))
+	assert.NoError(t, s.Set(ctx, "b", []byte("2"), 0))
+	_, err := s.Get(ctx, "a")
+	assert.NoError(t, err)
+	assert.NoError(t, s.Set(ctx, "c", []byte("3"), 0))
+
+	assert.Equal(t, 2, s.Len())
+	_, err = s.Get(ctx, "b")
+	assert.Equal(t, ErrStoreMiss, err)
+	_, err = s.Get(ctx, "a")
+	assert.NoError(t, err)
+}
+
+func TestStoreNonceCache(t *testing.T) {
+	cache := StoreNonceCache(NewMemoryStore(0), "nonce:")
+	expiry := time.Now().Add(time.Minute)
+	assert.True(t, cache.Use("abc", expiry))
+	assert.False(t, cache.Use("abc", expiry))
+	assert.True(t, cache.Use("def", time.Now().Add(-time.Second)))
+}
+
+func TestStoreQuotaStore(t *testing.T) {
+	quotas := StoreQuotaStore(NewMemoryStore(0), "quota:", 48*time.Hour)
+	period := time.Unix(86400, 0)
+
+	usage, err := quotas.Load(period, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, QuotaUsage{}, usage)
+
+	assert.NoError(t, quotas.Add(period, map[string]QuotaUsage{"key": {Requests: 2, Bytes: 100}, "other": {Requests: 1}}))
+	assert.NoError(t, quotas.Add(period, map[string]QuotaUsage{"key": {Requests: 1, Bytes: 50}}))
+	usage, err = quotas.Load(period, "key")
+	assert.NoError(t, err)
+	assert.Equal(t, QuotaUsage{Requests: 3, Bytes: 150}, usage)
+
+	usage, err = quotas.Load(period.Add(24*time.Hour), "key")
+	assert.NoError(t, err)
+	assert.Equal(t, QuotaUsage{}, usage)
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"time"
+)
+
+// ErrStreamClientGone is returned by StreamWithConfig and by the stream writer once the
+// client went away or the stream context was canceled.
+var ErrStreamClientGone = errors.New("gin: stream client is gone")
+
+var defaultKeepAliveMessage = []byte(":\n\n")
+
+// StreamConfig defines the config for Context.StreamWithConfig.
+type StreamConfig struct {
+	// Context stops the stream when done.
+	// Optional. Default value is the request context.
+	Context context.Context
+
+	// KeepAlive is the interval after which KeepAliveMessage is written if nothing else was,
+	// to keep proxies from closing idle connections. Optional. Disabled when zero.
+	KeepAlive time.Duration
+
+	// KeepAliveMessage is written every KeepAlive interval.
+	// Optional. Default value is a Server-Sent Events comment ":\n\n".
+	KeepAliveMessage []byte
+
+	// MaxBytesPerSecond caps the write rate, writes block until they fit the budget.
+	// Optional. Unlimited when zero.
+	MaxBytesPerSecond int
+}
+
+// streamWriter is the io.Writer handed to StreamWithConfig steps. Every write is flushed,
+// rate limited and fails with ErrStreamClientGone once the stream context is done.
+type streamWriter struct {
+	mu        sync.Mutex
+	w         ResponseWriter
+	ctx       context.Context
+	drain     <-chan struct{}
+	rate      int
+	start     time.Time
+	written   int64
+	lastWrite time.Time
+}
+
+func (s *streamWriter) Write(p []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.write(p)
+}
+
+func (s *streamWriter) write(p []byte) (int, error) {
+	if s.ctx.Err() != nil {
+		return 0, ErrStreamClientGone
+	}
+	if isDraining(s.drain) {
+		return 0, ErrStreamDraining
+	}
+	if err := s.throttle(len(p)); err != nil {
+		return 0, err
+	}
+	n, err := s.w.Write(p)
+	s.written += int64(n)
+	s.lastWrite = time.Now()
+	if err != nil {
+		return n, err
+	}
+	s.w.Flush()
+	return n, nil
+}
+
+// throttle waits until n more bytes fit in the rate budget.
+func (s *streamWriter) throttle(n int) error {
+	if s.rate <= 0 {
+		return nil
+	}
+	budget := time.Duration(float64(s.written+int64(n)) / float64(s.rate) * float64(time.Second))
+	wait := budget - time.Since(s.start)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-s.ctx.Done():
+		return ErrStreamClientGone
+	case <-s.drain:
+		return ErrStreamDraining
+	}
+}
+
+func (s *streamWriter) keepAlive(interval time.Duration, message []byte, done <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.mu.Lock()
+			if time.Since(s.lastWrite) >= interval {
+				s.write(message) // nolint: errcheck
+			}
+			s.mu.Unlock()
+		case <-done:
+			return
+		case <-s.ctx.Done():
+			return
+		case <-s.drain:
+			return
+		}
+	}
+}
+
+// StreamWithConfig sends a streaming response like Stream, but step receives a writer whose
+// writes fail with ErrStreamClientGone once the client is gone, and returns an error instead
+// of a bool: io.EOF ends the stream normally, any other error ends it and is returned.
+// The config enables periodic keep-alive messages, write rate caps and cancellation. The
+// stream ends with ErrStreamDraining once the engine starts draining, see Engine.Drain.
+func (c *Context) StreamWithConfig(config StreamConfig, step func(w io.Writer) error) error {
+	ctx := config.Context
+	if ctx == nil {
+		ctx = c.Request.Context()
+	}
+	sw := &streamWriter{
+		w:         c.Writer,
+		ctx:       ctx,
+		drain:     c.engine.Draining(),
+		rate:      config.MaxBytesPerSecond,
+		start:     time.Now(),
+		lastWrite: time.Now(),
+	}
+
+	c.Writer.WriteHeaderNow()
+	if config.KeepAlive > 0 {
+		message := config.KeepAliveMessage
+		if message == nil {
+			message = defaultKeepAliveMessage
+		}
+		done := make(chan struct{})
+		var wg sync.WaitGroup
+		wg.Add(1)
+		defer wg.Wait()
+		defer close(done)
+		go func() {
+			defer wg.Done()
+			sw.keepAlive(config.KeepAlive, message, done)
+		}()
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return ErrStreamClientGone
+		}
+		if isDraining(sw.drain) {
+			return ErrStreamDraining
+		}
+		if err := step(sw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createStreamContext(ctx context.Context) (*Context, *httptest.ResponseRecorder) {
+	w := httptest.NewRecorder()
+	c, _ := CreateTestContext(w)
+	c.Request, _ = http.NewRequestWithContext(ctx, http.MethodGet, "/stream", nil)
+	return c, w
+}
+
+func TestContextStreamWithConfig(t *testing.T) {
+	c, w := createStreamContext(context.Background())
+
+	chunks := []string{"a", "b", "c"}
+	err := c.StreamWithConfig(StreamConfig{}, func(w io.Writer) error {
+		if len(chunks) == 0 {
+			return io.EOF
+		}
+		_, err := io.WriteString(w, chunks[0])
+		chunks = chunks[1:]
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", w.Body.String())
+	assert.True(t, w.Flushed)
+}
+
+func TestContextStreamWithConfigError(t *testing.T) {
+	c, _ := createStreamContext(context.Background())
+	failure := errors.New("failure")
+
+	err := c.StreamWithConfig(StreamConfig{}, func(w io.Writer) error {
+		return failure
+	})
+	assert.Equal(t, failure, err)
+}
+
+func TestContextStreamWithConfigClientGone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	c, w := createStreamContext(ctx)
+
+	var writeErr error
+	err := c.StreamWithConfig(StreamConfig{}, func(w io.Writer) error {
+		_, writeErr = io.WriteString(w, "data")
+		cancel()
+		_, err := io.WriteString(w, "lost")
+		return err
+	})
+
+	assert.NoError(t, writeErr)
+	assert.Equal(t, ErrStreamClientGone, err)
+	assert.Equal(t, "data", w.Body.String())
+
+	// the configured context takes precedence over the request one
+	c, _ = createStreamContext(context.Background())
+	err = c.StreamWithConfig(StreamConfig{Context: ctx}, func(w io.Writer) error {
+		return nil
+	})
+	assert.Equal(t, ErrStreamClientGone, err)
+}
+
+func TestContextStreamWithConfigKeepAlive(t *testing.T) {
+	c, w := createStreamContext(context.Background())
+
+	steps := 0
+	err := c.StreamWithConfig(StreamConfig{KeepAlive: 5 * time.Millisecond}, func(w io.Writer) error {
+		steps++
+		if steps > 1 {
+			return io.EOF
+		}
+		// wait for a keep-alive message instead of relying on timer accuracy
+		sw := w.(*streamWriter)
+		for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); {
+			sw.mu.Lock()
+			written := sw.written
+			sw.mu.Unlock()
+			if written > 0 {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		_, err := io.WriteString(w, "data\n")
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(w.Body.String(), ":\n\n"))
+	assert.Contains(t, w.Body.String(), "data\n")
+}
+
+func TestContextStreamWithConfigRate(t *testing.T) {
+	c, w := createStreamContext(context.Background())
+
+	start := time.Now()
+	sent := 0
+	err := c.StreamWithConfig(StreamConfig{MaxBytesPerSecond: 1000}, func(w io.Writer) error {
+		if sent == 5 {
+			return io.EOF
+		}
+		sent++
+		_, err := w.Write(make([]byte, 10))
+		return err
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, 50, w.Body.Len())
+	assert.GreaterOrEqual(t, time.Since(start), 45*time.Millisecond)
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// fillPath replaces the wildcards of the valid route path template with the escaped params,
+// returning the name of the first missing param, if any.
+func fillPath(template string, params map[string]string) (path, missing string) {
+	var buf strings.Builder
+	for len(template) > 0 {
+		wildcard, i, _ := findWildcard(template)
+		if i < 0 {
+			buf.WriteString(template)
+			break
+		}
+		buf.WriteString(template[:i])
+		value, ok := params[wildcard[1:]]
+		if !ok {
+			return "", wildcard[1:]
+		}
+		if wildcard[0] == '*' {
+			// the catch-all value keeps its slashes, the leading one is part of the template
+			buf.WriteString((&url.URL{Path: strings.TrimPrefix(value, "/")}).EscapedPath())
+		} else {
+			buf.WriteString(url.PathEscape(value))
+		}
+		template = template[i+len(wildcard):]
+	}
+	return buf.String(), ""
+}
+
+// FillPath returns the path of the route path template with its wildcards replaced with the
+// escaped params, the catch-all one keeping its slashes. It returns an error if template
+// is invalid, see ValidatePath, or a param is missing.
+//
+//	path, err := gin.FillPath("/users/:id/books/*rest", map[string]string{"id": "42", "rest": "a/b"})
+//	// path is /users/42/books/a/b
+func FillPath(template string, params map[string]string) (string, error) {
+	if err := ValidatePath(template); err != nil {
+		return "", err
+	}
+	path, missing := fillPath(template, params)
+	if missing != "" {
+		return "", fmt.Errorf("gin: missing param %q for path %q", missing, template)
+	}
+	return path, nil
+}
+
+// MatchTemplate reports whether path matches the route path template, as the router would
+// match it, and returns the params. It returns false if template is invalid, see
+// ValidatePath. It is the inverse of FillPath, e.g. to replace the paths with their
+// template in the metric labels.
+//
+//	params, ok := gin.MatchTemplate("/users/:id/books/*rest", "/users/42/books/a/b")
+//	// params are id=42 and rest=/a/b
+func MatchTemplate(template, path string) (Params, bool) {
+	if ValidatePath(template) != nil {
+		return nil, false
+	}
+	tree := &node{fullPath: "/"}
+	tree.addRoute(template, HandlersChain{func(*Context) {}})
+
+	params := make(Params, 0, countParams(template))
+	skippedNodes := make([]skippedNode, 0, countSections(template))
+	value := tree.getValue(path, &params, &skippedNodes, false)
+	if value.handlers == nil {
+		return nil, false
+	}
+	return params, true
+}
@@ -0,0 +1,53 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFillPath(t *testing.T) {
+	path, err := FillPath("/users/:id/books/*rest", map[string]string{"id": "4 2", "rest": "/a/b c"})
+	assert.NoError(t, err)
+	assert.Equal(t, "/users/4%202/books/a/b%20c", path)
+
+	path, err = FillPath("/static", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, "/static", path)
+
+	_, err = FillPath("/users/:id", nil)
+	assert.EqualError(t, err, `gin: missing param "id" for path "/users/:id"`)
+
+	_, err = FillPath("/users/:", nil)
+	assert.EqualError(t, err, "wildcards must be named with a non-empty name in path '/users/:'")
+}
+
+func TestMatchTemplate(t *testing.T) {
+	params, ok := MatchTemplate("/users/:id/books/*rest", "/users/42/books/a/b")
+	assert.True(t, ok)
+	assert.Equal(t, Params{{"id", "42"}, {"rest", "/a/b"}}, params)
+
+	params, ok = MatchTemplate("/static", "/static")
+	assert.True(t, ok)
+	assert.Empty(t, params)
+
+	_, ok = MatchTemplate("/users/:id", "/users/42/books")
+	assert.False(t, ok)
+	_, ok = MatchTemplate("/users/:id", "/users/")
+	assert.False(t, ok)
+	_, ok = MatchTemplate("/users/:id:name", "/users/42")
+	assert.False(t, ok)
+
+	// MatchTemplate is the inverse of FillPath
+	template := "/repos/:owner/:repo/contents/*path"
+	path, err := FillPath(template, map[string]string{"owner": "gin-gonic", "repo": "gin", "path": "docs/README.md"})
+	assert.NoError(t, err)
+	params, ok = MatchTemplate(template, path)
+	assert.True(t, ok)
+	assert.Equal(t, "gin", params.ByName("repo"))
+	assert.Equal(t, "/docs/README.md", params.ByName("path"))
+}
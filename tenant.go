@@ -0,0 +1,229 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin/render"
+)
+
+// TenantSource is the part of the request the tenant is extracted from.
+type TenantSource uint8
+
+const (
+	// TenantFromHeader extracts the tenant from a request header.
+	TenantFromHeader TenantSource = iota
+	// TenantFromSubdomain extracts the tenant from the subdomain of the request host,
+	// e.g. acme for acme.example.com.
+	TenantFromSubdomain
+	// TenantFromPathPrefix extracts the tenant from the first segment of the request path,
+	// e.g. acme for /acme/users. The segment is not part of the route, which is matched
+	// against the rest of the path, here /users. The request URL is left untouched, so
+	// c.Request.URL.Path is still /acme/users, in the logs and in the redirects adding or
+	// removing a trailing slash; the routed path is the one of c.FullPath and c.Param.
+	TenantFromPathPrefix
+)
+
+const defaultTenantHeader = "X-Tenant-ID"
+
+// Tenant holds the settings of a tenant, overriding the engine ones for its requests.
+type Tenant struct {
+	// ID identifies the tenant in the requests.
+	ID string
+
+	// HTMLRender overrides Engine.HTMLRender, e.g. to give the tenant its own template set.
+	// Optional.
+	HTMLRender render.HTMLRender
+
+	// TrustedProxies overrides the proxies trusted by Context.ClientIP, see
+	// Engine.SetTrustedProxies. Optional.
+	TrustedProxies []string
+
+	// RateLimit is the number of requests per second the tenant may send, enforced by
+	// TenantRateLimit. Optional. Unlimited when zero.
+	RateLimit float64
+
+	// Quota is the usage allowed to the tenant, enforced by Quota.Middleware. Optional.
+	Quota QuotaLimit
+
+	// Values holds any other setting of the tenant. Optional.
+	Values map[string]any
+
+	trustedCIDRs []*net.IPNet
+	limiter      *tokenBucket
+}
+
+// TenancyConfig defines the config for Engine.SetTenancy.
+type TenancyConfig struct {
+	// Source is the part of the request the tenant is extracted from.
+	Source TenantSource
+
+	// Header is the request header holding the tenant when Source is TenantFromHeader.
+	// Optional. Default value is "X-Tenant-ID".
+	Header string
+
+	// Domain is the parent domain of the tenant subdomains when Source is TenantFromSubdomain.
+	Domain string
+
+	// Tenants are the known tenants. Requests for other tenants are answered with a 404.
+	// Optional. If empty, every tenant is accepted, with the engine settings.
+	Tenants []*Tenant
+
+	// Optional lets requests without tenant through, Context.Tenant returns nil for them.
+	// By default they are answered with a 404.
+	Optional bool
+}
+
+// tenancy is the tenant resolution set up by Engine.SetTenancy.
+type tenancy struct {
+	config  TenancyConfig
+	tenants map[string]*Tenant
+}
+
+// SetTenancy enables the resolution of the tenant of every request, before any route lookup,
+// see Context.Tenant. It returns an error if the trusted proxies of a tenant are invalid.
+//
+//	router.SetTenancy(gin.TenancyConfig{
+//	    Source: gin.TenantFromSubdomain,
+//	    Domain: "example.com",
+//	    Tenants: []*gin.Tenant{{ID: "acme", RateLimit: 100}},
+//	})
+func (engine *Engine) SetTenancy(config TenancyConfig) error {
+	if config.Header == "" {
+		config.Header = defaultTenantHeader
+	}
+	assert1(config.Source != TenantFromSubdomain || config.Domain != "", "domain can not be empty in subdomain mode")
+	config.Domain = strings.ToLower(strings.Trim(config.Domain, "."))
+
+	t := &tenancy{config: config}
+	if len(config.Tenants) > 0 {
+		t.tenants = make(map[string]*Tenant, len(config.Tenants))
+	}
+	for _, tenant := range config.Tenants {
+		assert1(tenant.ID != "", "tenant id can not be empty")
+		cidrs, err := parseCIDRs(tenant.TrustedProxies)
+		if err != nil {
+			return err
+		}
+		tenant.trustedCIDRs = cidrs
+		if tenant.RateLimit > 0 {
+			tenant.limiter = newTokenBucket(tenant.RateLimit)
+		}
+		t.tenants[tenant.ID] = tenant
+	}
+	engine.tenancy = t
+	return nil
+}
+
+// extract returns the tenant id of the request, and the path left to route.
+func (t *tenancy) extract(c *Context, path string) (string, string) {
+	switch t.config.Source {
+	case TenantFromSubdomain:
+		host := c.Request.Host
+		if h, _, err := net.SplitHostPort(host); err == nil {
+			host = h
+		}
+		host = strings.ToLower(host)
+		if id := strings.TrimSuffix(host, "."+t.config.Domain); id != host {
+			return id, path
+		}
+		return "", path
+	case TenantFromPathPrefix:
+		if len(path) < 2 || path[0] != '/' {
+			return "", path
+		}
+		end := strings.IndexByte(path[1:], '/')
+		if end < 0 {
+			return path[1:], "/"
+		}
+		return path[1 : end+1], path[end+1:]
+	default:
+		return c.requestHeader(t.config.Header), path
+	}
+}
+
+// resolveTenant sets the tenant of the request and returns the path left to route. It
+// answers the request with a 404 and returns false if the tenant is missing or unknown.
+func (engine *Engine) resolveTenant(c *Context, path string) (string, bool) {
+	t := engine.tenancy
+	id, rest := t.extract(c, path)
+	switch {
+	case id == "":
+		if t.config.Optional {
+			return path, true
+		}
+	case t.tenants == nil:
+		c.tenant = &Tenant{ID: id}
+		return rest, true
+	case t.tenants[id] != nil:
+		c.tenant = t.tenants[id]
+		return rest, true
+	}
+	c.handlers = engine.allNoRoute
+	serveError(c, http.StatusNotFound, default404Body)
+	return "", false
+}
+
+// Tenant returns the tenant of the request, see Engine.SetTenancy, or nil if there is none.
+func (c *Context) Tenant() *Tenant {
+	return c.tenant
+}
+
+// TenantRateLimit returns a middleware that enforces the rate limit of the tenant of the
+// request, see Tenant.RateLimit. Requests over the limit are answered with a
+// 429 Too Many Requests and a Retry-After header.
+func TenantRateLimit() HandlerFunc {
+	return func(c *Context) {
+		tenant := c.Tenant()
+		if tenant == nil || tenant.limiter == nil {
+			return
+		}
+		if wait := tenant.limiter.take(); wait > 0 {
+			c.Header("Retry-After", formatRetryAfter(wait))
+			c.AbortWithStatus(http.StatusTooManyRequests)
+		}
+	}
+}
+
+// formatRetryAfter formats d as a number of seconds, rounded up.
+func formatRetryAfter(d time.Duration) string {
+	return strconv.FormatInt(int64(math.Ceil(d.Seconds())), 10)
+}
+
+// tokenBucket is a token bucket rate limiter, refilled at rate tokens per second,
+// holding at most one second worth of tokens.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64) *tokenBucket {
+	burst := math.Max(math.Ceil(rate), 1)
+	return &tokenBucket{rate: rate, burst: burst, tokens: burst, last: time.Now()}
+}
+
+// take consumes a token and returns zero, or returns how long to wait for the next token.
+func (b *tokenBucket) take() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := time.Now()
+	b.tokens = math.Min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
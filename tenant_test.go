@@ -0,0 +1,147 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"html/template"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func tenantRouter(t *testing.T, config TenancyConfig) *Engine {
+	router := New()
+	assert.NoError(t, router.SetTenancy(config))
+	router.GET("/users", func(c *Context) {
+		id := "none"
+		if tenant := c.Tenant(); tenant != nil {
+			id = tenant.ID
+		}
+		c.Header("X-Request-Path", c.Request.URL.Path)
+		c.String(http.StatusOK, "%s", id+" "+c.FullPath())
+	})
+	return router
+}
+
+func TestTenancyHeader(t *testing.T) {
+	router := tenantRouter(t, TenancyConfig{})
+
+	w := PerformRequest(router, http.MethodGet, "/users", header{"X-Tenant-ID", "acme"})
+	assert.Equal(t, "acme /users", w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/users")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	router = tenantRouter(t, TenancyConfig{Header: "X-Org", Optional: true})
+	w = PerformRequest(router, http.MethodGet, "/users")
+	assert.Equal(t, "none /users", w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/users", header{"X-Org", "acme"})
+	assert.Equal(t, "acme /users", w.Body.String())
+}
+
+func TestTenancySubdomain(t *testing.T) {
+	router := tenantRouter(t, TenancyConfig{
+		Source:  TenantFromSubdomain,
+		Domain:  "Example.com",
+		Tenants: []*Tenant{{ID: "acme"}},
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/users", nil)
+	req.Host = "ACME.example.com:8080"
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, "acme /users", w.Body.String())
+
+	req.Host = "other.example.com"
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	req.Host = "example.com"
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	assert.Panics(t, func() { _ = New().SetTenancy(TenancyConfig{Source: TenantFromSubdomain}) })
+}
+
+func TestTenancyPathPrefix(t *testing.T) {
+	router := tenantRouter(t, TenancyConfig{Source: TenantFromPathPrefix})
+
+	w := PerformRequest(router, http.MethodGet, "/acme/users")
+	assert.Equal(t, "acme /users", w.Body.String())
+	assert.Equal(t, "/acme/users", w.Header().Get("X-Request-Path"), "the request URL is not rewritten")
+
+	w = PerformRequest(router, http.MethodGet, "/acme/users/")
+	assert.Equal(t, http.StatusMovedPermanently, w.Code)
+	assert.Equal(t, "/acme/users", w.Header().Get("Location"))
+
+	w = PerformRequest(router, http.MethodGet, "/users")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
+
+func TestTenantOverrides(t *testing.T) {
+	router := New()
+	router.LoadHTMLGlob("./testdata/template/*")
+	err := router.SetTenancy(TenancyConfig{Tenants: []*Tenant{
+		{ID: "acme", TrustedProxies: []string{"10.0.0.1"}},
+		{ID: "globex", HTMLRender: render.HTMLProduction{
+			Template: template.Must(template.New("hello.tmpl").Parse("globex {{.name}}")),
+		}},
+	}})
+	assert.NoError(t, err)
+	router.GET("/ip", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.ClientIP())
+	})
+	router.GET("/hello", func(c *Context) {
+		c.HTML(http.StatusOK, "hello.tmpl", H{"name": "world"})
+	})
+
+	request := func(tenant, remoteAddr string) string {
+		req := httptest.NewRequest(http.MethodGet, "/ip", nil)
+		req.RemoteAddr = remoteAddr
+		req.Header.Set("X-Tenant-ID", tenant)
+		req.Header.Set("X-Forwarded-For", "1.2.3.4")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w.Body.String()
+	}
+	assert.Equal(t, "1.2.3.4", request("acme", "10.0.0.1:1234"))
+	assert.Equal(t, "10.0.0.2", request("acme", "10.0.0.2:1234"))
+	assert.Equal(t, "1.2.3.4", request("globex", "10.0.0.2:1234"))
+
+	w := PerformRequest(router, http.MethodGet, "/hello", header{"X-Tenant-ID", "globex"})
+	assert.Equal(t, "globex world", w.Body.String())
+	w = PerformRequest(router, http.MethodGet, "/hello", header{"X-Tenant-ID", "acme"})
+	assert.Equal(t, "<h1>Hello {[{.name}]}</h1>", w.Body.String())
+
+	assert.Error(t, New().SetTenancy(TenancyConfig{Tenants: []*Tenant{{ID: "a", TrustedProxies: []string{"x"}}}}))
+}
+
+func TestTenantRateLimit(t *testing.T) {
+	router := New()
+	assert.NoError(t, router.SetTenancy(TenancyConfig{Tenants: []*Tenant{
+		{ID: "acme", RateLimit: 2},
+		{ID: "globex"},
+	}}))
+	router.Use(TenantRateLimit())
+	router.GET("/", func(c *Context) {})
+
+	for i := 0; i < 2; i++ {
+		w := PerformRequest(router, http.MethodGet, "/", header{"X-Tenant-ID", "acme"})
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+	w := PerformRequest(router, http.MethodGet, "/", header{"X-Tenant-ID", "acme"})
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+
+	for i := 0; i < 5; i++ {
+		w = PerformRequest(router, http.MethodGet, "/", header{"X-Tenant-ID", "globex"})
+		assert.Equal(t, http.StatusOK, w.Code)
+	}
+}
@@ -0,0 +1,168 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// timeoutWriter buffers the response written by the handlers run by TimeoutHandler.
+// Once the deadline is exceeded, writes fail with http.ErrHandlerTimeout so a late
+// handler can not touch the response that was already sent.
+type timeoutWriter struct {
+	mu       sync.Mutex
+	ctx      context.Context
+	header   http.Header
+	code     int
+	body     bytes.Buffer
+	timedOut bool
+}
+
+func (tw *timeoutWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *timeoutWriter) Write(data []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut || tw.ctx.Err() != nil {
+		return 0, http.ErrHandlerTimeout
+	}
+	return tw.body.Write(data)
+}
+
+func (tw *timeoutWriter) WriteHeader(code int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if !tw.timedOut && tw.code == 0 {
+		tw.code = code
+	}
+}
+
+// Flush is a no-op, the response is only sent once the handlers are done.
+func (tw *timeoutWriter) Flush() {}
+
+// Hijack implements the http.Hijacker interface, connections can not be hijacked
+// by the handlers run by TimeoutHandler.
+func (tw *timeoutWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, http.ErrNotSupported
+}
+
+// CloseNotify implements the http.CloseNotifier interface.
+func (tw *timeoutWriter) CloseNotify() <-chan bool {
+	return make(chan bool)
+}
+
+func (tw *timeoutWriter) timeout() {
+	tw.mu.Lock()
+	tw.timedOut = true
+	tw.mu.Unlock()
+}
+
+// TimeoutHandler returns a middleware running the rest of the handlers chain with a time
+// limit. The handlers run in their own goroutine, on a context of their own holding a copy
+// of the request state, whose request context is canceled at the deadline. Their response
+// is buffered and sent once they are done. If they take longer than timeout, the chain is
+// aborted and fallback writes the response, or a 503 Service Unavailable is sent if fallback
+// is nil; later writes of the handlers fail with http.ErrHandlerTimeout. If the request is
+// canceled first, e.g. the client went away, the chain is aborted without writing a response.
+// Panics of the handlers are propagated to the goroutine serving the request as long as the
+// timeout is not exceeded. The jobs they enqueue, see Context.Enqueue, are queued with the
+// response, so they are dropped with it when the timeout is exceeded. Streaming and
+// hijacking are not supported within the time limit.
+func TimeoutHandler(timeout time.Duration, fallback HandlerFunc) HandlerFunc {
+	return func(c *Context) {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+
+		tw := &timeoutWriter{ctx: ctx, header: make(http.Header)}
+		cc := c.timeoutCopy(tw, ctx)
+
+		done := make(chan struct{})
+		panicChan := make(chan any, 1)
+		go func() {
+			defer func() {
+				if p := recover(); p != nil {
+					panicChan <- p
+				}
+			}()
+			cc.Next()
+			close(done)
+		}()
+
+		select {
+		case p := <-panicChan:
+			c.Abort()
+			panic(p)
+		case <-done:
+			c.mu.Lock()
+			c.Keys = cc.Keys
+			c.mu.Unlock()
+			c.Errors = append(c.Errors, cc.Errors...)
+			c.index = cc.index
+			// the jobs are queued once the response is written, with the ones of c
+			c.pendingJobs = append(c.pendingJobs, cc.pendingJobs...)
+
+			dst := c.Writer.Header()
+			for k, v := range tw.header {
+				dst[k] = v
+			}
+			c.Writer.WriteHeader(cc.Writer.Status())
+			if cc.Writer.Written() {
+				c.Writer.WriteHeaderNow()
+				c.Writer.Write(tw.body.Bytes()) // nolint: errcheck
+			}
+		case <-ctx.Done():
+			tw.timeout()
+			if err := c.Request.Context().Err(); err != nil {
+				// the request was canceled before the deadline, nobody is waiting for a response
+				c.Error(err) // nolint: errcheck
+				c.Abort()
+				return
+			}
+			c.Error(http.ErrHandlerTimeout) // nolint: errcheck
+			c.Abort()
+			if fallback != nil {
+				fallback(c)
+				return
+			}
+			c.AbortWithStatus(http.StatusServiceUnavailable)
+		}
+	}
+}
+
+// timeoutCopy returns a context that is not pooled, sharing the request state of c but
+// writing to w, so it can safely outlive the request.
+func (c *Context) timeoutCopy(w http.ResponseWriter, ctx context.Context) *Context {
+	cc := c.engine.allocateContext()
+	cc.writermem.reset(w)
+	cc.Writer = &cc.writermem
+	cc.Request = c.Request.WithContext(ctx)
+	cc.Params = append(cc.Params, c.Params...)
+	cc.handlers = c.handlers
+	cc.index = c.index
+	cc.fullPath = c.fullPath
+	cc.tenant = c.tenant
+	cc.hostMethod = c.hostMethod
+	cc.constrained = c.constrained
+	cc.segmentParams = c.segmentParams
+	cc.inheritedParams = c.inheritedParams
+
+	c.mu.RLock()
+	if c.Keys != nil {
+		cc.Keys = make(map[string]any, len(c.Keys))
+		for k, v := range c.Keys {
+			cc.Keys[k] = v
+		}
+	}
+	c.mu.RUnlock()
+	return cc
+}
@@ -0,0 +1,141 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeoutHandler(t *testing.T) {
+	router := New()
+	router.Use(func(c *Context) {
+		c.Set("user", "gin")
+		c.Next()
+	})
+	router.Use(TimeoutHandler(time.Second, nil))
+	router.GET("/users/:id", func(c *Context) {
+		c.Header("X-User", c.MustGet("user").(string))
+		c.Set("handled", true)
+		c.String(http.StatusCreated, "user %s", c.Param("id"))
+	}, func(c *Context) {
+		c.Writer.WriteString(" and more") // nolint: errcheck
+	})
+	router.GET("/status", func(c *Context) {
+		c.Status(http.StatusAccepted)
+	})
+
+	var handled bool
+	router.GET("/aborted", func(c *Context) {
+		c.AbortWithStatus(http.StatusForbidden)
+	}, func(c *Context) {
+		handled = true
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/users/42")
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, "user 42 and more", w.Body.String())
+	assert.Equal(t, "gin", w.Header().Get("X-User"))
+
+	w = PerformRequest(router, http.MethodGet, "/status")
+	assert.Equal(t, http.StatusAccepted, w.Code)
+
+	w = PerformRequest(router, http.MethodGet, "/aborted")
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	assert.False(t, handled)
+}
+
+func TestTimeoutHandlerExceeded(t *testing.T) {
+	lateWrite := make(chan error)
+	router := New()
+	router.Use(TimeoutHandler(10*time.Millisecond, nil))
+	router.GET("/slow", func(c *Context) {
+		<-c.Request.Context().Done()
+		_, err := c.Writer.WriteString("late")
+		lateWrite <- err
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/slow")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, http.ErrHandlerTimeout, <-lateWrite)
+	assert.Empty(t, w.Body.String())
+
+	router = New()
+	router.Use(TimeoutHandler(10*time.Millisecond, func(c *Context) {
+		c.JSON(http.StatusGatewayTimeout, H{"error": c.Errors.Last().Error()})
+	}))
+	router.GET("/slow", func(c *Context) {
+		<-c.Request.Context().Done()
+	})
+	w = PerformRequest(router, http.MethodGet, "/slow")
+	assert.Equal(t, http.StatusGatewayTimeout, w.Code)
+	assert.Equal(t, `{"error":"http: Handler timeout"}`, w.Body.String())
+}
+
+func TestTimeoutHandlerCanceled(t *testing.T) {
+	var errs []error
+	router := New()
+	router.Use(func(c *Context) {
+		c.Next()
+		for _, err := range c.Errors {
+			errs = append(errs, err.Err)
+		}
+	}, TimeoutHandler(time.Second, func(c *Context) {
+		c.String(http.StatusGatewayTimeout, "timeout")
+	}))
+	router.GET("/slow", func(c *Context) {
+		<-c.Request.Context().Done()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req := httptest.NewRequest(http.MethodGet, "/slow", nil).WithContext(ctx)
+	w := httptest.NewRecorder()
+	time.AfterFunc(10*time.Millisecond, cancel)
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+	assert.Equal(t, []error{context.Canceled}, errs)
+}
+
+func TestTimeoutHandlerCopy(t *testing.T) {
+	tenant := &Tenant{}
+	router := New()
+	router.Use(func(c *Context) {
+		c.tenant = tenant
+		c.hostMethod = "GET api.example.com"
+		c.segmentParams = []url.Values{{"lang": {"en"}}}
+		c.inheritedParams = Params{{Key: "org", Value: "gin"}}
+		c.Next()
+	}, TimeoutHandler(time.Second, nil))
+	router.GET("/users/:id", func(c *Context) {
+		assert.Same(t, tenant, c.tenant)
+		assert.Equal(t, "GET api.example.com", c.hostMethod)
+		assert.Equal(t, []url.Values{{"lang": {"en"}}}, c.segmentParams)
+		assert.Equal(t, Params{{Key: "org", Value: "gin"}}, c.inheritedParams)
+		c.Status(http.StatusNoContent)
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/users/42")
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
+
+func TestTimeoutHandlerPanic(t *testing.T) {
+	router := New()
+	router.Use(RecoveryWithWriter(io.Discard), TimeoutHandler(time.Second, nil))
+	router.GET("/panic", func(c *Context) {
+		panic("boom")
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/panic")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+}
@@ -121,6 +121,45 @@ type node struct {
 	children  []*node // child nodes, at most 1 :param style node at the end of the array
 	handlers  HandlersChain
 	fullPath  string
+
+	// childIndex maps an index char to its position in children plus one, it is only
+	// built for nodes with at least childIndexThreshold children, see updateChildIndex.
+	childIndex *[256]uint16
+
+	// extensions matches the extension of the value of a catchAll node, see
+	// RouterGroup.WithExtensions.
+	extensions *CatchAllExtensions
+}
+
+// childIndexThreshold is the number of indexed children from which a node looks up its
+// children with a jump table instead of scanning its indices.
+const childIndexThreshold = 8
+
+// updateChildIndex rebuilds the jump table of the children, it must be called whenever
+// indices changes.
+func (n *node) updateChildIndex() {
+	if len(n.indices) < childIndexThreshold {
+		n.childIndex = nil
+		return
+	}
+	index := new([256]uint16)
+	for i := 0; i < len(n.indices); i++ {
+		index[n.indices[i]] = uint16(i + 1)
+	}
+	n.childIndex = index
+}
+
+// childPos returns the position in children of the child for the index char c, or -1.
+func (n *node) childPos(c byte) int {
+	if n.childIndex != nil {
+		return int(n.childIndex[c]) - 1
+	}
+	for i, max := 0, len(n.indices); i < max; i++ {
+		if c == n.indices[i] {
+			return i
+		}
+	}
+	return -1
 }
 
 // Increments priority of the given child and reorders if necessary
@@ -142,6 +181,7 @@ func (n *node) incrementChildPrio(pos int) int {
 			n.indices[pos:pos+1] + // The index char we move
 			n.indices[newPos:pos] + n.indices[pos+1:] // Rest without char at 'pos'
 	}
+	n.updateChildIndex()
 
 	return newPos
 }
@@ -171,18 +211,20 @@ walk:
 		// Split edge
 		if i < len(n.path) {
 			child := node{
-				path:      n.path[i:],
-				wildChild: n.wildChild,
-				indices:   n.indices,
-				children:  n.children,
-				handlers:  n.handlers,
-				priority:  n.priority - 1,
-				fullPath:  n.fullPath,
+				path:       n.path[i:],
+				wildChild:  n.wildChild,
+				indices:    n.indices,
+				children:   n.children,
+				handlers:   n.handlers,
+				priority:   n.priority - 1,
+				fullPath:   n.fullPath,
+				childIndex: n.childIndex,
 			}
 
 			n.children = []*node{&child}
 			// []byte for proper unicode char conversion, see #65
 			n.indices = bytesconv.BytesToString([]byte{n.path[i]})
+			n.childIndex = nil
 			n.path = path[:i]
 			n.handlers = nil
 			n.wildChild = false
@@ -203,13 +245,11 @@ walk:
 			}
 
 			// Check if a child with the next path byte exists
-			for i, max := 0, len(n.indices); i < max; i++ {
-				if c == n.indices[i] {
-					parentFullPathIndex += len(n.path)
-					i = n.incrementChildPrio(i)
-					n = n.children[i]
-					continue walk
-				}
+			if i := n.childPos(c); i >= 0 {
+				parentFullPathIndex += len(n.path)
+				i = n.incrementChildPrio(i)
+				n = n.children[i]
+				continue walk
 			}
 
 			// Otherwise insert it
@@ -374,6 +414,7 @@ func (n *node) insertChild(path string, fullPath string, handlers HandlersChain)
 
 		n.addChild(child)
 		n.indices = string('/')
+		n.updateChildIndex()
 		n = child
 		n.priority++
 
@@ -416,8 +457,28 @@ type skippedNode struct {
 // made if a handle exists with an extra (without the) trailing slash for the
 // given path.
 func (n *node) getValue(path string, params *Params, skippedNodes *[]skippedNode, unescape bool) (value nodeValue) {
-	var globalParamsCount int16
+	return n.walkValue(path, params, skippedNodes, unescape, 0)
+}
 
+// resumeValue resumes a lookup from the last node skipped by getValue, which would have
+// been walked if the returned route had not matched. It returns an empty value if no node
+// was skipped.
+func resumeValue(params *Params, skippedNodes *[]skippedNode, unescape bool) nodeValue {
+	l := len(*skippedNodes)
+	if l == 0 {
+		return nodeValue{}
+	}
+	skipped := (*skippedNodes)[l-1]
+	*skippedNodes = (*skippedNodes)[:l-1]
+	if params != nil {
+		*params = (*params)[:skipped.paramsCount]
+	}
+	return skipped.node.walkValue(skipped.path, params, skippedNodes, unescape, skipped.paramsCount)
+}
+
+// walkValue implements getValue, globalParamsCount being the number of params already
+// saved by the walk.
+func (n *node) walkValue(path string, params *Params, skippedNodes *[]skippedNode, unescape bool, globalParamsCount int16) (value nodeValue) {
 walk: // Outer loop for walking the tree
 	for {
 		prefix := n.path
@@ -426,31 +487,30 @@ walk: // Outer loop for walking the tree
 				path = path[len(prefix):]
 
 				// Try all the non-wildcard children first by matching the indices
-				idxc := path[0]
-				for i, c := range []byte(n.indices) {
-					if c == idxc {
-						//  strings.HasPrefix(n.children[len(n.children)-1].path, ":") == n.wildChild
-						if n.wildChild {
-							index := len(*skippedNodes)
-							*skippedNodes = (*skippedNodes)[:index+1]
-							(*skippedNodes)[index] = skippedNode{
-								path: prefix + path,
-								node: &node{
-									path:      n.path,
-									wildChild: n.wildChild,
-									nType:     n.nType,
-									priority:  n.priority,
-									children:  n.children,
-									handlers:  n.handlers,
-									fullPath:  n.fullPath,
-								},
-								paramsCount: globalParamsCount,
-							}
+				if i := n.childPos(path[0]); i >= 0 {
+					//  strings.HasPrefix(n.children[len(n.children)-1].path, ":") == n.wildChild
+					if n.wildChild {
+						// the copy has neither indices nor child index, so only its
+						// wildcard child is tried when backtracking
+						index := len(*skippedNodes)
+						*skippedNodes = append(*skippedNodes, skippedNode{})
+						(*skippedNodes)[index] = skippedNode{
+							path: prefix + path,
+							node: &node{
+								path:      n.path,
+								wildChild: n.wildChild,
+								nType:     n.nType,
+								priority:  n.priority,
+								children:  n.children,
+								handlers:  n.handlers,
+								fullPath:  n.fullPath,
+							},
+							paramsCount: globalParamsCount,
 						}
-
-						n = n.children[i]
-						continue walk
 					}
+
+					n = n.children[i]
+					continue walk
 				}
 
 				if !n.wildChild {
@@ -495,13 +555,13 @@ walk: // Outer loop for walking the tree
 					}
 
 					// Save param value
-					if params != nil && cap(*params) > 0 {
+					if params != nil {
 						if value.params == nil {
 							value.params = params
 						}
-						// Expand slice within preallocated capacity
+						// Expand slice, within preallocated capacity when possible
 						i := len(*value.params)
-						*value.params = (*value.params)[:i+1]
+						*value.params = append(*value.params, Param{})
 						val := path[:end]
 						if unescape {
 							if v, err := url.QueryUnescape(val); err == nil {
@@ -540,14 +600,18 @@ walk: // Outer loop for walking the tree
 					return
 
 				case catchAll:
+					if n.extensions != nil && !n.extensions.allows(path) {
+						return
+					}
+
 					// Save param value
 					if params != nil {
 						if value.params == nil {
 							value.params = params
 						}
-						// Expand slice within preallocated capacity
+						// Expand slice, within preallocated capacity when possible
 						i := len(*value.params)
-						*value.params = (*value.params)[:i+1]
+						*value.params = append(*value.params, Param{})
 						val := path
 						if unescape {
 							if v, err := url.QueryUnescape(path); err == nil {
@@ -558,6 +622,12 @@ walk: // Outer loop for walking the tree
 							Key:   n.path[2:],
 							Value: val,
 						}
+						if n.extensions != nil && n.extensions.Param != "" {
+							*value.params = append(*value.params, Param{
+								Key:   n.extensions.Param,
+								Value: fileExt(val),
+							})
+						}
 					}
 
 					value.handlers = n.handlers
@@ -720,10 +790,12 @@ walk: // Outer loop for walking the tree
 			return nil
 		}
 
-		// If this node does not have a wildcard (param or catchAll) child,
-		// we can just look up the next child node and continue to walk down
-		// the tree
-		if !n.wildChild {
+		// Look up the next static child node and continue to walk down the
+		// tree. Static children are preferred over the wildcard (param or
+		// catchAll) child, which is tried when none of them matches.
+		if len(n.indices) > 0 || !n.wildChild {
+			wildRb := rb
+
 			// Skip rune bytes already processed
 			rb = shiftNRuneBytes(rb, npLen)
 
@@ -787,6 +859,15 @@ walk: // Outer loop for walking the tree
 					for i, c := range []byte(n.indices) {
 						// Uppercase matches
 						if c == idxc {
+							if n.wildChild {
+								// keep the wildcard child as a fallback
+								if out := n.children[i].findCaseInsensitivePathRec(
+									path, ciPath, rb, fixTrailingSlash,
+								); out != nil {
+									return out
+								}
+								break
+							}
 							// Continue with child node
 							n = n.children[i]
 							npLen = len(n.path)
@@ -801,10 +882,14 @@ walk: // Outer loop for walking the tree
 			if fixTrailingSlash && path == "/" && n.handlers != nil {
 				return ciPath
 			}
-			return nil
+			if !n.wildChild {
+				return nil
+			}
+			rb = wildRb
 		}
 
-		n = n.children[0]
+		// The wildcard child is always the last one
+		n = n.children[len(n.children)-1]
 		switch n.nType {
 		case param:
 			// Find param end (either '/' or path end)
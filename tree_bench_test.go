@@ -0,0 +1,140 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+// The BenchmarkTree benchmarks measure the route matching alone and the full requests on
+// realistic route sets, for every tree implementation, see `make bench`. Compare two
+// revisions of tree.go with benchstat on the outputs of both.
+
+// largeRouteSet returns a deterministic set of about n static and param routes, shaped like
+// a large REST API with versioned resources, nested resources and static pages.
+func largeRouteSet(n int) []route {
+	routes := make([]route, 0, n)
+	for i := 0; len(routes) < n; i++ {
+		resource := fmt.Sprintf("/api/v%d/resource%d", i%3+1, i)
+		routes = append(routes,
+			route{http.MethodGet, resource},
+			route{http.MethodPost, resource},
+			route{http.MethodGet, resource + "/:id"},
+			route{http.MethodPut, resource + "/:id"},
+			route{http.MethodDelete, resource + "/:id"},
+			route{http.MethodGet, resource + "/:id/items"},
+			route{http.MethodGet, resource + "/:id/items/:item"},
+			route{http.MethodGet, fmt.Sprintf("/docs/page%d.html", i)},
+		)
+	}
+	return routes
+}
+
+// benchRouteSets are the route sets the benchmarks run on.
+var benchRouteSets = []struct {
+	name   string
+	routes []route
+}{
+	{"github", githubAPI},
+	{"large", largeRouteSet(5000)},
+}
+
+// benchTreeImpls are the tree implementations the benchmarks compare.
+var benchTreeImpls = []struct {
+	name  string
+	setup func(*Engine)
+}{
+	{"tree", func(*Engine) {}},
+	{"frozen", func(engine *Engine) { engine.Freeze() }},
+	{"fastpath", func(engine *Engine) { engine.EnableStaticFastPath = true }},
+}
+
+// examplePath returns a request path matching the route path, with fixed param values.
+func examplePath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		switch {
+		case strings.HasPrefix(segment, ":"):
+			segments[i] = "value"
+		case strings.HasPrefix(segment, "*"):
+			segments[i] = "some/file.txt"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+func newBenchEngine(routes []route, setup func(*Engine)) *Engine {
+	SetMode(ReleaseMode)
+	defer SetMode(TestMode)
+	engine := New()
+	for _, r := range routes {
+		engine.Handle(r.method, r.path, func(c *Context) {})
+	}
+	setup(engine)
+	return engine
+}
+
+func BenchmarkTreeMatch(b *testing.B) {
+	for _, set := range benchRouteSets {
+		for _, impl := range benchTreeImpls {
+			b.Run(set.name+"/"+impl.name, func(b *testing.B) {
+				engine := newBenchEngine(set.routes, impl.setup)
+				paths := make([]string, len(set.routes))
+				roots := make([]*node, len(set.routes))
+				for i, r := range set.routes {
+					paths[i] = examplePath(r.path)
+					roots[i] = engine.trees.get(r.method)
+				}
+				params := make(Params, 0, engine.maxParams)
+				skippedNodes := make([]skippedNode, 0, engine.maxSections)
+
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					j := i % len(paths)
+					params = params[:0]
+					if value := roots[j].getValue(paths[j], &params, &skippedNodes, false); value.handlers == nil {
+						b.Fatalf("no match for %s", paths[j])
+					}
+				}
+			})
+		}
+	}
+}
+
+func BenchmarkTreeServe(b *testing.B) {
+	for _, set := range benchRouteSets {
+		for _, impl := range benchTreeImpls {
+			b.Run(set.name+"/"+impl.name, func(b *testing.B) {
+				engine := newBenchEngine(set.routes, impl.setup)
+				requests := make([]*http.Request, len(set.routes))
+				for i, r := range set.routes {
+					requests[i], _ = http.NewRequest(r.method, examplePath(r.path), nil)
+				}
+				w := newMockWriter()
+
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					engine.ServeHTTP(w, requests[i%len(requests)])
+				}
+			})
+		}
+	}
+}
+
+func TestLargeRouteSet(t *testing.T) {
+	routes := largeRouteSet(1000)
+	engine := newBenchEngine(routes, func(*Engine) {})
+	for _, r := range routes {
+		w := PerformRequest(engine, r.method, examplePath(r.path))
+		if w.Code != http.StatusOK {
+			t.Errorf("%s %s: status %d", r.method, r.path, w.Code)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"strings"
+	"testing"
+)
+
+// fuzzAddRoute adds path to the tree and returns the value it panicked with, if any.
+func fuzzAddRoute(tree *node, path string) (recovered any) {
+	defer func() {
+		recovered = recover()
+	}()
+	tree.addRoute(path, fakeHandler(path))
+	return nil
+}
+
+// fuzzLookup checks that path can be looked up in the tree without panicking, and returns
+// the full path of the matched route. Like the request paths, path must begin with '/'.
+func fuzzLookup(t *testing.T, tree *node, path string) string {
+	if path == "" || path[0] != '/' {
+		return ""
+	}
+	params := make(Params, 0, 20)
+	skippedNodes := make([]skippedNode, 0, 20)
+	value := tree.getValue(path, &params, &skippedNodes, false)
+	if ciPath, found := tree.findCaseInsensitivePath(path, true); found && len(ciPath) == 0 {
+		t.Errorf("empty case insensitive path found for %q", path)
+	}
+	if value.handlers == nil {
+		return ""
+	}
+	return value.fullPath
+}
+
+func FuzzTreeAddRoute(f *testing.F) {
+	f.Add("/users/:id", "/users/new")
+	f.Add("/static/*filepath", "/static/js")
+	f.Add("/src/*filepath", "/search/:query")
+	f.Add("/:a/:b", "/a/b/")
+	f.Add("/users/:id:name", "/users/")
+
+	f.Fuzz(func(t *testing.T, first, second string) {
+		tree := &node{fullPath: "/"}
+		for _, path := range []string{first, second} {
+			err := ValidatePath(path)
+			if path == "" || path[0] != '/' {
+				continue
+			}
+			recovered := fuzzAddRoute(tree, path)
+			if err != nil && recovered == nil {
+				t.Fatalf("%q was added despite %v", path, err)
+			}
+			if recovered != nil {
+				continue
+			}
+			if !strings.ContainsAny(path, ":*") {
+				if fullPath := fuzzLookup(t, tree, path); fullPath != path {
+					t.Fatalf("%q matched %q", path, fullPath)
+				}
+			}
+		}
+		fuzzLookup(t, tree, first+second)
+		fuzzLookup(t, tree, strings.ToUpper(first))
+	})
+}
+
+func FuzzTreeGetValue(f *testing.F) {
+	tree := &node{fullPath: "/"}
+	for _, r := range githubAPI {
+		if r.method == "GET" {
+			tree.addRoute(r.path, fakeHandler(r.path))
+		}
+	}
+	tree.addRoute("/static/*filepath", fakeHandler("/static/*filepath"))
+	for _, r := range githubAPI[:20] {
+		f.Add(r.path)
+	}
+	f.Add("/static/css/app.css")
+	f.Add("/USERS/gin-gonic/REPOS/")
+
+	f.Fuzz(func(t *testing.T, path string) {
+		fuzzLookup(t, tree, path)
+	})
+}
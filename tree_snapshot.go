@@ -0,0 +1,162 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bufio"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// TreeNode is a node of a routing tree, see Engine.TreeSnapshot.
+type TreeNode struct {
+	// Path is the segment of the path the node matches, e.g. "users/" or ":id".
+	Path string `json:"path"`
+
+	// Type is the type of the node: "static", "root", "param" or "catchAll".
+	Type string `json:"type"`
+
+	// Priority is the number of routes registered below the node, which orders the lookups.
+	Priority uint32 `json:"priority"`
+
+	// FullPath is the path template of the route of the node, for the nodes holding one.
+	FullPath string `json:"full_path,omitempty"`
+
+	// Handlers are the names of the handlers of the route, middleware first.
+	Handlers []string `json:"handlers,omitempty"`
+
+	// Alternatives are the names of the handlers of the other routes sharing the path with
+	// constraints, see RouterGroup.HandleWithConstraints, in the order they are tried after
+	// the route of Handlers.
+	Alternatives [][]string `json:"alternatives,omitempty"`
+
+	// Children are the child nodes, the wildcard child last.
+	Children []*TreeNode `json:"children,omitempty"`
+}
+
+// MethodTreeSnapshot is the routing tree of a method.
+type MethodTreeSnapshot struct {
+	// Method is the method of the routes of the tree.
+	Method string `json:"method"`
+
+	// Host is the host pattern of the routes of the tree, for the routes registered with
+	// Engine.Host.
+	Host string `json:"host,omitempty"`
+
+	// Root is the root node of the tree.
+	Root *TreeNode `json:"root"`
+}
+
+// TreeSnapshot is a copy of the routing trees of an Engine, serializable as JSON or
+// Graphviz DOT, to document or debug the routing.
+type TreeSnapshot []MethodTreeSnapshot
+
+var nodeTypeNames = [...]string{
+	0:        "static",
+	root:     "root",
+	param:    "param",
+	catchAll: "catchAll",
+}
+
+// TreeSnapshot returns a copy of the routing trees, one per method.
+//
+//	router.GET("/debug/routes.json", func(c *gin.Context) {
+//		c.JSON(http.StatusOK, router.TreeSnapshot())
+//	})
+func (engine *Engine) TreeSnapshot() TreeSnapshot {
+	snapshot := make(TreeSnapshot, 0, len(engine.trees))
+	for _, tree := range engine.trees {
+		method, host := splitHostMethod(tree.method)
+		snapshot = append(snapshot, MethodTreeSnapshot{
+			Method: method,
+			Host:   host,
+			Root:   engine.snapshotNode(tree.method, tree.root),
+		})
+	}
+	return snapshot
+}
+
+func (engine *Engine) snapshotNode(method string, n *node) *TreeNode {
+	tn := &TreeNode{
+		Path:     n.path,
+		Type:     nodeTypeNames[n.nType],
+		Priority: n.priority,
+	}
+	if len(n.handlers) > 0 {
+		tn.FullPath = n.fullPath
+		tn.Handlers = engine.handlerNamesOf(n.handlers)
+		if routes, ok := engine.constraints[constraintKey{method: method, path: n.fullPath}]; ok {
+			tn.Handlers = engine.handlerNamesOf(routes[0].handlers)
+			for _, route := range routes[1:] {
+				tn.Alternatives = append(tn.Alternatives, engine.handlerNamesOf(route.handlers))
+			}
+		}
+	}
+	if len(n.children) > 0 {
+		tn.Children = make([]*TreeNode, len(n.children))
+		for i, child := range n.children {
+			tn.Children[i] = engine.snapshotNode(method, child)
+		}
+	}
+	return tn
+}
+
+func (engine *Engine) handlerNamesOf(handlers HandlersChain) []string {
+	names := make([]string, len(handlers))
+	for i, handler := range handlers {
+		names[i] = engine.HandlerName(handler)
+	}
+	return names
+}
+
+// WriteDOT writes the trees as a Graphviz DOT graph, one cluster per tree, the nodes holding
+// a route being labelled with its main handler, and those of its alternatives:
+//
+//	router.TreeSnapshot().WriteDOT(file) // then: dot -Tsvg routes.dot -o routes.svg
+func (snapshot TreeSnapshot) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("digraph routes {\n\trankdir=LR;\n\tnode [shape=box, fontname=monospace];\n")
+	id := 0
+	for i, tree := range snapshot {
+		label := tree.Method
+		if tree.Host != "" {
+			label += " " + tree.Host
+		}
+		bw.WriteString("\tsubgraph cluster_" + strconv.Itoa(i) + " {\n\t\tlabel=" + dotQuote(label) + ";\n")
+		if tree.Root != nil {
+			writeDOTNode(bw, tree.Root, &id)
+		}
+		bw.WriteString("\t}\n")
+	}
+	bw.WriteString("}\n")
+	return bw.Flush()
+}
+
+// writeDOTNode writes n and its children, numbered from *id, and returns the id of n.
+func writeDOTNode(bw *bufio.Writer, n *TreeNode, id *int) string {
+	name := "n" + strconv.Itoa(*id)
+	*id++
+	label, attrs := n.Path, ""
+	if len(n.Handlers) > 0 {
+		label += "\n" + n.Handlers[len(n.Handlers)-1]
+		attrs = ", style=bold"
+	}
+	for _, handlers := range n.Alternatives {
+		label += "\n" + handlers[len(handlers)-1]
+	}
+	bw.WriteString("\t\t" + name + " [label=" + dotQuote(label) + attrs + "];\n")
+	for _, child := range n.Children {
+		childName := writeDOTNode(bw, child, id)
+		bw.WriteString("\t\t" + name + " -> " + childName + ";\n")
+	}
+	return name
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
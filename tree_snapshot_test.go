@@ -0,0 +1,81 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEngineTreeSnapshot(t *testing.T) {
+	router := New()
+	router.GET("/users/:id", handlerNameTest)
+	router.GET("/users/new", handlerNameTest2)
+	router.GET("/files/*path", handlerNameTest)
+	router.Host("api.example.com").POST("/users", handlerNameTest)
+
+	snapshot := router.TreeSnapshot()
+	assert.Len(t, snapshot, 2)
+	assert.Equal(t, http.MethodGet, snapshot[0].Method)
+	assert.Empty(t, snapshot[0].Host)
+	assert.Equal(t, "api.example.com", snapshot[1].Host)
+
+	data, err := json.Marshal(snapshot[0].Root)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"path": "/", "type": "root", "priority": 3,
+		"children": [
+			{"path": "users/", "type": "static", "priority": 2, "children": [
+				{"path": "new", "type": "static", "priority": 1, "full_path": "/users/new",
+					"handlers": ["github.com/gin-gonic/gin.handlerNameTest2"]},
+				{"path": ":id", "type": "param", "priority": 1, "full_path": "/users/:id",
+					"handlers": ["github.com/gin-gonic/gin.handlerNameTest"]}
+			]},
+			{"path": "files", "type": "static", "priority": 1, "children": [
+				{"path": "", "type": "catchAll", "priority": 1, "children": [
+					{"path": "/*path", "type": "catchAll", "priority": 1, "full_path": "/files/*path",
+						"handlers": ["github.com/gin-gonic/gin.handlerNameTest"]}
+				]}
+			]}
+		]
+	}`, string(data))
+}
+
+func TestTreeSnapshotWriteDOT(t *testing.T) {
+	router := New()
+	router.GET("/users/:id", handlerNameTest)
+	router.Host("api.example.com").POST(`/say/"hi"`, handlerNameTest2)
+
+	var buf bytes.Buffer
+	assert.NoError(t, router.TreeSnapshot().WriteDOT(&buf))
+	assert.Equal(t, `digraph routes {
+	rankdir=LR;
+	node [shape=box, fontname=monospace];
+	subgraph cluster_0 {
+		label="GET";
+		n0 [label="/users/"];
+		n1 [label=":id\ngithub.com/gin-gonic/gin.handlerNameTest", style=bold];
+		n0 -> n1;
+	}
+	subgraph cluster_1 {
+		label="POST api.example.com";
+		n2 [label="/say/\"hi\"\ngithub.com/gin-gonic/gin.handlerNameTest2", style=bold];
+	}
+}
+`, buf.String())
+
+	assert.Error(t, router.TreeSnapshot().WriteDOT(errorWriter{}))
+}
+
+type errorWriter struct{}
+
+func (errorWriter) Write([]byte) (int, error) {
+	return 0, errors.New("write failed")
+}
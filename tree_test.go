@@ -135,6 +135,52 @@ func TestTreeAddAndGet(t *testing.T) {
 	checkPriorities(t, tree)
 }
 
+func checkChildIndex(t *testing.T, n *node) {
+	if len(n.indices) < childIndexThreshold {
+		if n.childIndex != nil {
+			t.Errorf("unexpected child index for node '%s'", n.path)
+		}
+	} else if n.childIndex == nil {
+		t.Errorf("missing child index for node '%s'", n.path)
+	} else {
+		for i := 0; i < len(n.indices); i++ {
+			if pos := n.childIndex[n.indices[i]]; pos != uint16(i+1) {
+				t.Errorf("child index mismatch for node '%s' and char '%c': is %d, should be %d", n.path, n.indices[i], pos, i+1)
+			}
+		}
+	}
+	for _, child := range n.children {
+		checkChildIndex(t, child)
+	}
+}
+
+func TestTreeChildIndex(t *testing.T) {
+	tree := &node{}
+
+	routes := []string{"/api/:name"}
+	for c := 'a'; c <= 'p'; c++ {
+		routes = append(routes, "/api/"+string(c)+"/item", "/api/"+string(c)+string(c))
+	}
+	// splits the edge of the indexed node
+	routes = append(routes, "/ap")
+	for _, route := range routes {
+		tree.addRoute(route, fakeHandler(route))
+	}
+
+	checkChildIndex(t, tree)
+	checkPriorities(t, tree)
+
+	checkRequests(t, tree, testRequests{
+		{"/ap", false, "/ap", nil},
+		{"/api/a/item", false, "/api/a/item", nil},
+		{"/api/pp", false, "/api/pp", nil},
+		{"/api/h/item", false, "/api/h/item", nil},
+		{"/api/gopher", false, "/api/:name", Params{Param{"name", "gopher"}}},
+		{"/api/a", false, "/api/:name", Params{Param{"name", "a"}}},
+		{"/api/z/item", true, "", Params{Param{"name", "z"}}},
+	})
+}
+
 func TestTreeWildcard(t *testing.T) {
 	tree := &node{}
 
@@ -871,17 +917,17 @@ func TestTreeInvalidNodeType(t *testing.T) {
 	}
 }
 
-func TestTreeInvalidParamsType(t *testing.T) {
+func TestTreeParamsWithoutCapacity(t *testing.T) {
 	tree := &node{}
-	tree.wildChild = true
-	tree.children = append(tree.children, &node{})
-	tree.children[0].nType = 2
+	tree.addRoute("/:name/*path", fakeHandler("/:name/*path"))
 
-	// set invalid Params type
+	// the params are recorded even though no capacity was preallocated
 	params := make(Params, 0)
-
-	// try to trigger slice bounds out of range with capacity 0
-	tree.getValue("/test", &params, getSkippedNodes(), false)
+	value := tree.getValue("/test/a/b", &params, getSkippedNodes(), false)
+	want := Params{{Key: "name", Value: "test"}, {Key: "path", Value: "/a/b"}}
+	if value.params == nil || !reflect.DeepEqual(*value.params, want) {
+		t.Fatalf("params mismatch: got %v, want %v", params, want)
+	}
 }
 
 func TestTreeWildcardConflictEx(t *testing.T) {
@@ -921,3 +967,27 @@ func TestTreeWildcardConflictEx(t *testing.T) {
 		}
 	}
 }
+
+func TestTreeFindCaseInsensitivePathWildcardSibling(t *testing.T) {
+	tree := &node{}
+	for _, route := range []string{"/users/:id", "/users/new", "/users/:id/posts", "/files/:name", "/files/index"} {
+		tree.addRoute(route, fakeHandler(route))
+	}
+
+	tests := []struct {
+		in, out string
+	}{
+		{"/USERS/NEW", "/users/new"},
+		{"/USERS/Bob", "/users/Bob"},
+		{"/Users/Bob/POSTS", "/users/Bob/posts"},
+		{"/Users/Bob/posts/", "/users/Bob/posts"},
+		{"/FILES/INDEX", "/files/index"},
+		{"/FILES/Readme", "/files/Readme"},
+	}
+	for _, test := range tests {
+		out, found := tree.findCaseInsensitivePath(test.in, true)
+		if !found || string(out) != test.out {
+			t.Errorf("Wrong result for '%s': got %s (%t); want %s", test.in, string(out), found, test.out)
+		}
+	}
+}
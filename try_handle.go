@@ -0,0 +1,77 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// RouteError is returned by RouterGroup.TryHandle when a route can not be registered.
+type RouteError struct {
+	Method string
+	Path   string
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *RouteError) Error() string {
+	return "can not register " + e.Method + " " + e.Path + ": " + e.Reason
+}
+
+// TryHandle is like Handle, but returns a *RouteError instead of panicking when the route
+// can not be registered, e.g. because of a misplaced wildcard, a conflict with another
+// route or a missing handler, for the servers registering routes from a config at runtime.
+// The routes already registered are left untouched.
+func (group *RouterGroup) TryHandle(httpMethod, relativePath string, handlers ...HandlerFunc) (routes IRoutes, err error) {
+	absolutePath := group.calculateAbsolutePath(relativePath)
+	fail := func(reason string) error {
+		return &RouteError{Method: httpMethod, Path: absolutePath, Reason: reason}
+	}
+	switch {
+	case !regEnLetter.MatchString(httpMethod):
+		return nil, fail("http method is not valid")
+	case len(handlers) == 0:
+		return nil, fail("there must be at least one handler")
+	case group.engine.frozen:
+		return nil, fail("routes can not be added once the engine is frozen")
+	}
+	if err := ValidatePath(absolutePath); err != nil {
+		return nil, fail(err.Error())
+	}
+
+	// the remaining errors, such as conflicts, are raised before the tree is modified
+	defer func() {
+		if r := recover(); r != nil {
+			routes, err = nil, fail(fmt.Sprint(r))
+		}
+	}()
+	return group.handle(httpMethod, relativePath, handlers), nil
+}
+
+// TryGET is a shortcut for router.TryHandle("GET", path, handle).
+func (group *RouterGroup) TryGET(relativePath string, handlers ...HandlerFunc) (IRoutes, error) {
+	return group.TryHandle(http.MethodGet, relativePath, handlers...)
+}
+
+// TryPOST is a shortcut for router.TryHandle("POST", path, handle).
+func (group *RouterGroup) TryPOST(relativePath string, handlers ...HandlerFunc) (IRoutes, error) {
+	return group.TryHandle(http.MethodPost, relativePath, handlers...)
+}
+
+// TryPUT is a shortcut for router.TryHandle("PUT", path, handle).
+func (group *RouterGroup) TryPUT(relativePath string, handlers ...HandlerFunc) (IRoutes, error) {
+	return group.TryHandle(http.MethodPut, relativePath, handlers...)
+}
+
+// TryPATCH is a shortcut for router.TryHandle("PATCH", path, handle).
+func (group *RouterGroup) TryPATCH(relativePath string, handlers ...HandlerFunc) (IRoutes, error) {
+	return group.TryHandle(http.MethodPatch, relativePath, handlers...)
+}
+
+// TryDELETE is a shortcut for router.TryHandle("DELETE", path, handle).
+func (group *RouterGroup) TryDELETE(relativePath string, handlers ...HandlerFunc) (IRoutes, error) {
+	return group.TryHandle(http.MethodDelete, relativePath, handlers...)
+}
@@ -0,0 +1,52 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTryHandle(t *testing.T) {
+	router := New()
+	api := router.Group("/api", func(c *Context) {})
+
+	routes, err := api.TryGET("/users/:id", func(c *Context) {
+		c.String(http.StatusOK, "%s", c.Param("id"))
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, api, routes)
+
+	for _, test := range []struct {
+		method, path, reason string
+		handlers             []HandlerFunc
+	}{
+		{"GET", "/users/:", "wildcards must be named with a non-empty name in path '/api/users/:'", []HandlerFunc{func(*Context) {}}},
+		{"GET", "/users/:name", "':name' in new path '/api/users/:name' conflicts with existing wildcard ':id' in existing prefix '/api/users/:id'", []HandlerFunc{func(*Context) {}}},
+		{"GET", "/users/:id", "handlers are already registered for path '/api/users/:id'", []HandlerFunc{func(*Context) {}}},
+		{"get me", "/users", "http method is not valid", []HandlerFunc{func(*Context) {}}},
+		{"POST", "/users", "there must be at least one handler", nil},
+	} {
+		routes, err := api.TryHandle(test.method, test.path, test.handlers...)
+		assert.Nil(t, routes)
+		if assert.IsType(t, &RouteError{}, err) {
+			assert.Equal(t, test.reason, err.(*RouteError).Reason)
+			assert.Equal(t, "/api"+test.path, err.(*RouteError).Path)
+		}
+	}
+	assert.EqualError(t, func() error { _, err := router.TryPOST("/a/*b/c", func(*Context) {}); return err }(),
+		"can not register POST /a/*b/c: catch-all routes are only allowed at the end of the path in path '/a/*b/c'")
+
+	// the failed registrations left the routes untouched
+	assert.Len(t, router.Routes(), 1)
+	w := PerformRequest(router, http.MethodGet, "/api/users/42")
+	assert.Equal(t, "42", w.Body.String())
+
+	router.Freeze()
+	_, err = router.TryDELETE("/users", func(*Context) {})
+	assert.EqualError(t, err, "can not register DELETE /users: routes can not be added once the engine is frozen")
+}
@@ -0,0 +1,147 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"reflect"
+	"sort"
+
+	"github.com/gin-gonic/gin/binding"
+)
+
+// RouteTypesMetaKey is the route metadata key holding the RouteTypes of the routes
+// registered with HandleTyped.
+const RouteTypesMetaKey = "_gin-gonic/gin/types"
+
+// RouteTypes are the request and response types of a typed route.
+type RouteTypes struct {
+	Request  reflect.Type
+	Response reflect.Type
+}
+
+// HandleTyped registers a route served by a typed function, after middleware: the request
+// is bound into a Req from the path params, with the uri tags, and from the query or the
+// body, as ShouldBind does, the binding errors aborting the request with a 400. The result
+// is written as JSON with a 200, the errors being reported with Context.Fail. The types of
+// the route are recorded in its metadata, see Engine.RouteSpecs, for the code generators
+// emitting clients.
+//
+//	gin.HandleTyped(router, http.MethodGet, "/users/:id", func(c *gin.Context, req GetUserRequest) (*User, error) {
+//		return store.User(c, req.ID)
+//	})
+func HandleTyped[Req, Resp any](group *RouterGroup, httpMethod, relativePath string, f func(c *Context, req Req) (Resp, error), middleware ...HandlerFunc) IRoutes {
+	assert1(f != nil, "typed handler can not be nil")
+	types := RouteTypes{
+		Request:  reflect.TypeOf((*Req)(nil)).Elem(),
+		Response: reflect.TypeOf((*Resp)(nil)).Elem(),
+	}
+	handler := func(c *Context) {
+		var req Req
+		if err := bindTyped(c, &req); err != nil {
+			c.AbortWithError(http.StatusBadRequest, err).SetType(ErrorTypeBind) // nolint: errcheck
+			return
+		}
+		resp, err := f(c, req)
+		if err != nil {
+			c.Fail(err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+
+	handlers := make(HandlersChain, 0, len(middleware)+1)
+	handlers = append(handlers, middleware...)
+	handlers = append(handlers, handler)
+	return group.WithMeta(RouteTypesMetaKey, types).Handle(httpMethod, relativePath, handlers...)
+}
+
+// bindTyped binds the request into req, see HandleTyped. The path params are mapped
+// first, without validation, so that req is validated once complete.
+func bindTyped(c *Context, req any) error {
+	if reflect.TypeOf(req).Elem().Kind() != reflect.Struct {
+		if c.Request.ContentLength == 0 {
+			return nil
+		}
+		return c.ShouldBind(req)
+	}
+	if len(c.Params) > 0 {
+		params := make(map[string][]string, len(c.Params))
+		for _, p := range c.Params {
+			params[p.Key] = []string{p.Value}
+		}
+		if err := binding.MapFormWithTag(req, params, "uri"); err != nil {
+			return err
+		}
+	}
+	if c.Request.Body == nil || c.Request.Body == http.NoBody || c.Request.ContentLength == 0 {
+		return c.ShouldBindQuery(req)
+	}
+	return c.ShouldBind(req)
+}
+
+// RouteParam is a path param of a route.
+type RouteParam struct {
+	Name string
+	// CatchAll is true for the *name params, matching the rest of the path.
+	CatchAll bool
+}
+
+// RouteSpec describes a registered route for the tools generating code from the routes of
+// an engine, e.g. typed clients or server stubs, without parsing Go source.
+type RouteSpec struct {
+	Method string
+	Path   string
+	// Name is the name of the route, see RouterGroup.Named, if any.
+	Name string
+	// Params are the path params, in the order of the path.
+	Params []RouteParam
+	// Request and Response are the types of the routes registered with HandleTyped, nil
+	// for the others.
+	Request  reflect.Type
+	Response reflect.Type
+	// Meta is the metadata of the route, see RouterGroup.WithMeta.
+	Meta map[string]any
+}
+
+// RouteSpecs returns the specs of the registered routes, sorted by path and method.
+func (engine *Engine) RouteSpecs() []RouteSpec {
+	routes := engine.Routes()
+	specs := make([]RouteSpec, 0, len(routes))
+	for _, route := range routes {
+		spec := RouteSpec{
+			Method: route.Method,
+			Path:   route.Path,
+			Params: routeParams(route.Path),
+			Meta:   route.Meta,
+		}
+		spec.Name, _ = route.Meta[RouteNameMetaKey].(string)
+		if types, ok := route.Meta[RouteTypesMetaKey].(RouteTypes); ok {
+			spec.Request, spec.Response = types.Request, types.Response
+		}
+		specs = append(specs, spec)
+	}
+	sort.Slice(specs, func(i, j int) bool {
+		if specs[i].Path != specs[j].Path {
+			return specs[i].Path < specs[j].Path
+		}
+		return specs[i].Method < specs[j].Method
+	})
+	return specs
+}
+
+// routeParams returns the params of the route path.
+func routeParams(path string) []RouteParam {
+	var params []RouteParam
+	for len(path) > 0 {
+		wildcard, i, _ := findWildcard(path)
+		if i < 0 {
+			break
+		}
+		params = append(params, RouteParam{Name: wildcard[1:], CatchAll: wildcard[0] == '*'})
+		path = path[i+len(wildcard):]
+	}
+	return params
+}
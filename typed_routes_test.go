@@ -0,0 +1,117 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type typedGetBook struct {
+	ID     string `uri:"id" binding:"required"`
+	Fields string `form:"fields"`
+}
+
+type typedCreateBook struct {
+	ShelfID string `uri:"shelf"`
+	Title   string `json:"title" binding:"required"`
+}
+
+type typedBook struct {
+	ID    string `json:"id"`
+	Shelf string `json:"shelf,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
+var errTypedNotFound = errors.New("book not found")
+
+func TestHandleTyped(t *testing.T) {
+	router := New()
+	router.MapError(errTypedNotFound, http.StatusNotFound)
+	HandleTyped(router.Group("/books"), http.MethodGet, "/:id", func(c *Context, req typedGetBook) (typedBook, error) {
+		if req.ID == "missing" {
+			return typedBook{}, errTypedNotFound
+		}
+		return typedBook{ID: req.ID, Title: req.Fields}, nil
+	})
+	HandleTyped(&router.RouterGroup, http.MethodPost, "/shelves/:shelf/books", func(c *Context, req typedCreateBook) (*typedBook, error) {
+		return &typedBook{ID: "1", Shelf: req.ShelfID, Title: req.Title}, nil
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/books/42?fields=title")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"id":"42","title":"title"}`, w.Body.String())
+
+	w = PerformRequest(router, http.MethodGet, "/books/missing")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	w = httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/shelves/s1/books", strings.NewReader(`{"title":"Dune"}`))
+	req.Header.Set("Content-Type", MIMEJSON)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{"id":"1","shelf":"s1","title":"Dune"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodPost, "/shelves/s1/books", strings.NewReader(`{}`))
+	req.Header.Set("Content-Type", MIMEJSON)
+	router.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = PerformRequest(router, http.MethodPost, "/shelves/s1/books")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestHandleTypedMiddleware(t *testing.T) {
+	router := New()
+	var calls []string
+	HandleTyped(&router.RouterGroup, http.MethodGet, "/ping", func(c *Context, req struct{}) (string, error) {
+		calls = append(calls, "handler")
+		return "pong", nil
+	}, func(c *Context) {
+		calls = append(calls, "middleware")
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/ping")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `"pong"`, w.Body.String())
+	assert.Equal(t, []string{"middleware", "handler"}, calls)
+}
+
+func TestEngineRouteSpecs(t *testing.T) {
+	router := New()
+	router.Named("file").GET("/files/*path", func(c *Context) {})
+	HandleTyped(router.WithMeta("audit", true), http.MethodGet, "/books/:id", func(c *Context, req typedGetBook) (typedBook, error) {
+		return typedBook{}, nil
+	})
+	HandleTyped(&router.RouterGroup, http.MethodDelete, "/books/:id", func(c *Context, req typedGetBook) (struct{}, error) {
+		return struct{}{}, nil
+	})
+
+	specs := router.RouteSpecs()
+	assert.Len(t, specs, 3)
+
+	assert.Equal(t, http.MethodDelete, specs[0].Method)
+	assert.Equal(t, "/books/:id", specs[0].Path)
+	assert.Equal(t, reflect.TypeOf(struct{}{}), specs[0].Response)
+
+	assert.Equal(t, http.MethodGet, specs[1].Method)
+	assert.Equal(t, []RouteParam{{Name: "id"}}, specs[1].Params)
+	assert.Equal(t, reflect.TypeOf(typedGetBook{}), specs[1].Request)
+	assert.Equal(t, reflect.TypeOf(typedBook{}), specs[1].Response)
+	assert.Equal(t, true, specs[1].Meta["audit"])
+
+	assert.Equal(t, "/files/*path", specs[2].Path)
+	assert.Equal(t, "file", specs[2].Name)
+	assert.Equal(t, []RouteParam{{Name: "path", CatchAll: true}}, specs[2].Params)
+	assert.Nil(t, specs[2].Request)
+	assert.Nil(t, specs[2].Response)
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+//go:build !js && !wasip1 && !plan9
+// +build !js,!wasip1,!plan9
+
+package gin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const (
+	upgradeListenerEnv = "GIN_UPGRADE_LISTENER_FD"
+	upgradeReadyEnv    = "GIN_UPGRADE_READY_FD"
+	upgradeTimeout     = 30 * time.Second
+)
+
+// inheritedFile returns the file whose descriptor is set in the env variable key, if any,
+// and unsets it so that it is not passed down again.
+func inheritedFile(key string) (*os.File, error) {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return nil, nil
+	}
+	os.Unsetenv(key)
+	fd, err := strconv.Atoi(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s %q: %w", key, value, err)
+	}
+	return os.NewFile(uintptr(fd), fmt.Sprintf("fd@%d", fd)), nil
+}
+
+// upgradableListener returns the listener inherited from the parent process, or a new one.
+func upgradableListener(addr string) (net.Listener, error) {
+	f, err := inheritedFile(upgradeListenerEnv)
+	if err != nil {
+		return nil, err
+	}
+	if f == nil {
+		return net.Listen("tcp", addr)
+	}
+	defer f.Close()
+	return net.FileListener(f)
+}
+
+// notifyReady tells the parent process, if any, that the listener is served.
+func notifyReady() error {
+	f, err := inheritedFile(upgradeReadyEnv)
+	if err != nil || f == nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write([]byte{1})
+	return err
+}
+
+// upgrade starts a new process of the current binary inheriting the listener, and waits
+// until it serves the requests.
+func upgrade(listener net.Listener) error {
+	filer, ok := listener.(interface{ File() (*os.File, error) })
+	if !ok {
+		return errors.New("listener can not be passed to a new process")
+	}
+	lf, err := filer.File()
+	if err != nil {
+		return err
+	}
+	defer lf.Close()
+	readyR, readyW, err := os.Pipe()
+	if err != nil {
+		return err
+	}
+	defer readyR.Close()
+
+	executable, err := os.Executable()
+	if err != nil {
+		readyW.Close()
+		return err
+	}
+	cmd := exec.Command(executable, os.Args[1:]...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	cmd.ExtraFiles = []*os.File{lf, readyW}
+	cmd.Env = append(os.Environ(), upgradeListenerEnv+"=3", upgradeReadyEnv+"=4")
+	err = cmd.Start()
+	readyW.Close()
+	if err != nil {
+		return err
+	}
+
+	ready := make(chan error, 1)
+	go func() {
+		_, err := readyR.Read(make([]byte, 1))
+		ready <- err
+	}()
+	select {
+	case err = <-ready:
+		if err == nil {
+			return nil
+		}
+		err = fmt.Errorf("new process exited before serving: %w", err)
+	case <-time.After(upgradeTimeout):
+		err = errors.New("new process did not serve in time")
+	}
+	cmd.Process.Kill() // nolint: errcheck
+	cmd.Wait()         // nolint: errcheck
+	return err
+}
+
+// serveUpgradable serves listener until a stop signal is received, or an upgrade signal
+// once a new process took over the listener, then shuts down gracefully.
+func (engine *Engine) serveUpgradable(listener net.Listener, signals <-chan os.Signal, upgrade func(net.Listener) error) error {
+	srv := engine.newServer(listener.Addr().String())
+	served := make(chan error, 1)
+	go func() { served <- srv.Serve(listener) }()
+	if err := notifyReady(); err != nil {
+		debugPrint("[WARNING] Failed to notify the parent process: %v\n", err)
+	}
+
+	for {
+		select {
+		case err := <-served:
+			return err
+		case sig := <-signals:
+			if sig == syscall.SIGHUP {
+				if err := upgrade(listener); err != nil {
+					debugPrint("[WARNING] Upgrade failed, still serving: %v\n", err)
+					continue
+				}
+				debugPrint("Upgraded, shutting down the old process\n")
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), upgradeTimeout)
+			err := srv.Shutdown(ctx)
+			cancel()
+			<-served
+			return err
+		}
+	}
+}
+
+// RunUpgradable attaches the router to a http.Server and starts listening and serving HTTP
+// requests on addr like Run, but allows replacing the binary without dropping connections:
+// on SIGHUP, the current executable is started again and inherits the listening socket, and
+// once it serves the requests, the old process shuts down gracefully and RunUpgradable
+// returns nil. If the new process fails to start, the old one keeps serving. SIGINT and
+// SIGTERM shut down gracefully. The listener is only inherited on platforms supporting it.
+// Note: this method will block the calling goroutine until the process is upgraded or
+// stopped, or an error happens.
+func (engine *Engine) RunUpgradable(addr string) (err error) {
+	defer func() { debugPrintError(err) }()
+
+	if engine.isUnsafeTrustedProxies() {
+		debugPrint("[WARNING] You trusted all proxies, this is NOT safe. We recommend you to set a value.\n" +
+			"Please check https://pkg.go.dev/github.com/gin-gonic/gin#readme-don-t-trust-all-proxies for details.")
+	}
+
+	listener, err := upgradableListener(addr)
+	if err != nil {
+		return
+	}
+	debugPrint("Listening and serving HTTP on %s (upgradable, pid %d)\n", listener.Addr(), os.Getpid())
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
+	err = engine.serveUpgradable(listener, signals, upgrade)
+	return
+}
@@ -0,0 +1,13 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+//go:build js || wasip1 || plan9
+// +build js wasip1 plan9
+
+package gin
+
+// RunUpgradable is like Run, the process can not be upgraded on this platform.
+func (engine *Engine) RunUpgradable(addr string) error {
+	return engine.Run(addr)
+}
@@ -0,0 +1,96 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+//go:build !windows && !js && !wasip1 && !plan9
+// +build !windows,!js,!wasip1,!plan9
+
+package gin
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpgradableListenerInherited(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	defer ln.Close()
+	f, err := ln.(*net.TCPListener).File()
+	assert.NoError(t, err)
+	// the inherited descriptor is owned by upgradableListener
+	fd, err := syscall.Dup(int(f.Fd()))
+	assert.NoError(t, err)
+	f.Close()
+
+	t.Setenv(upgradeListenerEnv, strconv.Itoa(fd))
+	inherited, err := upgradableListener("127.0.0.1:0")
+	assert.NoError(t, err)
+	defer inherited.Close()
+	assert.Equal(t, ln.Addr().String(), inherited.Addr().String())
+	_, ok := os.LookupEnv(upgradeListenerEnv)
+	assert.False(t, ok)
+
+	t.Setenv(upgradeListenerEnv, "invalid")
+	_, err = upgradableListener("127.0.0.1:0")
+	assert.Error(t, err)
+}
+
+func TestNotifyReady(t *testing.T) {
+	assert.NoError(t, notifyReady())
+
+	r, w, err := os.Pipe()
+	assert.NoError(t, err)
+	defer r.Close()
+	fd, err := syscall.Dup(int(w.Fd()))
+	assert.NoError(t, err)
+	w.Close()
+	t.Setenv(upgradeReadyEnv, strconv.Itoa(fd))
+	assert.NoError(t, notifyReady())
+
+	buf := make([]byte, 1)
+	n, err := r.Read(buf)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
+
+func TestServeUpgradable(t *testing.T) {
+	router := New()
+	router.GET("/", func(c *Context) { c.String(http.StatusOK, "it worked") })
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	url := "http://" + ln.Addr().String() + "/"
+
+	signals := make(chan os.Signal)
+	upgrades := make(chan error)
+	result := make(chan error)
+	go func() {
+		result <- router.serveUpgradable(ln, signals, func(net.Listener) error {
+			return <-upgrades
+		})
+	}()
+
+	testRequest(t, url)
+	signals <- syscall.SIGHUP
+	upgrades <- errors.New("failed")
+	testRequest(t, url)
+
+	signals <- syscall.SIGHUP
+	upgrades <- nil
+	select {
+	case err := <-result:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("old process did not shut down")
+	}
+	_, err = http.Get(url)
+	assert.Error(t, err)
+}
@@ -0,0 +1,41 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"strings"
+)
+
+// AddVary adds the request headers the response depends on to the Vary response header,
+// merging them with the ones already listed, e.g. by Negotiate and Compression, so caches
+// store a variant per value. A "*" value, meaning the response depends on more than request
+// headers, replaces all the others.
+func (c *Context) AddVary(headers ...string) {
+	for _, header := range headers {
+		addVary(c.Writer.Header(), header)
+	}
+}
+
+// addVary adds value to the Vary header, unless it is already there.
+func addVary(header http.Header, value string) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return
+	}
+	for _, vary := range header.Values("Vary") {
+		for _, v := range strings.Split(vary, ",") {
+			v = strings.TrimSpace(v)
+			if v == "*" || strings.EqualFold(v, value) {
+				return
+			}
+		}
+	}
+	if value == "*" {
+		header.Set("Vary", "*")
+		return
+	}
+	header.Add("Vary", http.CanonicalHeaderKey(value))
+}
@@ -0,0 +1,43 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextAddVary(t *testing.T) {
+	c, _ := CreateTestContext(httptest.NewRecorder())
+	c.Writer.Header().Set("Vary", "Origin")
+
+	c.AddVary("accept", "Accept-Encoding", " ")
+	c.AddVary("Accept", "origin")
+	assert.Equal(t, []string{"Origin", "Accept", "Accept-Encoding"}, c.Writer.Header().Values("Vary"))
+
+	c.AddVary("*")
+	assert.Equal(t, []string{"*"}, c.Writer.Header().Values("Vary"))
+	c.AddVary("Cookie")
+	assert.Equal(t, []string{"*"}, c.Writer.Header().Values("Vary"))
+}
+
+func TestVaryMergedBySubsystems(t *testing.T) {
+	router := New()
+	router.Use(Compression(CompressionConfig{}))
+	router.GET("/", func(c *Context) {
+		c.AddVary("Accept-Language")
+		c.Negotiate(http.StatusOK, Negotiate{Offered: []string{MIMEJSON}, Data: H{"ok": true}})
+	})
+
+	w := PerformRequest(router, http.MethodGet, "/", header{"Accept-Encoding", "gzip"})
+	assert.Equal(t, []string{"Accept-Encoding", "Accept-Language", "Accept"}, w.Header().Values("Vary"))
+
+	// the response varies by encoding even for clients not accepting gzip
+	w = PerformRequest(router, http.MethodGet, "/")
+	assert.Equal(t, []string{"Accept-Encoding", "Accept-Language", "Accept"}, w.Header().Values("Vary"))
+}
@@ -0,0 +1,61 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"path"
+	"strings"
+
+	"golang.org/x/net/webdav"
+)
+
+// webdavMethods are the HTTP methods served by MountWebDAV.
+var webdavMethods = []string{
+	http.MethodOptions, http.MethodGet, http.MethodHead, http.MethodPost,
+	http.MethodDelete, http.MethodPut, "MKCOL", "COPY", "MOVE",
+	"LOCK", "UNLOCK", "PROPFIND", "PROPPATCH",
+}
+
+// WebDAVConfig defines the config for MountWebDAV.
+type WebDAVConfig struct {
+	// LockSystem is the lock database used by LOCK and UNLOCK requests.
+	// Optional. Default value is an in-memory lock system.
+	LockSystem webdav.LockSystem
+
+	// Logger is called after every request, err is nil on success.
+	// Optional.
+	Logger func(req *http.Request, err error)
+}
+
+// MountWebDAV serves the given webdav.FileSystem under relativePath, so file-serving applications
+// don't need a second server. The handlers are executed before the WebDAV handler, e.g. for
+// authorization:
+//
+//	router.MountWebDAV("/dav", webdav.Dir("/srv/files"), gin.WebDAVConfig{}, gin.BasicAuth(accounts))
+func (group *RouterGroup) MountWebDAV(relativePath string, fs webdav.FileSystem, config WebDAVConfig, handlers ...HandlerFunc) IRoutes {
+	if strings.Contains(relativePath, ":") || strings.Contains(relativePath, "*") {
+		panic("URL parameters can not be used when mounting a WebDAV file system")
+	}
+	lockSystem := config.LockSystem
+	if lockSystem == nil {
+		lockSystem = webdav.NewMemLS()
+	}
+	dav := &webdav.Handler{
+		Prefix:     strings.TrimSuffix(group.calculateAbsolutePath(relativePath), "/"),
+		FileSystem: fs,
+		LockSystem: lockSystem,
+		Logger:     config.Logger,
+	}
+
+	chain := make(HandlersChain, 0, len(handlers)+1)
+	chain = append(chain, handlers...)
+	chain = append(chain, WrapH(dav))
+	urlPattern := path.Join(relativePath, "/*filepath")
+	for _, method := range webdavMethods {
+		group.handle(method, urlPattern, chain)
+	}
+	return group.returnObj()
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/webdav"
+)
+
+func TestMountWebDAV(t *testing.T) {
+	var logged []string
+	router := New()
+	router.Group("/files").MountWebDAV("/dav", webdav.NewMemFS(), WebDAVConfig{
+		Logger: func(req *http.Request, err error) {
+			logged = append(logged, req.Method)
+		},
+	}, BasicAuth(Accounts{"admin": "secret"}))
+
+	auth := authorizationHeader("admin", "secret")
+	perform := func(method, target, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, strings.NewReader(body))
+		req.Header.Set("Authorization", auth)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	w := PerformRequest(router, "PROPFIND", "/files/dav/")
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	w = perform("MKCOL", "/files/dav/docs", "")
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	w = perform(http.MethodPut, "/files/dav/docs/readme.txt", "hello webdav")
+	assert.Equal(t, http.StatusCreated, w.Code)
+
+	w = perform(http.MethodGet, "/files/dav/docs/readme.txt", "")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "hello webdav", w.Body.String())
+
+	w = perform("PROPFIND", "/files/dav/docs/", "")
+	assert.Equal(t, http.StatusMultiStatus, w.Code)
+	assert.Contains(t, w.Body.String(), "/files/dav/docs/readme.txt")
+
+	w = perform("MOVE", "/files/dav/docs/readme.txt", "")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	assert.Equal(t, []string{"MKCOL", "PUT", "GET", "PROPFIND", "MOVE"}, logged)
+}
+
+func TestMountWebDAVWithParams(t *testing.T) {
+	router := New()
+	assert.Panics(t, func() {
+		router.MountWebDAV("/dav/:id", webdav.NewMemFS(), WebDAVConfig{})
+	})
+}
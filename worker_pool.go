@@ -0,0 +1,280 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// WorkerPoolRejectPolicy tells which request a WorkerPool rejects when its queue is full.
+type WorkerPoolRejectPolicy int
+
+const (
+	// RejectNewest rejects the incoming request. It is the default.
+	RejectNewest WorkerPoolRejectPolicy = iota
+	// RejectOldest rejects the request queued for the longest time to queue the incoming
+	// one, favoring the clients that did not give up yet.
+	RejectOldest
+)
+
+// WorkerPoolConfig defines the config for NewWorkerPool.
+type WorkerPoolConfig struct {
+	// Workers is the number of requests handled concurrently.
+	// Optional. Default value is four times GOMAXPROCS.
+	Workers int
+
+	// QueueSize is the number of requests waiting for a worker, above which requests are
+	// rejected according to RejectPolicy. The PriorityCritical requests are queued past it.
+	// Optional. Default value is Workers.
+	QueueSize int
+
+	// QueueTimeout is the time a request waits for a worker before being rejected, the
+	// PriorityCritical requests wait until they are handled.
+	// Optional. By default, requests wait until the client goes away.
+	QueueTimeout time.Duration
+
+	// RejectPolicy tells which request is rejected when the queue is full.
+	// Optional. Default value is RejectNewest.
+	RejectPolicy WorkerPoolRejectPolicy
+
+	// RejectCode is the status code of the rejected requests.
+	// Optional. Default value is 503.
+	RejectCode int
+}
+
+// WorkerPoolStats are the counters of a WorkerPool.
+type WorkerPoolStats struct {
+	// Workers is the number of workers.
+	Workers int
+	// Busy is the number of workers handling a request.
+	Busy int
+	// Queued is the number of requests waiting for a worker.
+	Queued int
+	// Processed is the number of requests handled by the workers.
+	Processed uint64
+	// Rejected is the number of requests rejected because the queue was full, or that
+	// waited longer than the queue timeout.
+	Rejected uint64
+}
+
+type workerResult struct {
+	rejected bool
+	panicked bool
+	value    any
+}
+
+type workerJob struct {
+	c        *Context
+	priority Priority
+	done     chan workerResult
+}
+
+// WorkerPool handles the requests with a fixed number of workers fed by a bounded queue,
+// instead of a goroutine per request, so that bursts of requests queue up instead of
+// competing for the CPU and the downstream services, which smooths the latency.
+// Queued requests are handled by decreasing route priority, see RouterGroup.WithPriority,
+// and the requests with the lowest priority are rejected first when the queue is full.
+// The PriorityCritical requests are never rejected, unless the pool is closed.
+type WorkerPool struct {
+	config WorkerPoolConfig
+
+	mu     sync.Mutex
+	cond   *sync.Cond
+	queue  []*workerJob // sorted by decreasing priority, then by arrival
+	stats  WorkerPoolStats
+	closed bool
+	wg     sync.WaitGroup
+}
+
+// NewWorkerPool returns a new WorkerPool and starts its workers.
+func NewWorkerPool(config WorkerPoolConfig) *WorkerPool {
+	if config.Workers <= 0 {
+		config.Workers = 4 * runtime.GOMAXPROCS(0)
+	}
+	if config.QueueSize <= 0 {
+		config.QueueSize = config.Workers
+	}
+	if config.RejectCode == 0 {
+		config.RejectCode = http.StatusServiceUnavailable
+	}
+	p := &WorkerPool{config: config}
+	p.cond = sync.NewCond(&p.mu)
+	p.stats.Workers = config.Workers
+	p.wg.Add(config.Workers)
+	for i := 0; i < config.Workers; i++ {
+		go p.work()
+	}
+	return p
+}
+
+// Stats returns the current counters of the pool.
+func (p *WorkerPool) Stats() WorkerPoolStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	stats := p.stats
+	stats.Queued = len(p.queue)
+	return stats
+}
+
+// Close rejects the new requests and stops the workers once the queued requests are handled.
+func (p *WorkerPool) Close() {
+	p.mu.Lock()
+	p.closed = true
+	p.mu.Unlock()
+	p.cond.Broadcast()
+	p.wg.Wait()
+}
+
+func (p *WorkerPool) work() {
+	defer p.wg.Done()
+	for {
+		p.mu.Lock()
+		for len(p.queue) == 0 && !p.closed {
+			p.cond.Wait()
+		}
+		if len(p.queue) == 0 {
+			p.mu.Unlock()
+			return
+		}
+		job := p.queue[0]
+		p.queue[0] = nil
+		p.queue = p.queue[1:]
+		p.stats.Busy++
+		p.mu.Unlock()
+
+		job.done <- p.run(job.c)
+
+		p.mu.Lock()
+		p.stats.Busy--
+		p.stats.Processed++
+		p.mu.Unlock()
+	}
+}
+
+// run calls the pending handlers, catching their panic to raise it again in the request
+// goroutine, where the Recovery middleware handles it.
+func (p *WorkerPool) run(c *Context) (result workerResult) {
+	defer func() {
+		if err := recover(); err != nil {
+			result = workerResult{panicked: true, value: err}
+		}
+	}()
+	c.Next()
+	return
+}
+
+// enqueue queues job, reporting false if the pool is closed or job is rejected.
+func (p *WorkerPool) enqueue(job *workerJob) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.closed {
+		p.stats.Rejected++
+		return false
+	}
+	if len(p.queue) >= p.config.QueueSize && job.priority < PriorityCritical {
+		p.stats.Rejected++
+		victim := p.victim(job.priority)
+		if victim < 0 {
+			return false
+		}
+		p.queue[victim].done <- workerResult{rejected: true}
+		p.queue = append(p.queue[:victim], p.queue[victim+1:]...)
+	}
+	i := len(p.queue)
+	for i > 0 && p.queue[i-1].priority < job.priority {
+		i--
+	}
+	p.queue = append(p.queue, nil)
+	copy(p.queue[i+1:], p.queue[i:])
+	p.queue[i] = job
+	p.cond.Signal()
+	return true
+}
+
+// victim returns the index of the queued job to reject in favor of a job of priority, or
+// -1 to reject the latter. As priority is below PriorityCritical, the critical jobs are
+// never picked.
+func (p *WorkerPool) victim(priority Priority) int {
+	last := len(p.queue) - 1
+	lowest := p.queue[last].priority
+	switch {
+	case priority < lowest, priority == lowest && p.config.RejectPolicy != RejectOldest:
+		return -1
+	case priority > lowest && p.config.RejectPolicy != RejectOldest:
+		return last
+	}
+	i := last
+	for i > 0 && p.queue[i-1].priority == lowest {
+		i--
+	}
+	return i
+}
+
+// dequeue removes job from the queue, reporting false if a worker already picked it.
+func (p *WorkerPool) dequeue(job *workerJob) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for i, queued := range p.queue {
+		if queued == job {
+			p.queue = append(p.queue[:i], p.queue[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (p *WorkerPool) reject(c *Context) {
+	c.Header("Retry-After", "1")
+	c.AbortWithStatus(p.config.RejectCode)
+}
+
+// Middleware returns a middleware handing the pending handlers over to the workers of the
+// pool, while the request goroutine waits. Requests rejected because the queue is full or
+// that waited longer than QueueTimeout are aborted with RejectCode. Panics of the handlers
+// are raised again in the request goroutine, so register the Recovery middleware first.
+func (p *WorkerPool) Middleware() HandlerFunc {
+	return func(c *Context) {
+		job := &workerJob{c: c, priority: c.Priority(), done: make(chan workerResult, 1)}
+		if !p.enqueue(job) {
+			p.reject(c)
+			return
+		}
+
+		var timeout <-chan time.Time
+		if p.config.QueueTimeout > 0 && job.priority < PriorityCritical {
+			timer := time.NewTimer(p.config.QueueTimeout)
+			defer timer.Stop()
+			timeout = timer.C
+		}
+		var result workerResult
+		select {
+		case result = <-job.done:
+		case <-timeout:
+			if p.dequeue(job) {
+				p.mu.Lock()
+				p.stats.Rejected++
+				p.mu.Unlock()
+				p.reject(c)
+				return
+			}
+			result = <-job.done
+		case <-c.Request.Context().Done():
+			if p.dequeue(job) {
+				c.Abort()
+				return
+			}
+			result = <-job.done
+		}
+
+		if result.rejected {
+			p.reject(c)
+		} else if result.panicked {
+			panic(result.value)
+		}
+	}
+}
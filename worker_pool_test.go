@@ -0,0 +1,113 @@
+// Copyright 2026 Gin Core Team. All rights reserved.
+// Use of this source code is governed by a MIT style
+// license that can be found in the LICENSE file.
+
+package gin
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newBlockingPoolRouter returns a router whose /block requests wait for release.
+func newBlockingPoolRouter(pool *WorkerPool, release chan struct{}) *Engine {
+	router := New()
+	router.Use(Recovery(), pool.Middleware())
+	router.GET("/block", func(c *Context) {
+		<-release
+		c.String(http.StatusOK, "done")
+	})
+	router.GET("/panic", func(c *Context) {
+		panic("oops")
+	})
+	return router
+}
+
+func waitPoolStats(t *testing.T, pool *WorkerPool, busy, queued int) {
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if stats := pool.Stats(); stats.Busy == busy && stats.Queued == queued {
+			return
+		}
+	}
+	t.Fatalf("pool stats %+v, expected %d busy and %d queued", pool.Stats(), busy, queued)
+}
+
+func performAsync(router *Engine, path string) <-chan *httptest.ResponseRecorder {
+	done := make(chan *httptest.ResponseRecorder, 1)
+	go func() { done <- PerformRequest(router, http.MethodGet, path) }()
+	return done
+}
+
+func TestWorkerPoolRejectNewest(t *testing.T) {
+	pool := NewWorkerPool(WorkerPoolConfig{Workers: 1, QueueSize: 1})
+	defer pool.Close()
+	release := make(chan struct{})
+	router := newBlockingPoolRouter(pool, release)
+
+	first := performAsync(router, "/block")
+	waitPoolStats(t, pool, 1, 0)
+	second := performAsync(router, "/block")
+	waitPoolStats(t, pool, 1, 1)
+
+	w := PerformRequest(router, http.MethodGet, "/block")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, "1", w.Header().Get("Retry-After"))
+
+	close(release)
+	assert.Equal(t, http.StatusOK, (<-first).Code)
+	assert.Equal(t, "done", (<-second).Body.String())
+
+	stats := pool.Stats()
+	assert.Equal(t, WorkerPoolStats{Workers: 1, Processed: 2, Rejected: 1}, stats)
+}
+
+func TestWorkerPoolRejectOldest(t *testing.T) {
+	pool := NewWorkerPool(WorkerPoolConfig{Workers: 1, QueueSize: 1, RejectPolicy: RejectOldest, RejectCode: http.StatusTooManyRequests})
+	defer pool.Close()
+	release := make(chan struct{})
+	router := newBlockingPoolRouter(pool, release)
+
+	first := performAsync(router, "/block")
+	waitPoolStats(t, pool, 1, 0)
+	second := performAsync(router, "/block")
+	waitPoolStats(t, pool, 1, 1)
+	third := performAsync(router, "/block")
+
+	assert.Equal(t, http.StatusTooManyRequests, (<-second).Code)
+	close(release)
+	assert.Equal(t, http.StatusOK, (<-first).Code)
+	assert.Equal(t, http.StatusOK, (<-third).Code)
+}
+
+func TestWorkerPoolQueueTimeout(t *testing.T) {
+	pool := NewWorkerPool(WorkerPoolConfig{Workers: 1, QueueTimeout: 10 * time.Millisecond})
+	defer pool.Close()
+	release := make(chan struct{})
+	router := newBlockingPoolRouter(pool, release)
+
+	first := performAsync(router, "/block")
+	waitPoolStats(t, pool, 1, 0)
+
+	w := PerformRequest(router, http.MethodGet, "/block")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+	assert.Equal(t, uint64(1), pool.Stats().Rejected)
+
+	close(release)
+	assert.Equal(t, http.StatusOK, (<-first).Code)
+}
+
+func TestWorkerPoolPanic(t *testing.T) {
+	pool := NewWorkerPool(WorkerPoolConfig{})
+	router := newBlockingPoolRouter(pool, nil)
+
+	w := PerformRequest(router, http.MethodGet, "/panic")
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	pool.Close()
+	w = PerformRequest(router, http.MethodGet, "/panic")
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}